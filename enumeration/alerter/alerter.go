@@ -3,6 +3,7 @@ package alerter
 import (
 	"fmt"
 
+	"github.com/snyk/driftctl/enumeration/events"
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
@@ -52,6 +53,11 @@ func (a *Alerter) Retrieve() Alerts {
 }
 
 func (a *Alerter) SendAlert(key string, alert Alert) {
+	var resourceType, resourceId string
+	if res := alert.Resource(); res != nil {
+		resourceType, resourceId = res.ResourceType(), res.ResourceId()
+	}
+	events.Emit(events.NewAlertRaised(key, alert.Message(), resourceType, resourceId))
 	a.alertsCh <- Alerts{
 		key: []Alert{alert},
 	}
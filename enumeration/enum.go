@@ -1,6 +1,7 @@
 package enumeration
 
 import (
+	"context"
 	"time"
 
 	"github.com/snyk/driftctl/enumeration/diagnostic"
@@ -9,6 +10,10 @@ import (
 
 type EnumerateInput struct {
 	ResourceTypes []string
+
+	// OnResources is optionally called with the resources of each type as soon as they are listed,
+	// calls may happen concurrently.
+	OnResources func(resourceType string, resources []*resource.Resource)
 }
 
 type EnumerateOutput struct {
@@ -29,5 +34,5 @@ type EnumerateOutput struct {
 }
 
 type Enumerator interface {
-	Enumerate(*EnumerateInput) (*EnumerateOutput, error)
+	Enumerate(context.Context, *EnumerateInput) (*EnumerateOutput, error)
 }
@@ -6,25 +6,30 @@ import (
 	"os"
 	"sync"
 
+	"github.com/hashicorp/terraform/configs/configschema"
+	tfterraform "github.com/hashicorp/terraform/terraform"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/diagnostic"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/terraform"
 )
 
 type CloudEnumerator struct {
-	alerter              *sliceAlerter
-	progress             enumeration.ProgressCounter
-	remoteLibrary        *common.RemoteLibrary
-	providerLibrary      *terraform.ProviderLibrary
-	enumeratorRunner     *parallel.ParallelRunner
-	detailsFetcherRunner *parallel.ParallelRunner
-	to                   string
+	alerter                    *sliceAlerter
+	progress                   enumeration.ProgressCounter
+	remoteLibrary              *common.RemoteLibrary
+	providerLibrary            *terraform.ProviderLibrary
+	enumerationConcurrency     int64
+	detailsFetchingConcurrency int64
+	to                         string
 }
 
 type ListOutput struct {
@@ -35,7 +40,15 @@ type ListOutput struct {
 type cloudEnumeratorBuilder struct {
 	cloud           string
 	providerVersion string
+	providerHashes  []string
+	providerSource  terraform.ProviderSource
 	configDirectory string
+	providerMirror  terraform.ProviderMirror
+	cacheTTL        cache.TTLConfig
+
+	enumerationConcurrency     int
+	detailsFetchingConcurrency int
+	rateLimit                  float64
 }
 
 // WithCloud Choose which cloud to use for enumeration and refresh
@@ -51,20 +64,65 @@ func (b *cloudEnumeratorBuilder) WithProviderVersion(providerVersion string) *cl
 	return b
 }
 
+// WithProviderHashes optionally pin the h1: and zh: hashes the downloaded provider must match, as found in terraform lock files
+func (b *cloudEnumeratorBuilder) WithProviderHashes(hashes []string) *cloudEnumeratorBuilder {
+	b.providerHashes = hashes
+	return b
+}
+
+// WithProviderSource optionally install the provider from another registry hostname or namespace than hashicorp's on the public registry
+func (b *cloudEnumeratorBuilder) WithProviderSource(source terraform.ProviderSource) *cloudEnumeratorBuilder {
+	b.providerSource = source
+	return b
+}
+
 // WithConfigDirectory optionally choose the directory used to download terraform provider used for refresh
 func (b *cloudEnumeratorBuilder) WithConfigDirectory(configDir string) *cloudEnumeratorBuilder {
 	b.configDirectory = configDir
 	return b
 }
 
+// WithProviderMirror optionally install the terraform provider from a local directory or a network mirror
+func (b *cloudEnumeratorBuilder) WithProviderMirror(mirror terraform.ProviderMirror) *cloudEnumeratorBuilder {
+	b.providerMirror = mirror
+	return b
+}
+
+// WithCacheTTL optionally persist enumeration results on disk in the config directory for the given durations
+func (b *cloudEnumeratorBuilder) WithCacheTTL(ttl cache.TTLConfig) *cloudEnumeratorBuilder {
+	b.cacheTTL = ttl
+	return b
+}
+
+// WithConcurrency optionally choose how many enumerators and details fetchers run in parallel
+func (b *cloudEnumeratorBuilder) WithConcurrency(enumeration, detailsFetching int) *cloudEnumeratorBuilder {
+	b.enumerationConcurrency = enumeration
+	b.detailsFetchingConcurrency = detailsFetching
+	return b
+}
+
+// WithRateLimit optionally choose the maximum number of requests per second sent to each cloud service, zero disables rate limiting
+func (b *cloudEnumeratorBuilder) WithRateLimit(rateLimit float64) *cloudEnumeratorBuilder {
+	b.rateLimit = rateLimit
+	return b
+}
+
 func (b *cloudEnumeratorBuilder) Build() (*CloudEnumerator, error) {
+	enumerationConcurrency, detailsFetchingConcurrency := int64(remote.DefaultConcurrency), int64(remote.DefaultConcurrency)
+	if b.enumerationConcurrency > 0 {
+		enumerationConcurrency = int64(b.enumerationConcurrency)
+	}
+	if b.detailsFetchingConcurrency > 0 {
+		detailsFetchingConcurrency = int64(b.detailsFetchingConcurrency)
+	}
+
 	enumerator := &CloudEnumerator{
-		enumeratorRunner:     parallel.NewParallelRunner(context.TODO(), 10),
-		detailsFetcherRunner: parallel.NewParallelRunner(context.TODO(), 10),
-		providerLibrary:      terraform.NewProviderLibrary(),
-		remoteLibrary:        common.NewRemoteLibrary(),
-		alerter:              newSliceAlerter(),
-		progress:             &dummyCounter{},
+		enumerationConcurrency:     enumerationConcurrency,
+		detailsFetchingConcurrency: detailsFetchingConcurrency,
+		providerLibrary:            terraform.NewProviderLibrary(),
+		remoteLibrary:              common.NewRemoteLibrary(),
+		alerter:                    newSliceAlerter(),
+		progress:                   &dummyCounter{},
 	}
 
 	if b.configDirectory == "" {
@@ -75,30 +133,34 @@ func (b *cloudEnumeratorBuilder) Build() (*CloudEnumerator, error) {
 		b.configDirectory = tempDir
 	}
 
-	err := enumerator.init(fmt.Sprintf("%s+tf", b.cloud), b.providerVersion, b.configDirectory)
+	err := enumerator.init(fmt.Sprintf("%s+tf", b.cloud), b.providerVersion, b.providerHashes, b.providerSource, b.configDirectory, b.providerMirror, b.cacheTTL, b.rateLimit)
 
 	return enumerator, err
 }
 
 func NewCloudEnumerator() *cloudEnumeratorBuilder {
-	return &cloudEnumeratorBuilder{}
+	return &cloudEnumeratorBuilder{
+		rateLimit: ratelimit.DefaultRate,
+	}
 }
 
-func (e *CloudEnumerator) init(to, providerVersion, configDirectory string) error {
+func (e *CloudEnumerator) init(to, providerVersion string, providerHashes []string, providerSource terraform.ProviderSource, configDirectory string, providerMirror terraform.ProviderMirror, cacheTTL cache.TTLConfig, rateLimit float64) error {
 	e.to = to
 
 	resFactory := terraform.NewTerraformResourceFactory()
 
-	err := remote.Activate(to, providerVersion, e.alerter, e.providerLibrary, e.remoteLibrary, e.progress, resFactory, configDirectory)
+	err := remote.Activate(to, providerVersion, providerHashes, providerSource, e.alerter, e.providerLibrary, e.remoteLibrary, e.progress, resFactory, configDirectory, providerMirror, cacheTTL, rateLimit)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (e *CloudEnumerator) Enumerate(input *enumeration.EnumerateInput) (*enumeration.EnumerateOutput, error) {
+func (e *CloudEnumerator) Enumerate(ctx context.Context, input *enumeration.EnumerateInput) (*enumeration.EnumerateOutput, error) {
 
 	e.alerter.alerts = alerter.Alerts{}
+	// Runners can not be reused once read, so each call gets its own
+	enumeratorRunner := parallel.NewParallelRunner(ctx, e.enumerationConcurrency)
 
 	enumerators := e.remoteLibrary.Enumerators()
 
@@ -119,8 +181,8 @@ func (e *CloudEnumerator) Enumerate(input *enumeration.EnumerateInput) (*enumera
 			continue
 		}
 		enumerator := enumerator
-		e.enumeratorRunner.Run(func() (interface{}, error) {
-			resources, err := enumerator.Enumerate()
+		enumeratorRunner.Run(func() (interface{}, error) {
+			resources, err := enumerator.Enumerate(ctx)
 			if err != nil {
 				err := remote.HandleResourceEnumerationError(err, e.alerter)
 				if err == nil {
@@ -128,6 +190,7 @@ func (e *CloudEnumerator) Enumerate(input *enumeration.EnumerateInput) (*enumera
 				}
 				return nil, err
 			}
+			found := make([]*resource.Resource, 0, len(resources))
 			for _, res := range resources {
 				if res == nil {
 					continue
@@ -136,18 +199,29 @@ func (e *CloudEnumerator) Enumerate(input *enumeration.EnumerateInput) (*enumera
 					"id":   res.ResourceId(),
 					"type": res.ResourceType(),
 				}).Debug("Found cloud resource")
+				found = append(found, res)
+			}
+			if input.OnResources != nil {
+				input.OnResources(string(enumerator.SupportedType()), found)
 			}
 			return resources, nil
 		})
 	}
 
-	results, err := e.retrieveRunnerResults(e.enumeratorRunner)
+	results, err := e.retrieveRunnerResults(ctx, enumeratorRunner)
 	if err != nil {
 		return nil, err
 	}
 
 	mapRes := mapByType(results)
 
+	if limiter := e.remoteLibrary.RateLimiter(); limiter != nil {
+		throttles := limiter.FlushThrottles()
+		for _, service := range ratelimit.SortedServices(throttles) {
+			e.alerter.SendAlert("", alerts.NewThrottlingAlert(service, throttles[service]))
+		}
+	}
+
 	diagnostics := diagnostic.FromAlerts(e.alerter.Alerts())
 
 	return &enumeration.EnumerateOutput{
@@ -157,20 +231,21 @@ func (e *CloudEnumerator) Enumerate(input *enumeration.EnumerateInput) (*enumera
 	}, nil
 }
 
-func (e *CloudEnumerator) Refresh(input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+func (e *CloudEnumerator) Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
 
 	e.alerter.alerts = alerter.Alerts{}
+	detailsFetcherRunner := parallel.NewParallelRunner(ctx, e.detailsFetchingConcurrency)
 
 	for _, resByType := range input.Resources {
 		for _, res := range resByType {
 			res := res
-			e.detailsFetcherRunner.Run(func() (interface{}, error) {
+			detailsFetcherRunner.Run(func() (interface{}, error) {
 				fetcher := e.remoteLibrary.GetDetailsFetcher(resource.ResourceType(res.ResourceType()))
 				if fetcher == nil {
 					return []*resource.Resource{res}, nil
 				}
 
-				resourceWithDetails, err := fetcher.ReadDetails(res)
+				resourceWithDetails, err := fetcher.ReadDetails(ctx, res)
 				if err != nil {
 					if err := remote.HandleResourceDetailsFetchingError(err, e.alerter); err != nil {
 						return nil, err
@@ -182,7 +257,7 @@ func (e *CloudEnumerator) Refresh(input *enumeration.RefreshInput) (*enumeration
 		}
 	}
 
-	results, err := e.retrieveRunnerResults(e.detailsFetcherRunner)
+	results, err := e.retrieveRunnerResults(ctx, detailsFetcherRunner)
 	if err != nil {
 		return nil, err
 	}
@@ -197,10 +272,30 @@ func (e *CloudEnumerator) Refresh(input *enumeration.RefreshInput) (*enumeration
 }
 
 func (e *CloudEnumerator) GetSchema() (*enumeration.GetSchemasOutput, error) {
-	panic("GetSchema is not implemented..")
+	providerName := common.RemoteParameter(e.to).GetProviderAddress().Type
+	provider := e.providerLibrary.Provider(providerName)
+	if provider == nil {
+		return nil, fmt.Errorf("provider %s is not initialized", providerName)
+	}
+
+	schema := &tfterraform.ProviderSchema{
+		ResourceTypes:              map[string]*configschema.Block{},
+		ResourceTypeSchemaVersions: map[string]uint64{},
+	}
+	for typ, resourceSchema := range provider.Schema() {
+		schema.ResourceTypes[typ] = resourceSchema.Block
+		schema.ResourceTypeSchemaVersions[typ] = uint64(resourceSchema.Version)
+	}
+
+	return &enumeration.GetSchemasOutput{Schema: schema}, nil
+}
+
+// Cleanup stops the terraform provider used for refresh
+func (e *CloudEnumerator) Cleanup() {
+	e.providerLibrary.Cleanup()
 }
 
-func (e *CloudEnumerator) retrieveRunnerResults(runner *parallel.ParallelRunner) ([]*resource.Resource, error) {
+func (e *CloudEnumerator) retrieveRunnerResults(ctx context.Context, runner *parallel.ParallelRunner) ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0)
 loop:
 	for {
@@ -219,22 +314,25 @@ loop:
 			break loop
 		}
 	}
-	return results, runner.Err()
+	if runner.Err() != nil {
+		return results, runner.Err()
+	}
+	return results, ctx.Err()
 }
 
-func (e *CloudEnumerator) List(typ string) (*ListOutput, error) {
+func (e *CloudEnumerator) List(ctx context.Context, typ string) (*ListOutput, error) {
 
 	diagnostics := diagnostic.Diagnostics{}
 
 	enumInput := &enumeration.EnumerateInput{ResourceTypes: []string{typ}}
-	enumerate, err := e.Enumerate(enumInput)
+	enumerate, err := e.Enumerate(ctx, enumInput)
 	if err != nil {
 		return nil, err
 	}
 	diagnostics = append(diagnostics, enumerate.Diagnostics...)
 
 	refreshInput := &enumeration.RefreshInput{Resources: enumerate.Resources}
-	refresh, err := e.Refresh(refreshInput)
+	refresh, err := e.Refresh(ctx, refreshInput)
 	if err != nil {
 		return nil, err
 	}
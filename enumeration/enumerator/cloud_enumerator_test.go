@@ -0,0 +1,76 @@
+package enumerator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestCloudEnumerator(remoteLibrary *common.RemoteLibrary) *CloudEnumerator {
+	return &CloudEnumerator{
+		enumerationConcurrency:     1,
+		detailsFetchingConcurrency: 1,
+		remoteLibrary:              remoteLibrary,
+		alerter:                    newSliceAlerter(),
+		progress:                   &dummyCounter{},
+	}
+}
+
+func TestCloudEnumerator_Enumerate(t *testing.T) {
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+		{Id: "bucket", Type: "aws_s3_bucket"},
+	}, nil).Once()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	output, err := newTestCloudEnumerator(remoteLibrary).Enumerate(context.Background(), &enumeration.EnumerateInput{
+		ResourceTypes: []string{"aws_s3_bucket"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]*resource.Resource{
+		"aws_s3_bucket": {{Id: "bucket", Type: "aws_s3_bucket"}},
+	}, output.Resources)
+	fakeEnumerator.AssertExpectations(t)
+}
+
+func TestCloudEnumerator_EnumerateShouldBeCancellable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	hungEnumerator := &common.MockEnumerator{}
+	hungEnumerator.On("SupportedType").Return(resource.ResourceType("aws_s3_bucket"))
+	hungEnumerator.On("Enumerate", mock.Anything).Return(func(ctx context.Context) []*resource.Resource {
+		cancel()
+		<-ctx.Done()
+		return nil
+	}, func(ctx context.Context) error {
+		return ctx.Err()
+	}).Once()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(hungEnumerator)
+
+	_, err := newTestCloudEnumerator(remoteLibrary).Enumerate(ctx, &enumeration.EnumerateInput{
+		ResourceTypes: []string{"aws_s3_bucket"},
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestCloudEnumerator_RefreshShouldBeCancellable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newTestCloudEnumerator(common.NewRemoteLibrary()).Refresh(ctx, &enumeration.RefreshInput{
+		Resources: map[string][]*resource.Resource{
+			"aws_s3_bucket": {{Id: "bucket", Type: "aws_s3_bucket"}},
+		},
+	})
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Type identifies a scan lifecycle event
+type Type string
+
+const (
+	ScanStarted         Type = "scan_started"
+	ScanFinished        Type = "scan_finished"
+	StateReadStarted    Type = "state_read_started"
+	StateReadFinished   Type = "state_read_finished"
+	EnumerationStarted  Type = "enumeration_started"
+	EnumerationFinished Type = "enumeration_finished"
+	AlertRaised         Type = "alert"
+)
+
+// Event is a machine-readable record of something that happened during a scan
+type Event struct {
+	Time         time.Time `json:"time"`
+	Type         Type      `json:"type"`
+	Source       string    `json:"source,omitempty"`
+	Key          string    `json:"key,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceId   string    `json:"resource_id,omitempty"`
+	Count        *int      `json:"count,omitempty"`
+	DurationMs   *int64    `json:"duration_ms,omitempty"`
+	Message      string    `json:"message,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+func NewScanStarted(to string) Event {
+	return Event{Type: ScanStarted, Source: to}
+}
+
+func NewScanFinished(to string, duration time.Duration, err error) Event {
+	ms := duration.Milliseconds()
+	event := Event{Type: ScanFinished, Source: to, DurationMs: &ms}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
+
+func NewStateReadStarted(source string) Event {
+	return Event{Type: StateReadStarted, Source: source}
+}
+
+func NewStateReadFinished(source string, count int, duration time.Duration, err error) Event {
+	return finished(Event{Type: StateReadFinished, Source: source}, count, duration, err)
+}
+
+func NewEnumerationStarted(resourceType string) Event {
+	return Event{Type: EnumerationStarted, ResourceType: resourceType}
+}
+
+func NewEnumerationFinished(resourceType string, count int, duration time.Duration, err error) Event {
+	return finished(Event{Type: EnumerationFinished, ResourceType: resourceType}, count, duration, err)
+}
+
+func NewAlertRaised(key, message string, resourceType, resourceId string) Event {
+	return Event{Type: AlertRaised, Key: key, Message: message, ResourceType: resourceType, ResourceId: resourceId}
+}
+
+func finished(event Event, count int, duration time.Duration, err error) Event {
+	ms := duration.Milliseconds()
+	event.DurationMs = &ms
+	if err != nil {
+		event.Error = err.Error()
+		return event
+	}
+	event.Count = &count
+	return event
+}
+
+// Emitter receives the events of a scan
+type Emitter interface {
+	Emit(event Event)
+}
+
+var (
+	globalEmitterMu sync.RWMutex
+	globalEmitter   Emitter = &VoidEmitter{}
+)
+
+// ChangeEmitter sets the emitter receiving the events of the current process
+func ChangeEmitter(emitter Emitter) {
+	globalEmitterMu.Lock()
+	defer globalEmitterMu.Unlock()
+	globalEmitter = emitter
+}
+
+func Emit(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	globalEmitterMu.RLock()
+	emitter := globalEmitter
+	globalEmitterMu.RUnlock()
+	emitter.Emit(event)
+}
+
+// NDJSONEmitter writes events as newline delimited JSON
+type NDJSONEmitter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closed  bool
+}
+
+func NewNDJSONEmitter(w io.Writer) *NDJSONEmitter {
+	return &NDJSONEmitter{encoder: json.NewEncoder(w)}
+}
+
+func (e *NDJSONEmitter) Emit(event Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	if err := e.encoder.Encode(event); err != nil {
+		logrus.WithField("error", err).Debug("Unable to write event")
+	}
+}
+
+// Close stops writing events, the ones emitted afterwards are ignored
+func (e *NDJSONEmitter) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+}
+
+type VoidEmitter struct{}
+
+func (v *VoidEmitter) Emit(event Event) {}
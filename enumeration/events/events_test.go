@@ -0,0 +1,76 @@
+package events
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNDJSONEmitter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ChangeEmitter(NewNDJSONEmitter(buf))
+	defer ChangeEmitter(&VoidEmitter{})
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []Event{
+		NewStateReadStarted("tfstate://terraform.tfstate"),
+		NewStateReadFinished("tfstate://terraform.tfstate", 0, 12*time.Millisecond, nil),
+		NewEnumerationStarted("aws_s3_bucket"),
+		NewEnumerationFinished("aws_s3_bucket", 2, 1500*time.Millisecond, nil),
+		NewEnumerationFinished("aws_iam_user", 0, time.Second, errors.New("AccessDeniedException")),
+		NewAlertRaised("aws_iam_user", "Ignoring aws_iam_user from drift calculation", "", ""),
+	}
+	for _, event := range cases {
+		event.Time = date
+		Emit(event)
+	}
+
+	assert.Equal(t, `{"time":"2022-01-01T00:00:00Z","type":"state_read_started","source":"tfstate://terraform.tfstate"}
+{"time":"2022-01-01T00:00:00Z","type":"state_read_finished","source":"tfstate://terraform.tfstate","count":0,"duration_ms":12}
+{"time":"2022-01-01T00:00:00Z","type":"enumeration_started","resource_type":"aws_s3_bucket"}
+{"time":"2022-01-01T00:00:00Z","type":"enumeration_finished","resource_type":"aws_s3_bucket","count":2,"duration_ms":1500}
+{"time":"2022-01-01T00:00:00Z","type":"enumeration_finished","resource_type":"aws_iam_user","duration_ms":1000,"error":"AccessDeniedException"}
+{"time":"2022-01-01T00:00:00Z","type":"alert","key":"aws_iam_user","message":"Ignoring aws_iam_user from drift calculation"}
+`, buf.String())
+}
+
+func TestEmitSetsTime(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ChangeEmitter(NewNDJSONEmitter(buf))
+	defer ChangeEmitter(&VoidEmitter{})
+
+	Emit(NewScanStarted("aws+tf"))
+	assert.NotContains(t, buf.String(), `"time":"0001-01-01T00:00:00Z"`)
+}
+
+func TestNDJSONEmitterIgnoresEventsAfterClose(t *testing.T) {
+	buf := &bytes.Buffer{}
+	emitter := NewNDJSONEmitter(buf)
+	emitter.Emit(NewScanStarted("aws+tf"))
+	emitter.Close()
+	emitter.Emit(NewScanFinished("aws+tf", time.Second, nil))
+
+	assert.Contains(t, buf.String(), `"type":"scan_started"`)
+	assert.NotContains(t, buf.String(), `"type":"scan_finished"`)
+}
+
+func TestChangeEmitterWhileEmitting(t *testing.T) {
+	defer ChangeEmitter(&VoidEmitter{})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			Emit(NewScanStarted("aws+tf"))
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		emitter := NewNDJSONEmitter(&bytes.Buffer{})
+		ChangeEmitter(emitter)
+		emitter.Close()
+	}
+	<-done
+}
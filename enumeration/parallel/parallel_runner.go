@@ -19,7 +19,7 @@ type ParallelRunner struct {
 	ctx     context.Context
 	cancel  context.CancelFunc
 	resChan chan interface{}
-	err     error
+	err     *atomic.Error
 	hasErr  *atomic.Bool
 	waiting *atomic.Bool
 }
@@ -32,7 +32,7 @@ func NewParallelRunner(ctx context.Context, maxRun int64) *ParallelRunner {
 		ctx:     ctx,
 		cancel:  cancelFunc,
 		resChan: make(chan interface{}),
-		err:     nil,
+		err:     atomic.NewError(nil),
 		hasErr:  atomic.NewBool(false),
 		waiting: atomic.NewBool(false),
 	}
@@ -46,7 +46,7 @@ func (p *ParallelRunner) SubRunner() *ParallelRunner {
 		ctx:     ctx,
 		cancel:  cancelFunc,
 		resChan: make(chan interface{}),
-		err:     nil,
+		err:     atomic.NewError(nil),
 		hasErr:  atomic.NewBool(false),
 		waiting: atomic.NewBool(false),
 	}
@@ -62,7 +62,7 @@ func (p *ParallelRunner) DoneChan() <-chan struct{} {
 }
 
 func (p *ParallelRunner) Err() error {
-	return p.err
+	return p.err.Load()
 }
 
 func (p *ParallelRunner) wait() {
@@ -105,7 +105,7 @@ func (p *ParallelRunner) Run(runnable func() (interface{}, error)) {
 func (p *ParallelRunner) Stop(err error) {
 	if !p.hasErr.Swap(true) {
 		logrus.Debug("Stopping ParallelRunner")
-		p.err = err
+		p.err.Store(err)
 		p.cancel()
 	}
 }
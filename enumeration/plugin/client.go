@@ -0,0 +1,198 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/plugin/pb"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	serverpb "github.com/snyk/driftctl/enumeration/server/pb"
+	"github.com/snyk/driftctl/logger"
+	"google.golang.org/grpc/status"
+)
+
+// ClientConfig returns the go-plugin configuration used to launch the enumerator plugin at path
+func ClientConfig(path string) *plugin.ClientConfig {
+	return &plugin.ClientConfig{
+		Cmd:              exec.Command(path),
+		HandshakeConfig:  Handshake,
+		Plugins:          pluginSet(nil),
+		Managed:          true,
+		Logger:           logger.NewTerraformPluginLogger(),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		AutoMTLS:         true,
+	}
+}
+
+// Plugins holds the enumerator plugins started by Load
+type Plugins struct {
+	clients       []*plugin.Client
+	resourceTypes []string
+}
+
+// ResourceTypes returns the resource types enumerated by the loaded plugins
+func (p *Plugins) ResourceTypes() []string {
+	return p.resourceTypes
+}
+
+// Cleanup stops the plugin processes
+func (p *Plugins) Cleanup() {
+	for _, client := range p.clients {
+		client.Kill()
+	}
+}
+
+// Load starts every enumerator plugin found in dir and adds their enumerators and details fetchers to the library.
+// Resource types already enumerated by the library are left to driftctl, a missing directory loads no plugin.
+func Load(dir string, library *common.RemoteLibrary) (*Plugins, error) {
+	plugins := &Plugins{}
+	paths, err := discover(dir)
+	if err != nil {
+		return plugins, err
+	}
+
+	enumerated := map[resource.ResourceType]bool{}
+	for _, enumerator := range library.Enumerators() {
+		enumerated[enumerator.SupportedType()] = true
+	}
+
+	for _, path := range paths {
+		client := plugin.NewClient(ClientConfig(path))
+		plugins.clients = append(plugins.clients, client)
+
+		pluginClient, err := dispense(client)
+		if err != nil {
+			plugins.Cleanup()
+			return plugins, errors.Wrapf(err, "unable to start enumerator plugin %s", path)
+		}
+		response, err := pluginClient.GetResourceTypes(context.Background(), &pb.GetResourceTypesRequest{})
+		if err != nil {
+			plugins.Cleanup()
+			return plugins, errors.Wrapf(err, "unable to list resource types of enumerator plugin %s", path)
+		}
+
+		for _, ty := range response.ResourceTypes {
+			resourceType := resource.ResourceType(ty.Name)
+			if enumerated[resourceType] {
+				logrus.WithFields(logrus.Fields{
+					"plugin": path,
+					"type":   ty.Name,
+				}).Warn("Resource type is already enumerated, ignoring it")
+				continue
+			}
+			enumerated[resourceType] = true
+			library.AddEnumerator(&enumerator{client: pluginClient, resourceType: resourceType})
+			if ty.DetailsFetcher {
+				library.AddDetailsFetcher(resourceType, &detailsFetcher{client: pluginClient})
+			}
+			resource.AddSupportedType(ty.Name)
+			plugins.resourceTypes = append(plugins.resourceTypes, ty.Name)
+			logrus.WithFields(logrus.Fields{
+				"plugin": path,
+				"type":   ty.Name,
+			}).Debug("Loaded enumerator plugin")
+		}
+	}
+
+	return plugins, nil
+}
+
+// discover returns the executables of dir whose name starts with BinaryPrefix, sorted by name
+func discover(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read enumerator plugins directory %s", dir)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), BinaryPrefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if info.Mode()&0111 == 0 {
+			logrus.WithField("path", filepath.Join(dir, entry.Name())).Debug("Skipping non executable enumerator plugin")
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func dispense(client *plugin.Client) (pb.EnumeratorPluginClient, error) {
+	rpcClient, err := client.Client()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rpcClient.Dispense(pluginName)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(pb.EnumeratorPluginClient), nil
+}
+
+// enumerator implements common.Enumerator by calling a plugin
+type enumerator struct {
+	client       pb.EnumeratorPluginClient
+	resourceType resource.ResourceType
+}
+
+func (e *enumerator) SupportedType() resource.ResourceType {
+	return e.resourceType
+}
+
+func (e *enumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	response, err := e.client.Enumerate(ctx, &pb.EnumerateRequest{ResourceType: e.resourceType.String()})
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(pluginError(err), e.resourceType.String())
+	}
+	resources := make([]*resource.Resource, 0, len(response.Resources))
+	for _, res := range response.Resources {
+		resources = append(resources, res.ToResource())
+	}
+	return resources, nil
+}
+
+// detailsFetcher implements common.DetailsFetcher by calling a plugin
+type detailsFetcher struct {
+	client pb.EnumeratorPluginClient
+}
+
+func (f *detailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	protoRes, err := serverpb.FromResource(res)
+	if err != nil {
+		return nil, err
+	}
+	response, err := f.client.ReadDetails(ctx, &pb.ReadDetailsRequest{Resource: protoRes})
+	if err != nil {
+		return nil, remoteerror.NewResourceScanningError(pluginError(err), res.ResourceType(), res.ResourceId())
+	}
+	if response.Resource == nil {
+		return nil, nil
+	}
+	return response.Resource.ToResource(), nil
+}
+
+// pluginError drops the gRPC status of errors returned by plugins, so they are not mistaken for Google API errors
+func pluginError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return errors.New(st.Message())
+	}
+	return err
+}
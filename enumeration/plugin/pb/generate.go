@@ -0,0 +1,4 @@
+// Package pb contains the protobuf types and gRPC stubs of the enumerator plugin protocol, generated from plugin.proto
+package pb
+
+//go:generate protoc -I ../../.. --go_out=../../.. --go_opt=paths=source_relative --go-grpc_out=../../.. --go-grpc_opt=paths=source_relative enumeration/plugin/pb/plugin.proto
@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: enumeration/plugin/pb/plugin.proto
+
+package pb
+
+import (
+	pb "github.com/snyk/driftctl/enumeration/server/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetResourceTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetResourceTypesRequest) Reset() {
+	*x = GetResourceTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceTypesRequest) ProtoMessage() {}
+
+func (x *GetResourceTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceTypesRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceTypesRequest) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+type GetResourceTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceTypes []*ResourceType `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+}
+
+func (x *GetResourceTypesResponse) Reset() {
+	*x = GetResourceTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResourceTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceTypesResponse) ProtoMessage() {}
+
+func (x *GetResourceTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceTypesResponse) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetResourceTypesResponse) GetResourceTypes() []*ResourceType {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+type ResourceType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Set when the plugin implements ReadDetails for this type
+	DetailsFetcher bool `protobuf:"varint,2,opt,name=details_fetcher,json=detailsFetcher,proto3" json:"details_fetcher,omitempty"`
+}
+
+func (x *ResourceType) Reset() {
+	*x = ResourceType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceType) ProtoMessage() {}
+
+func (x *ResourceType) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceType.ProtoReflect.Descriptor instead.
+func (*ResourceType) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourceType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceType) GetDetailsFetcher() bool {
+	if x != nil {
+		return x.DetailsFetcher
+	}
+	return false
+}
+
+type EnumerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+}
+
+func (x *EnumerateRequest) Reset() {
+	*x = EnumerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumerateRequest) ProtoMessage() {}
+
+func (x *EnumerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumerateRequest.ProtoReflect.Descriptor instead.
+func (*EnumerateRequest) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnumerateRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+type EnumerateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*pb.Resource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *EnumerateResponse) Reset() {
+	*x = EnumerateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumerateResponse) ProtoMessage() {}
+
+func (x *EnumerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumerateResponse.ProtoReflect.Descriptor instead.
+func (*EnumerateResponse) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnumerateResponse) GetResources() []*pb.Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type ReadDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource *pb.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (x *ReadDetailsRequest) Reset() {
+	*x = ReadDetailsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDetailsRequest) ProtoMessage() {}
+
+func (x *ReadDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ReadDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReadDetailsRequest) GetResource() *pb.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type ReadDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource is not set when the resource does not exist anymore
+	Resource *pb.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (x *ReadDetailsResponse) Reset() {
+	*x = ReadDetailsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDetailsResponse) ProtoMessage() {}
+
+func (x *ReadDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_enumeration_plugin_pb_plugin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDetailsResponse.ProtoReflect.Descriptor instead.
+func (*ReadDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_enumeration_plugin_pb_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadDetailsResponse) GetResource() *pb.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+var File_enumeration_plugin_pb_plugin_proto protoreflect.FileDescriptor
+
+var file_enumeration_plugin_pb_plugin_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x2f,
+	0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0x37,
+	0x0a, 0x10, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x11, 0x45, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x53, 0x0a,
+	0x12, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x22, 0x54, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x32, 0xbb, 0x02, 0x0a, 0x10, 0x45, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x6d, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x2b, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x09,
+	0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x64, 0x72, 0x69, 0x66,
+	0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x64, 0x72, 0x69, 0x66, 0x74, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6e, 0x79, 0x6b, 0x2f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x63,
+	0x74, 0x6c, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_enumeration_plugin_pb_plugin_proto_rawDescOnce sync.Once
+	file_enumeration_plugin_pb_plugin_proto_rawDescData = file_enumeration_plugin_pb_plugin_proto_rawDesc
+)
+
+func file_enumeration_plugin_pb_plugin_proto_rawDescGZIP() []byte {
+	file_enumeration_plugin_pb_plugin_proto_rawDescOnce.Do(func() {
+		file_enumeration_plugin_pb_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_enumeration_plugin_pb_plugin_proto_rawDescData)
+	})
+	return file_enumeration_plugin_pb_plugin_proto_rawDescData
+}
+
+var file_enumeration_plugin_pb_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_enumeration_plugin_pb_plugin_proto_goTypes = []interface{}{
+	(*GetResourceTypesRequest)(nil),  // 0: driftctl.plugin.v1.GetResourceTypesRequest
+	(*GetResourceTypesResponse)(nil), // 1: driftctl.plugin.v1.GetResourceTypesResponse
+	(*ResourceType)(nil),             // 2: driftctl.plugin.v1.ResourceType
+	(*EnumerateRequest)(nil),         // 3: driftctl.plugin.v1.EnumerateRequest
+	(*EnumerateResponse)(nil),        // 4: driftctl.plugin.v1.EnumerateResponse
+	(*ReadDetailsRequest)(nil),       // 5: driftctl.plugin.v1.ReadDetailsRequest
+	(*ReadDetailsResponse)(nil),      // 6: driftctl.plugin.v1.ReadDetailsResponse
+	(*pb.Resource)(nil),              // 7: driftctl.enumeration.v1.Resource
+}
+var file_enumeration_plugin_pb_plugin_proto_depIdxs = []int32{
+	2, // 0: driftctl.plugin.v1.GetResourceTypesResponse.resource_types:type_name -> driftctl.plugin.v1.ResourceType
+	7, // 1: driftctl.plugin.v1.EnumerateResponse.resources:type_name -> driftctl.enumeration.v1.Resource
+	7, // 2: driftctl.plugin.v1.ReadDetailsRequest.resource:type_name -> driftctl.enumeration.v1.Resource
+	7, // 3: driftctl.plugin.v1.ReadDetailsResponse.resource:type_name -> driftctl.enumeration.v1.Resource
+	0, // 4: driftctl.plugin.v1.EnumeratorPlugin.GetResourceTypes:input_type -> driftctl.plugin.v1.GetResourceTypesRequest
+	3, // 5: driftctl.plugin.v1.EnumeratorPlugin.Enumerate:input_type -> driftctl.plugin.v1.EnumerateRequest
+	5, // 6: driftctl.plugin.v1.EnumeratorPlugin.ReadDetails:input_type -> driftctl.plugin.v1.ReadDetailsRequest
+	1, // 7: driftctl.plugin.v1.EnumeratorPlugin.GetResourceTypes:output_type -> driftctl.plugin.v1.GetResourceTypesResponse
+	4, // 8: driftctl.plugin.v1.EnumeratorPlugin.Enumerate:output_type -> driftctl.plugin.v1.EnumerateResponse
+	6, // 9: driftctl.plugin.v1.EnumeratorPlugin.ReadDetails:output_type -> driftctl.plugin.v1.ReadDetailsResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_enumeration_plugin_pb_plugin_proto_init() }
+func file_enumeration_plugin_pb_plugin_proto_init() {
+	if File_enumeration_plugin_pb_plugin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumerateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumerateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDetailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_enumeration_plugin_pb_plugin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDetailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_enumeration_plugin_pb_plugin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_enumeration_plugin_pb_plugin_proto_goTypes,
+		DependencyIndexes: file_enumeration_plugin_pb_plugin_proto_depIdxs,
+		MessageInfos:      file_enumeration_plugin_pb_plugin_proto_msgTypes,
+	}.Build()
+	File_enumeration_plugin_pb_plugin_proto = out.File
+	file_enumeration_plugin_pb_plugin_proto_rawDesc = nil
+	file_enumeration_plugin_pb_plugin_proto_goTypes = nil
+	file_enumeration_plugin_pb_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: enumeration/plugin/pb/plugin.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EnumeratorPluginClient is the client API for EnumeratorPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EnumeratorPluginClient interface {
+	// GetResourceTypes returns the resource types enumerated by the plugin
+	GetResourceTypes(ctx context.Context, in *GetResourceTypesRequest, opts ...grpc.CallOption) (*GetResourceTypesResponse, error)
+	// Enumerate lists the resources of a single type
+	Enumerate(ctx context.Context, in *EnumerateRequest, opts ...grpc.CallOption) (*EnumerateResponse, error)
+	// ReadDetails reads the attributes of a resource, only called for types with a details fetcher
+	ReadDetails(ctx context.Context, in *ReadDetailsRequest, opts ...grpc.CallOption) (*ReadDetailsResponse, error)
+}
+
+type enumeratorPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnumeratorPluginClient(cc grpc.ClientConnInterface) EnumeratorPluginClient {
+	return &enumeratorPluginClient{cc}
+}
+
+func (c *enumeratorPluginClient) GetResourceTypes(ctx context.Context, in *GetResourceTypesRequest, opts ...grpc.CallOption) (*GetResourceTypesResponse, error) {
+	out := new(GetResourceTypesResponse)
+	err := c.cc.Invoke(ctx, "/driftctl.plugin.v1.EnumeratorPlugin/GetResourceTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enumeratorPluginClient) Enumerate(ctx context.Context, in *EnumerateRequest, opts ...grpc.CallOption) (*EnumerateResponse, error) {
+	out := new(EnumerateResponse)
+	err := c.cc.Invoke(ctx, "/driftctl.plugin.v1.EnumeratorPlugin/Enumerate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enumeratorPluginClient) ReadDetails(ctx context.Context, in *ReadDetailsRequest, opts ...grpc.CallOption) (*ReadDetailsResponse, error) {
+	out := new(ReadDetailsResponse)
+	err := c.cc.Invoke(ctx, "/driftctl.plugin.v1.EnumeratorPlugin/ReadDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EnumeratorPluginServer is the server API for EnumeratorPlugin service.
+// All implementations must embed UnimplementedEnumeratorPluginServer
+// for forward compatibility
+type EnumeratorPluginServer interface {
+	// GetResourceTypes returns the resource types enumerated by the plugin
+	GetResourceTypes(context.Context, *GetResourceTypesRequest) (*GetResourceTypesResponse, error)
+	// Enumerate lists the resources of a single type
+	Enumerate(context.Context, *EnumerateRequest) (*EnumerateResponse, error)
+	// ReadDetails reads the attributes of a resource, only called for types with a details fetcher
+	ReadDetails(context.Context, *ReadDetailsRequest) (*ReadDetailsResponse, error)
+	mustEmbedUnimplementedEnumeratorPluginServer()
+}
+
+// UnimplementedEnumeratorPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedEnumeratorPluginServer struct {
+}
+
+func (UnimplementedEnumeratorPluginServer) GetResourceTypes(context.Context, *GetResourceTypesRequest) (*GetResourceTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceTypes not implemented")
+}
+func (UnimplementedEnumeratorPluginServer) Enumerate(context.Context, *EnumerateRequest) (*EnumerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enumerate not implemented")
+}
+func (UnimplementedEnumeratorPluginServer) ReadDetails(context.Context, *ReadDetailsRequest) (*ReadDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDetails not implemented")
+}
+func (UnimplementedEnumeratorPluginServer) mustEmbedUnimplementedEnumeratorPluginServer() {}
+
+// UnsafeEnumeratorPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EnumeratorPluginServer will
+// result in compilation errors.
+type UnsafeEnumeratorPluginServer interface {
+	mustEmbedUnimplementedEnumeratorPluginServer()
+}
+
+func RegisterEnumeratorPluginServer(s grpc.ServiceRegistrar, srv EnumeratorPluginServer) {
+	s.RegisterService(&EnumeratorPlugin_ServiceDesc, srv)
+}
+
+func _EnumeratorPlugin_GetResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnumeratorPluginServer).GetResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/driftctl.plugin.v1.EnumeratorPlugin/GetResourceTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnumeratorPluginServer).GetResourceTypes(ctx, req.(*GetResourceTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnumeratorPlugin_Enumerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnumerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnumeratorPluginServer).Enumerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/driftctl.plugin.v1.EnumeratorPlugin/Enumerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnumeratorPluginServer).Enumerate(ctx, req.(*EnumerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnumeratorPlugin_ReadDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnumeratorPluginServer).ReadDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/driftctl.plugin.v1.EnumeratorPlugin/ReadDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnumeratorPluginServer).ReadDetails(ctx, req.(*ReadDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EnumeratorPlugin_ServiceDesc is the grpc.ServiceDesc for EnumeratorPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EnumeratorPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "driftctl.plugin.v1.EnumeratorPlugin",
+	HandlerType: (*EnumeratorPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetResourceTypes",
+			Handler:    _EnumeratorPlugin_GetResourceTypes_Handler,
+		},
+		{
+			MethodName: "Enumerate",
+			Handler:    _EnumeratorPlugin_Enumerate_Handler,
+		},
+		{
+			MethodName: "ReadDetails",
+			Handler:    _EnumeratorPlugin_ReadDetails_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "enumeration/plugin/pb/plugin.proto",
+}
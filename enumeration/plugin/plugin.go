@@ -0,0 +1,53 @@
+// Package plugin lets external binaries provide enumerators and details fetchers for resource types driftctl does not
+// support yet. Plugins are launched with go-plugin like terraform providers and talk to driftctl over gRPC.
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/snyk/driftctl/enumeration/plugin/pb"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"google.golang.org/grpc"
+)
+
+// BinaryPrefix is the file name prefix of enumerator plugins in the plugins directory, e.g. driftctl-enumerator-internal
+const BinaryPrefix = "driftctl-enumerator-"
+
+const pluginName = "enumerator"
+
+// Handshake is shared by driftctl and enumerator plugins, the protocol version must be bumped on breaking changes
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "DRIFTCTL_ENUMERATOR_PLUGIN",
+	MagicCookieValue: "5b4e3ff2c7a04b5d8b3c2a1e1d6f9a0c",
+}
+
+// Serve runs an enumerator plugin exposing the enumerators and details fetchers of the given library.
+// It must be called from the main function of the plugin binary and never returns.
+func Serve(library *common.RemoteLibrary) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins:         pluginSet(library),
+		GRPCServer:      plugin.DefaultGRPCServer,
+	})
+}
+
+func pluginSet(library *common.RemoteLibrary) plugin.PluginSet {
+	return plugin.PluginSet{pluginName: &grpcPlugin{library: library}}
+}
+
+// grpcPlugin implements plugin.GRPCPlugin, the library is only set on the plugin side
+type grpcPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+	library *common.RemoteLibrary
+}
+
+func (p *grpcPlugin) GRPCServer(_ *plugin.GRPCBroker, server *grpc.Server) error {
+	pb.RegisterEnumeratorPluginServer(server, &grpcServer{library: p.library})
+	return nil
+}
+
+func (p *grpcPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return pb.NewEnumeratorPluginClient(conn), nil
+}
@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/snyk/driftctl/enumeration/plugin/pb"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDetailsFetcher struct{}
+
+func (f fakeDetailsFetcher) ReadDetails(_ context.Context, res *resource.Resource) (*resource.Resource, error) {
+	switch res.ResourceId() {
+	case "deleted":
+		return nil, nil
+	case "forbidden":
+		return nil, errors.New("AccessDenied: not allowed")
+	}
+	return &resource.Resource{Id: res.ResourceId(), Type: res.ResourceType(), Attrs: &resource.Attributes{"name": res.ResourceId(), "size": float64(3)}}, nil
+}
+
+func newTestPluginClient(t *testing.T) pb.EnumeratorPluginClient {
+	library := common.NewRemoteLibrary()
+
+	queues := &common.MockEnumerator{}
+	queues.On("SupportedType").Return(resource.ResourceType("internal_queue"))
+	queues.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+		{Id: "orders", Type: "internal_queue", Attrs: &resource.Attributes{"name": "orders"}},
+	}, nil)
+	library.AddEnumerator(queues)
+	library.AddDetailsFetcher("internal_queue", fakeDetailsFetcher{})
+
+	topics := &common.MockEnumerator{}
+	topics.On("SupportedType").Return(resource.ResourceType("internal_topic"))
+	topics.On("Enumerate", mock.Anything).Return(nil, errors.New("broker unavailable"))
+	library.AddEnumerator(topics)
+
+	client, server := plugin.TestPluginGRPCConn(t, pluginSet(library))
+	t.Cleanup(func() {
+		_ = client.Close()
+		server.Stop()
+	})
+	raw, err := client.Dispense(pluginName)
+	require.NoError(t, err)
+	return raw.(pb.EnumeratorPluginClient)
+}
+
+func TestPlugin_GetResourceTypes(t *testing.T) {
+	client := newTestPluginClient(t)
+
+	response, err := client.GetResourceTypes(context.Background(), &pb.GetResourceTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.ResourceTypes, 2)
+	assert.Equal(t, "internal_queue", response.ResourceTypes[0].Name)
+	assert.True(t, response.ResourceTypes[0].DetailsFetcher)
+	assert.Equal(t, "internal_topic", response.ResourceTypes[1].Name)
+	assert.False(t, response.ResourceTypes[1].DetailsFetcher)
+}
+
+func TestPlugin_Enumerate(t *testing.T) {
+	client := newTestPluginClient(t)
+
+	resources, err := (&enumerator{client: client, resourceType: "internal_queue"}).Enumerate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*resource.Resource{
+		{Id: "orders", Type: "internal_queue", Attrs: &resource.Attributes{"name": "orders"}},
+	}, resources)
+
+	_, err = (&enumerator{client: client, resourceType: "internal_topic"}).Enumerate(context.Background())
+	var scanningErr *remoteerror.ResourceScanningError
+	require.ErrorAs(t, err, &scanningErr)
+	assert.Equal(t, "internal_topic", scanningErr.ResourceType())
+	assert.Equal(t, "broker unavailable", scanningErr.RootCause().Error())
+
+	_, err = (&enumerator{client: client, resourceType: "internal_unknown"}).Enumerate(context.Background())
+	assert.EqualError(t, err, "error scanning resource type internal_unknown: no enumerator for resource type internal_unknown")
+}
+
+func TestPlugin_ReadDetails(t *testing.T) {
+	client := newTestPluginClient(t)
+	fetcher := &detailsFetcher{client: client}
+
+	res, err := fetcher.ReadDetails(context.Background(), &resource.Resource{Id: "orders", Type: "internal_queue", Attrs: &resource.Attributes{"name": "orders"}})
+	require.NoError(t, err)
+	assert.Equal(t, &resource.Resource{Id: "orders", Type: "internal_queue", Attrs: &resource.Attributes{"name": "orders", "size": float64(3)}}, res)
+
+	res, err = fetcher.ReadDetails(context.Background(), &resource.Resource{Id: "deleted", Type: "internal_queue"})
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	_, err = fetcher.ReadDetails(context.Background(), &resource.Resource{Id: "forbidden", Type: "internal_queue"})
+	var scanningErr *remoteerror.ResourceScanningError
+	require.ErrorAs(t, err, &scanningErr)
+	assert.Equal(t, "internal_queue.forbidden", scanningErr.Resource())
+	assert.Equal(t, "AccessDenied: not allowed", scanningErr.RootCause().Error())
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	for name, mode := range map[string]os.FileMode{
+		BinaryPrefix + "queues":  0755,
+		BinaryPrefix + "ami":     0755,
+		BinaryPrefix + "readme":  0644,
+		"terraform-provider-aws": 0755,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte{}, mode))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, BinaryPrefix+"dir"), 0755))
+
+	paths, err := discover(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, BinaryPrefix+"ami"),
+		filepath.Join(dir, BinaryPrefix+"queues"),
+	}, paths)
+}
+
+func TestLoad_MissingDirectory(t *testing.T) {
+	library := common.NewRemoteLibrary()
+
+	plugins, err := Load(filepath.Join(t.TempDir(), "missing"), library)
+	require.NoError(t, err)
+	assert.Empty(t, plugins.ResourceTypes())
+	assert.Empty(t, library.Enumerators())
+	plugins.Cleanup()
+}
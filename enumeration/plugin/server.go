@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/plugin/pb"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	serverpb "github.com/snyk/driftctl/enumeration/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcServer runs in the plugin process and serves the enumerators of its library
+type grpcServer struct {
+	pb.UnimplementedEnumeratorPluginServer
+	library *common.RemoteLibrary
+}
+
+func (s *grpcServer) GetResourceTypes(_ context.Context, _ *pb.GetResourceTypesRequest) (*pb.GetResourceTypesResponse, error) {
+	response := &pb.GetResourceTypesResponse{}
+	for _, enumerator := range s.library.Enumerators() {
+		ty := enumerator.SupportedType()
+		response.ResourceTypes = append(response.ResourceTypes, &pb.ResourceType{
+			Name:           ty.String(),
+			DetailsFetcher: s.library.GetDetailsFetcher(ty) != nil,
+		})
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Enumerate(ctx context.Context, req *pb.EnumerateRequest) (*pb.EnumerateResponse, error) {
+	for _, enumerator := range s.library.Enumerators() {
+		if enumerator.SupportedType().String() != req.ResourceType {
+			continue
+		}
+		resources, err := enumerator.Enumerate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		protoResources, err := serverpb.FromResources(resources)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &pb.EnumerateResponse{Resources: protoResources}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "no enumerator for resource type %s", req.ResourceType)
+}
+
+func (s *grpcServer) ReadDetails(ctx context.Context, req *pb.ReadDetailsRequest) (*pb.ReadDetailsResponse, error) {
+	if req.Resource == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing resource")
+	}
+	fetcher := s.library.GetDetailsFetcher(resource.ResourceType(req.Resource.Type))
+	if fetcher == nil {
+		return nil, status.Errorf(codes.NotFound, "no details fetcher for resource type %s", req.Resource.Type)
+	}
+	res, err := fetcher.ReadDetails(ctx, req.Resource.ToResource())
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return &pb.ReadDetailsResponse{}, nil
+	}
+	protoRes, err := serverpb.FromResource(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ReadDetailsResponse{Resource: protoRes}, nil
+}
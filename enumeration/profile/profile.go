@@ -0,0 +1,132 @@
+package profile
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Kind is the phase of the scan a profile entry belongs to
+type Kind string
+
+const (
+	Enumeration     Kind = "enumeration"
+	DetailsFetching Kind = "details_fetching"
+)
+
+type counterKey struct{}
+
+// Counter counts the cloud API calls made with a context
+type Counter struct {
+	apiCalls uint64
+}
+
+func WithCounter(ctx context.Context, counter *Counter) context.Context {
+	return context.WithValue(ctx, counterKey{}, counter)
+}
+
+// CountApiCall increments the counter of the given context, if any.
+// Retries are counted as separate calls.
+func CountApiCall(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if counter, ok := ctx.Value(counterKey{}).(*Counter); ok {
+		atomic.AddUint64(&counter.apiCalls, 1)
+	}
+}
+
+func (c *Counter) ApiCalls() int {
+	return int(atomic.LoadUint64(&c.apiCalls))
+}
+
+// TypeProfile aggregates the cost of a resource type for one phase of the scan
+type TypeProfile struct {
+	ResourceType string `json:"resource_type"`
+	DurationMs   int64  `json:"duration_ms"`
+	Calls        int    `json:"calls"`
+	ApiCalls     int    `json:"api_calls"`
+	Errors       int    `json:"errors"`
+
+	duration time.Duration
+}
+
+func (p TypeProfile) Duration() time.Duration {
+	return time.Duration(p.DurationMs) * time.Millisecond
+}
+
+// Report lists the profile of each resource type, slowest first
+type Report struct {
+	Enumeration     []TypeProfile `json:"enumeration"`
+	DetailsFetching []TypeProfile `json:"details_fetching,omitempty"`
+}
+
+// Slowest returns at most n profiles of the given kind, slowest first
+func (r *Report) Slowest(kind Kind, n int) []TypeProfile {
+	profiles := r.Enumeration
+	if kind == DetailsFetching {
+		profiles = r.DetailsFetching
+	}
+	if len(profiles) > n {
+		return profiles[:n]
+	}
+	return profiles
+}
+
+// Profiler records wall time, API calls and errors of enumerators and details fetchers.
+// Durations of details fetchers of a type are summed, they may overlap since they run in parallel.
+type Profiler struct {
+	mu       sync.Mutex
+	profiles map[Kind]map[string]*TypeProfile
+}
+
+func NewProfiler() *Profiler {
+	return &Profiler{
+		profiles: map[Kind]map[string]*TypeProfile{
+			Enumeration:     {},
+			DetailsFetching: {},
+		},
+	}
+}
+
+func (p *Profiler) Record(kind Kind, resourceType string, duration time.Duration, apiCalls int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	profile, exist := p.profiles[kind][resourceType]
+	if !exist {
+		profile = &TypeProfile{ResourceType: resourceType}
+		p.profiles[kind][resourceType] = profile
+	}
+	profile.duration += duration
+	profile.DurationMs = profile.duration.Milliseconds()
+	profile.Calls++
+	profile.ApiCalls += apiCalls
+	if err != nil {
+		profile.Errors++
+	}
+}
+
+func (p *Profiler) Report() *Report {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &Report{
+		Enumeration:     sorted(p.profiles[Enumeration]),
+		DetailsFetching: sorted(p.profiles[DetailsFetching]),
+	}
+}
+
+func sorted(profiles map[string]*TypeProfile) []TypeProfile {
+	result := make([]TypeProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		result = append(result, *profile)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].duration == result[j].duration {
+			return result[i].ResourceType < result[j].ResourceType
+		}
+		return result[i].duration > result[j].duration
+	})
+	return result
+}
@@ -0,0 +1,45 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountApiCall(t *testing.T) {
+	counter := &Counter{}
+	ctx := WithCounter(context.Background(), counter)
+	CountApiCall(ctx)
+	CountApiCall(ctx)
+	// Calls made without a counter are ignored
+	CountApiCall(context.Background())
+	assert.Equal(t, 2, counter.ApiCalls())
+}
+
+func TestProfiler_Report(t *testing.T) {
+	profiler := NewProfiler()
+	profiler.Record(Enumeration, "aws_s3_bucket", 2*time.Second, 12, nil)
+	profiler.Record(Enumeration, "aws_iam_user", 500*time.Millisecond, 1, errors.New("AccessDenied"))
+	profiler.Record(Enumeration, "aws_iam_role", 3*time.Second, 40, nil)
+	profiler.Record(DetailsFetching, "aws_s3_bucket", 300*time.Millisecond, 1, nil)
+	profiler.Record(DetailsFetching, "aws_s3_bucket", 400*time.Millisecond, 1, errors.New("not found"))
+
+	report := profiler.Report()
+	assert.Equal(t, []TypeProfile{
+		{ResourceType: "aws_iam_role", DurationMs: 3000, Calls: 1, ApiCalls: 40, duration: 3 * time.Second},
+		{ResourceType: "aws_s3_bucket", DurationMs: 2000, Calls: 1, ApiCalls: 12, duration: 2 * time.Second},
+		{ResourceType: "aws_iam_user", DurationMs: 500, Calls: 1, ApiCalls: 1, Errors: 1, duration: 500 * time.Millisecond},
+	}, report.Enumeration)
+	assert.Equal(t, []TypeProfile{
+		{ResourceType: "aws_s3_bucket", DurationMs: 700, Calls: 2, ApiCalls: 2, Errors: 1, duration: 700 * time.Millisecond},
+	}, report.DetailsFetching)
+
+	slowest := report.Slowest(Enumeration, 2)
+	assert.Len(t, slowest, 2)
+	assert.Equal(t, "aws_iam_role", slowest[0].ResourceType)
+	assert.Equal(t, 3*time.Second, slowest[0].Duration())
+	assert.Len(t, report.Slowest(DetailsFetching, 10), 1)
+}
@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRate is the default number of requests per second allowed for each cloud service
+	DefaultRate = 50
+	// minRate is the lowest rate the limiter backs off to after throttling errors
+	minRate = 1
+	// recoveryStep is the rate increase applied after each successful request
+	recoveryStep = 0.1
+)
+
+// throttlingErrors are error codes or messages returned by cloud services when they throttle requests.
+// They are used to detect throttling of requests made through terraform providers, which only give us the error message.
+var throttlingErrors = []string{
+	"Throttling",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"TooManyRequests",
+	"Rate exceeded",
+	"SlowDown",
+}
+
+// Limiter holds a token bucket per cloud service.
+// The rate of a bucket is halved each time the service throttles us, and slowly
+// increases back to the configured rate as requests succeed.
+type Limiter struct {
+	rate      float64
+	mu        sync.Mutex
+	buckets   map[string]*rate.Limiter
+	throttles map[string]int
+	// throttles already returned by FlushThrottles
+	flushed map[string]int
+}
+
+// NewLimiter creates a limiter allowing r requests per second for each service.
+// A rate lower than or equal to zero disables rate limiting, but throttles are still counted.
+func NewLimiter(r float64) *Limiter {
+	return &Limiter{
+		rate:      r,
+		buckets:   map[string]*rate.Limiter{},
+		throttles: map[string]int{},
+		flushed:   map[string]int{},
+	}
+}
+
+// burst returns the bucket size for a given rate, so that a backed off service
+// cannot receive a burst of requests sized for the configured rate
+func burst(limit rate.Limit) int {
+	if limit < 1 {
+		return 1
+	}
+	return int(limit)
+}
+
+func (l *Limiter) bucket(service string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, exist := l.buckets[service]
+	if !exist {
+		b = rate.NewLimiter(rate.Inf, 1)
+		if l.rate > 0 {
+			b = rate.NewLimiter(rate.Limit(l.rate), burst(rate.Limit(l.rate)))
+		}
+		l.buckets[service] = b
+	}
+	return b
+}
+
+// Wait blocks until a request to the given service is allowed
+func (l *Limiter) Wait(ctx context.Context, service string) error {
+	return l.bucket(service).Wait(ctx)
+}
+
+// Throttled records a throttling error returned by the given service and backs off
+func (l *Limiter) Throttled(service string) {
+	b := l.bucket(service)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.throttles[service]++
+
+	if l.rate <= 0 {
+		return
+	}
+	limit := b.Limit() / 2
+	if limit < minRate {
+		limit = minRate
+	}
+	b.SetLimit(limit)
+	b.SetBurst(burst(limit))
+	logrus.WithFields(logrus.Fields{
+		"service": service,
+		"rate":    float64(limit),
+	}).Debug("Got throttled, reducing request rate")
+}
+
+// Succeeded records a successful request to the given service and recovers the rate
+func (l *Limiter) Succeeded(service string) {
+	if l.rate <= 0 {
+		return
+	}
+	b := l.bucket(service)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit := b.Limit()
+	if float64(limit) >= l.rate {
+		return
+	}
+	limit += recoveryStep
+	if float64(limit) > l.rate {
+		limit = rate.Limit(l.rate)
+	}
+	b.SetLimit(limit)
+	b.SetBurst(burst(limit))
+}
+
+// Throttles returns the number of throttling errors by service
+func (l *Limiter) Throttles() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	throttles := make(map[string]int, len(l.throttles))
+	for service, count := range l.throttles {
+		throttles[service] = count
+	}
+	return throttles
+}
+
+// FlushThrottles returns the number of throttling errors by service counted since the previous flush.
+// It allows to report throttling once per enumeration when the limiter is shared by several of them.
+func (l *Limiter) FlushThrottles() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	throttles := make(map[string]int)
+	for service, count := range l.throttles {
+		if count > l.flushed[service] {
+			throttles[service] = count - l.flushed[service]
+		}
+		l.flushed[service] = count
+	}
+	return throttles
+}
+
+// SortedServices returns the sorted list of services of a throttles map
+func SortedServices(throttles map[string]int) []string {
+	services := make([]string, 0, len(throttles))
+	for service := range throttles {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// IsThrottlingError returns true when an error message looks like a throttling error
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, throttlingError := range throttlingErrors {
+		if strings.Contains(err.Error(), throttlingError) {
+			return true
+		}
+	}
+	return false
+}
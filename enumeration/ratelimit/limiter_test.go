@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestLimiter_Throttled(t *testing.T) {
+	l := NewLimiter(10)
+
+	l.Throttled("iam")
+	assert.Equal(t, rate.Limit(5), l.bucket("iam").Limit())
+	assert.Equal(t, rate.Limit(10), l.bucket("route53").Limit())
+
+	for i := 0; i < 10; i++ {
+		l.Throttled("iam")
+	}
+	assert.Equal(t, rate.Limit(minRate), l.bucket("iam").Limit())
+
+	assert.Equal(t, 1, l.bucket("iam").Burst())
+	assert.Equal(t, 10, l.bucket("route53").Burst())
+
+	assert.Equal(t, map[string]int{"iam": 11}, l.Throttles())
+}
+
+func TestLimiter_FlushThrottles(t *testing.T) {
+	l := NewLimiter(10)
+
+	l.Throttled("iam")
+	l.Throttled("iam")
+	l.Throttled("ec2")
+	assert.Equal(t, map[string]int{"iam": 2, "ec2": 1}, l.FlushThrottles())
+	assert.Equal(t, map[string]int{}, l.FlushThrottles())
+
+	l.Throttled("iam")
+	assert.Equal(t, map[string]int{"iam": 1}, l.FlushThrottles())
+	assert.Equal(t, map[string]int{"iam": 3, "ec2": 1}, l.Throttles())
+	assert.Equal(t, []string{"ec2", "iam"}, SortedServices(map[string]int{"iam": 1, "ec2": 1}))
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	assert.False(t, IsThrottlingError(nil))
+	assert.False(t, IsThrottlingError(errors.New("AccessDenied: User is not authorized")))
+	assert.True(t, IsThrottlingError(errors.New("error reading IAM Role (foo): Throttling: Rate exceeded")))
+	assert.True(t, IsThrottlingError(errors.New("RequestLimitExceeded: Request limit exceeded.")))
+}
+
+func TestLimiter_Succeeded(t *testing.T) {
+	l := NewLimiter(10)
+
+	l.Throttled("iam")
+	l.Succeeded("iam")
+	assert.InDelta(t, 5+recoveryStep, float64(l.bucket("iam").Limit()), 0.0001)
+	assert.Equal(t, 5, l.bucket("iam").Burst())
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded("iam")
+	}
+	assert.Equal(t, rate.Limit(10), l.bucket("iam").Limit())
+	assert.Equal(t, 10, l.bucket("iam").Burst())
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	l := NewLimiter(0)
+
+	l.Throttled("iam")
+	l.Succeeded("iam")
+	assert.Equal(t, rate.Inf, l.bucket("iam").Limit())
+	assert.Equal(t, map[string]int{"iam": 1}, l.Throttles())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, l.Wait(ctx, "iam"))
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	l := NewLimiter(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	assert.NoError(t, l.Wait(ctx, "iam"))
+	assert.Error(t, l.Wait(ctx, "iam"))
+	assert.NoError(t, l.Wait(ctx, "route53"))
+}
@@ -3,6 +3,7 @@ package alerts
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/common"
@@ -112,3 +113,94 @@ func SendEnumerationAlert(provider string, alerter alerter.AlerterInterface, lis
 func SendDetailsFetchingAlert(provider string, alerter alerter.AlerterInterface, listError *remoteerror.ResourceScanningError) {
 	sendRemoteAccessDeniedAlert(provider, alerter, listError, DetailsFetchingPhase)
 }
+
+type ThrottlingAlert struct {
+	service string
+	count   int
+}
+
+func NewThrottlingAlert(service string, count int) *ThrottlingAlert {
+	return &ThrottlingAlert{service, count}
+}
+
+func (e *ThrottlingAlert) Message() string {
+	return fmt.Sprintf("Requests to %s were throttled %d time(s) by the cloud provider, the request rate has been reduced", e.service, e.count)
+}
+
+func (e *ThrottlingAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+func (e *ThrottlingAlert) Resource() *resource.Resource {
+	return nil
+}
+
+type TimeoutAlert struct {
+	message  string
+	resource *resource.Resource
+}
+
+func NewEnumerationTimeoutAlert(resourceType string, timeout time.Duration) *TimeoutAlert {
+	return &TimeoutAlert{
+		message: fmt.Sprintf("Listing %s did not complete within %s, resources of this type have been ignored", resourceType, timeout),
+	}
+}
+
+func NewDetailsFetchingTimeoutAlert(res *resource.Resource, timeout time.Duration) *TimeoutAlert {
+	return &TimeoutAlert{
+		message:  fmt.Sprintf("Reading details of %s.%s did not complete within %s, this resource has been ignored", res.ResourceType(), res.ResourceId(), timeout),
+		resource: res,
+	}
+}
+
+func (e *TimeoutAlert) Message() string {
+	return e.message
+}
+
+func (e *TimeoutAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *TimeoutAlert) Resource() *resource.Resource {
+	return e.resource
+}
+
+type RefreshUnsupportedAlert struct {
+	resourceType string
+}
+
+func NewRefreshUnsupportedAlert(resourceType string) *RefreshUnsupportedAlert {
+	return &RefreshUnsupportedAlert{resourceType}
+}
+
+func (e *RefreshUnsupportedAlert) Message() string {
+	return fmt.Sprintf("%s can not be read from the cloud provider, resources of this type have been ignored", e.resourceType)
+}
+
+func (e *RefreshUnsupportedAlert) ShouldIgnoreResource() bool {
+	return true
+}
+
+func (e *RefreshUnsupportedAlert) Resource() *resource.Resource {
+	return nil
+}
+
+type AttributionAlert struct {
+	err string
+}
+
+func NewAttributionAlert(err error) *AttributionAlert {
+	return &AttributionAlert{err.Error()}
+}
+
+func (e *AttributionAlert) Message() string {
+	return fmt.Sprintf("Unable to retrieve resources attribution from cloud provider audit logs: %s", e.err)
+}
+
+func (e *AttributionAlert) ShouldIgnoreResource() bool {
+	return false
+}
+
+func (e *AttributionAlert) Resource() *resource.Resource {
+	return nil
+}
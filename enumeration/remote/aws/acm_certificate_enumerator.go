@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ACMCertificateEnumerator struct {
+	repository repository.ACMRepository
+	factory    resource.ResourceFactory
+}
+
+func NewACMCertificateEnumerator(repo repository.ACMRepository, factory resource.ResourceFactory) *ACMCertificateEnumerator {
+	return &ACMCertificateEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ACMCertificateEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsAcmCertificateResourceType
+}
+
+func (e *ACMCertificateEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	certificates, err := e.repository.ListAllCertificates(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(certificates))
+
+	for _, certificate := range certificates {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*certificate.CertificateArn,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// S3 bucket sub resources are read in the region of their bucket
+var s3BucketSubResourceTypes = map[string]struct{}{
+	aws.AwsS3BucketAnalyticsConfigurationResourceType: {},
+	aws.AwsS3BucketInventoryResourceType:              {},
+	aws.AwsS3BucketMetricResourceType:                 {},
+	aws.AwsS3BucketNotificationResourceType:           {},
+	aws.AwsS3BucketPolicyResourceType:                 {},
+}
+
+var wafv2ScopedResourceTypes = map[string]struct{}{
+	aws.AwsWafv2WebAclResourceType:    {},
+	aws.AwsWafv2IpSetResourceType:     {},
+	aws.AwsWafv2RuleGroupResourceType: {},
+}
+
+// resolveAlias returns the region used by enumerators for resources found in IaC,
+// so that their details are not read from the default region of the provider
+func resolveAlias(res *resource.Resource, resources map[string][]*resource.Resource) string {
+	if res.ResourceType() == aws.AwsS3BucketResourceType {
+		return stringAttribute(res, "region")
+	}
+	if _, exist := s3BucketSubResourceTypes[res.ResourceType()]; exist {
+		bucket := stringAttribute(res, "bucket")
+		for _, b := range resources[aws.AwsS3BucketResourceType] {
+			if b.ResourceId() == bucket {
+				return stringAttribute(b, "region")
+			}
+		}
+		return ""
+	}
+	if _, exist := wafv2ScopedResourceTypes[res.ResourceType()]; exist && stringAttribute(res, "scope") == wafv2.ScopeCloudfront {
+		return repository.GlobalRegion
+	}
+	return ""
+}
+
+func stringAttribute(res *resource.Resource, name string) string {
+	if res.Attributes() == nil {
+		return ""
+	}
+	if value := res.Attributes().GetString(name); value != nil {
+		return *value
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveAlias(t *testing.T) {
+	bucket := &resource.Resource{Id: "paris-bucket", Type: aws.AwsS3BucketResourceType, Attrs: &resource.Attributes{"bucket": "paris-bucket", "region": "eu-west-3"}}
+	resources := map[string][]*resource.Resource{
+		aws.AwsS3BucketResourceType: {bucket},
+	}
+
+	cases := []struct {
+		name     string
+		resource *resource.Resource
+		expected string
+	}{
+		{
+			name:     "bucket in a non default region",
+			resource: bucket,
+			expected: "eu-west-3",
+		},
+		{
+			name:     "bucket without region",
+			resource: &resource.Resource{Id: "bucket", Type: aws.AwsS3BucketResourceType, Attrs: &resource.Attributes{}},
+			expected: "",
+		},
+		{
+			name:     "bucket sub resource in the region of its bucket",
+			resource: &resource.Resource{Id: "paris-bucket", Type: aws.AwsS3BucketPolicyResourceType, Attrs: &resource.Attributes{"bucket": "paris-bucket"}},
+			expected: "eu-west-3",
+		},
+		{
+			name:     "bucket sub resource with a bucket missing from IaC",
+			resource: &resource.Resource{Id: "other-bucket:inventory", Type: aws.AwsS3BucketInventoryResourceType, Attrs: &resource.Attributes{"bucket": "other-bucket"}},
+			expected: "",
+		},
+		{
+			name:     "cloudfront web ACL",
+			resource: &resource.Resource{Id: "acl", Type: aws.AwsWafv2WebAclResourceType, Attrs: &resource.Attributes{"scope": "CLOUDFRONT"}},
+			expected: "us-east-1",
+		},
+		{
+			name:     "regional web ACL",
+			resource: &resource.Resource{Id: "acl", Type: aws.AwsWafv2WebAclResourceType, Attrs: &resource.Attributes{"scope": "REGIONAL"}},
+			expected: "",
+		},
+		{
+			name:     "resource without alias",
+			resource: &resource.Resource{Id: "user", Type: aws.AwsIamUserResourceType, Attrs: &resource.Attributes{"region": "eu-west-3"}},
+			expected: "",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, resolveAlias(c.resource, resources))
+		})
+	}
+}
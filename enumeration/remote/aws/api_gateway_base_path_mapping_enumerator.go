@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,8 +27,8 @@ func (e *ApiGatewayBasePathMappingEnumerator) SupportedType() resource.ResourceT
 	return aws.AwsApiGatewayBasePathMappingResourceType
 }
 
-func (e *ApiGatewayBasePathMappingEnumerator) Enumerate() ([]*resource.Resource, error) {
-	domainNames, err := e.repository.ListAllDomainNames()
+func (e *ApiGatewayBasePathMappingEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domainNames, err := e.repository.ListAllDomainNames(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayDomainNameResourceType)
 	}
@@ -35,7 +37,7 @@ func (e *ApiGatewayBasePathMappingEnumerator) Enumerate() ([]*resource.Resource,
 
 	for _, domainName := range domainNames {
 		d := domainName
-		mappings, err := e.repository.ListAllDomainNameBasePathMappings(*d.DomainName)
+		mappings, err := e.repository.ListAllDomainNameBasePathMappings(ctx, *d.DomainName)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
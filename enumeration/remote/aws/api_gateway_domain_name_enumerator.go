@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayDomainNameEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayDomainNameResourceType
 }
 
-func (e *ApiGatewayDomainNameEnumerator) Enumerate() ([]*resource.Resource, error) {
-	domainNames, err := e.repository.ListAllDomainNames()
+func (e *ApiGatewayDomainNameEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domainNames, err := e.repository.ListAllDomainNames(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"strings"
@@ -25,8 +27,8 @@ func (e *ApiGatewayIntegrationEnumerator) SupportedType() resource.ResourceType
 	return aws.AwsApiGatewayIntegrationResourceType
 }
 
-func (e *ApiGatewayIntegrationEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayIntegrationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -35,7 +37,7 @@ func (e *ApiGatewayIntegrationEnumerator) Enumerate() ([]*resource.Resource, err
 
 	for _, api := range apis {
 		a := api
-		resources, err := e.repository.ListAllRestApiResources(*a.Id)
+		resources, err := e.repository.ListAllRestApiResources(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayResourceResourceType)
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayModelEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayModelResourceType
 }
 
-func (e *ApiGatewayModelEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllRestApis()
+func (e *ApiGatewayModelEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllRestApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayRestApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayModelEnumerator) Enumerate() ([]*resource.Resource, error) {
 
 	for _, api := range apis {
 		a := api
-		models, err := e.repository.ListAllRestApiModels(*a.Id)
+		models, err := e.repository.ListAllRestApiModels(ctx, *a.Id)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
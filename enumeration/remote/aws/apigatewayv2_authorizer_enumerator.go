@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *ApiGatewayV2AuthorizerEnumerator) SupportedType() resource.ResourceType
 	return aws.AwsApiGatewayV2AuthorizerResourceType
 }
 
-func (e *ApiGatewayV2AuthorizerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2AuthorizerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *ApiGatewayV2AuthorizerEnumerator) Enumerate() ([]*resource.Resource, er
 
 	for _, api := range apis {
 		a := api
-		authorizers, err := e.repository.ListAllApiAuthorizers(*a.ApiId)
+		authorizers, err := e.repository.ListAllApiAuthorizers(ctx, *a.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,15 +25,15 @@ func (e *ApiGatewayV2ModelEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2ModelResourceType
 }
 
-func (e *ApiGatewayV2ModelEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2ModelEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
 
 	var results []*resource.Resource
 	for _, api := range apis {
-		models, err := e.repository.ListAllApiModels(*api.ApiId)
+		models, err := e.repository.ListAllApiModels(ctx, *api.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,15 +25,15 @@ func (e *ApiGatewayV2RouteEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsApiGatewayV2RouteResourceType
 }
 
-func (e *ApiGatewayV2RouteEnumerator) Enumerate() ([]*resource.Resource, error) {
-	apis, err := e.repository.ListAllApis()
+func (e *ApiGatewayV2RouteEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	apis, err := e.repository.ListAllApis(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsApiGatewayV2ApiResourceType)
 	}
 
 	var results []*resource.Resource
 	for _, api := range apis {
-		routes, err := e.repository.ListAllApiRoutes(api.ApiId)
+		routes, err := e.repository.ListAllApiRoutes(ctx, api.ApiId)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
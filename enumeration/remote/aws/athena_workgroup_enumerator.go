@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type AthenaWorkgroupEnumerator struct {
+	repository repository.AthenaRepository
+	factory    resource.ResourceFactory
+}
+
+func NewAthenaWorkgroupEnumerator(repo repository.AthenaRepository, factory resource.ResourceFactory) *AthenaWorkgroupEnumerator {
+	return &AthenaWorkgroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *AthenaWorkgroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsAthenaWorkgroupResourceType
+}
+
+func (e *AthenaWorkgroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	workGroups, err := e.repository.ListAllWorkGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(workGroups))
+
+	for _, workGroup := range workGroups {
+		// The primary workgroup is created by AWS and cannot be deleted
+		if *workGroup.Name == "primary" {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*workGroup.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -9,6 +9,10 @@ import (
 	s3controliface "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
 
 	s3iface "github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	shieldiface "github.com/aws/aws-sdk-go/service/shield/shieldiface"
+
+	wafv2iface "github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 )
 
 // MockAwsClientFactoryInterface is an autogenerated mock type for the AwsClientFactoryInterface type
@@ -60,6 +64,50 @@ func (_m *MockAwsClientFactoryInterface) GetS3ControlClient(configs ...*aws.Conf
 	return r0
 }
 
+// GetShieldClient provides a mock function with given fields: configs
+func (_m *MockAwsClientFactoryInterface) GetShieldClient(configs ...*aws.Config) shieldiface.ShieldAPI {
+	_va := make([]interface{}, len(configs))
+	for _i := range configs {
+		_va[_i] = configs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 shieldiface.ShieldAPI
+	if rf, ok := ret.Get(0).(func(...*aws.Config) shieldiface.ShieldAPI); ok {
+		r0 = rf(configs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(shieldiface.ShieldAPI)
+		}
+	}
+
+	return r0
+}
+
+// GetWAFV2Client provides a mock function with given fields: configs
+func (_m *MockAwsClientFactoryInterface) GetWAFV2Client(configs ...*aws.Config) wafv2iface.WAFV2API {
+	_va := make([]interface{}, len(configs))
+	for _i := range configs {
+		_va[_i] = configs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 wafv2iface.WAFV2API
+	if rf, ok := ret.Get(0).(func(...*aws.Config) wafv2iface.WAFV2API); ok {
+		r0 = rf(configs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(wafv2iface.WAFV2API)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockAwsClientFactoryInterface interface {
 	mock.TestingT
 	Cleanup(func())
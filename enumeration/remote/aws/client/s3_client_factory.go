@@ -7,11 +7,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/shield/shieldiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 )
 
 type AwsClientFactoryInterface interface {
 	GetS3Client(configs ...*aws.Config) s3iface.S3API
 	GetS3ControlClient(configs ...*aws.Config) s3controliface.S3ControlAPI
+	GetWAFV2Client(configs ...*aws.Config) wafv2iface.WAFV2API
+	GetShieldClient(configs ...*aws.Config) shieldiface.ShieldAPI
 }
 
 type AwsClientFactory struct {
@@ -29,3 +35,11 @@ func (s AwsClientFactory) GetS3Client(configs ...*aws.Config) s3iface.S3API {
 func (s AwsClientFactory) GetS3ControlClient(configs ...*aws.Config) s3controliface.S3ControlAPI {
 	return s3control.New(s.config, configs...)
 }
+
+func (s AwsClientFactory) GetWAFV2Client(configs ...*aws.Config) wafv2iface.WAFV2API {
+	return wafv2.New(s.config, configs...)
+}
+
+func (s AwsClientFactory) GetShieldClient(configs ...*aws.Config) shieldiface.ShieldAPI {
+	return shield.New(s.config, configs...)
+}
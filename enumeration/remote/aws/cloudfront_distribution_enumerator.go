@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *CloudfrontDistributionEnumerator) SupportedType() resource.ResourceType
 	return aws.AwsCloudfrontDistributionResourceType
 }
 
-func (e *CloudfrontDistributionEnumerator) Enumerate() ([]*resource.Resource, error) {
-	distributions, err := e.repository.ListAllDistributions()
+func (e *CloudfrontDistributionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	distributions, err := e.repository.ListAllDistributions(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchDashboardEnumerator struct {
+	repository repository.CloudwatchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchDashboardEnumerator(repo repository.CloudwatchRepository, factory resource.ResourceFactory) *CloudwatchDashboardEnumerator {
+	return &CloudwatchDashboardEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchDashboardEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchDashboardResourceType
+}
+
+func (e *CloudwatchDashboardEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	dashboards, err := e.repository.ListAllDashboards(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(dashboards))
+
+	for _, dashboard := range dashboards {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*dashboard.DashboardName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
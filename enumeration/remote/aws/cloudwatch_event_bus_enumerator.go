@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+const defaultEventBusName = "default"
+
+type CloudwatchEventBusEnumerator struct {
+	repository repository.CloudwatchEventsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchEventBusEnumerator(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) *CloudwatchEventBusEnumerator {
+	return &CloudwatchEventBusEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchEventBusEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventBusResourceType
+}
+
+func (e *CloudwatchEventBusEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventBuses, err := e.repository.ListAllEventBuses(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(eventBuses))
+
+	for _, eventBus := range eventBuses {
+		// The default event bus exists in every account and cannot be managed by terraform
+		if *eventBus.Name == defaultEventBusName {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*eventBus.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchEventRuleEnumerator struct {
+	repository repository.CloudwatchEventsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchEventRuleEnumerator(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) *CloudwatchEventRuleEnumerator {
+	return &CloudwatchEventRuleEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchEventRuleEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventRuleResourceType
+}
+
+func (e *CloudwatchEventRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventBuses, err := e.repository.ListAllEventBuses(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventBusResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, eventBus := range eventBuses {
+		rules, err := e.repository.ListAllRules(ctx, *eventBus.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, rule := range rules {
+			// Rules managed by other AWS services cannot be managed by terraform
+			if rule.ManagedBy != nil {
+				continue
+			}
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					cloudwatchEventRuleId(*eventBus.Name, *rule.Name),
+					map[string]interface{}{
+						"name":           *rule.Name,
+						"event_bus_name": *eventBus.Name,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
+
+// cloudwatchEventRuleId composes rule ids the way the terraform provider does,
+// rules of the default event bus are only identified by their name
+func cloudwatchEventRuleId(eventBusName, ruleName string) string {
+	if eventBusName == "" || eventBusName == defaultEventBusName {
+		return ruleName
+	}
+	return eventBusName + "/" + ruleName
+}
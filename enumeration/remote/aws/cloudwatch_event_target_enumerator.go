@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchEventTargetEnumerator struct {
+	repository repository.CloudwatchEventsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchEventTargetEnumerator(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) *CloudwatchEventTargetEnumerator {
+	return &CloudwatchEventTargetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchEventTargetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchEventTargetResourceType
+}
+
+func (e *CloudwatchEventTargetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	eventBuses, err := e.repository.ListAllEventBuses(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventBusResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, eventBus := range eventBuses {
+		rules, err := e.repository.ListAllRules(ctx, *eventBus.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchEventRuleResourceType)
+		}
+
+		for _, rule := range rules {
+			if rule.ManagedBy != nil {
+				continue
+			}
+
+			targets, err := e.repository.ListAllTargets(ctx, *eventBus.Name, *rule.Name)
+			if err != nil {
+				return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+			}
+
+			for _, target := range targets {
+				results = append(
+					results,
+					e.factory.CreateAbstractResource(
+						string(e.SupportedType()),
+						// Target ids are composed the same way as rule ids, suffixed with the target id
+						cloudwatchEventRuleId(*eventBus.Name, *rule.Name)+"-"+*target.Id,
+						map[string]interface{}{
+							"rule":           *rule.Name,
+							"target_id":      *target.Id,
+							"event_bus_name": *eventBus.Name,
+						},
+					),
+				)
+			}
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchLogMetricFilterEnumerator struct {
+	repository repository.CloudwatchLogsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchLogMetricFilterEnumerator(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) *CloudwatchLogMetricFilterEnumerator {
+	return &CloudwatchLogMetricFilterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchLogMetricFilterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchLogMetricFilterResourceType
+}
+
+func (e *CloudwatchLogMetricFilterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	metricFilters, err := e.repository.ListAllMetricFilters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(metricFilters))
+
+	for _, metricFilter := range metricFilters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*metricFilter.FilterName,
+				map[string]interface{}{
+					"name":           *metricFilter.FilterName,
+					"log_group_name": *metricFilter.LogGroupName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
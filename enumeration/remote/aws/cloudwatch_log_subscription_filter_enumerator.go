@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchLogSubscriptionFilterEnumerator struct {
+	repository repository.CloudwatchLogsRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchLogSubscriptionFilterEnumerator(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) *CloudwatchLogSubscriptionFilterEnumerator {
+	return &CloudwatchLogSubscriptionFilterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchLogSubscriptionFilterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchLogSubscriptionFilterResourceType
+}
+
+func (e *CloudwatchLogSubscriptionFilterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	logGroups, err := e.repository.ListAllLogGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCloudwatchLogGroupResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, logGroup := range logGroups {
+		subscriptionFilters, err := e.repository.ListAllSubscriptionFilters(ctx, *logGroup.LogGroupName)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, subscriptionFilter := range subscriptionFilters {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					aws.CreateCloudwatchLogSubscriptionFilterID(*logGroup.LogGroupName),
+					map[string]interface{}{
+						"name":           *subscriptionFilter.FilterName,
+						"log_group_name": *logGroup.LogGroupName,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CloudwatchMetricAlarmEnumerator struct {
+	repository repository.CloudwatchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCloudwatchMetricAlarmEnumerator(repo repository.CloudwatchRepository, factory resource.ResourceFactory) *CloudwatchMetricAlarmEnumerator {
+	return &CloudwatchMetricAlarmEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CloudwatchMetricAlarmEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCloudwatchMetricAlarmResourceType
+}
+
+func (e *CloudwatchMetricAlarmEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	alarms, err := e.repository.ListAllMetricAlarms(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(alarms))
+
+	for _, alarm := range alarms {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*alarm.AlarmName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CognitoIdentityPoolEnumerator struct {
+	repository repository.CognitoIdentityRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCognitoIdentityPoolEnumerator(repo repository.CognitoIdentityRepository, factory resource.ResourceFactory) *CognitoIdentityPoolEnumerator {
+	return &CognitoIdentityPoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CognitoIdentityPoolEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCognitoIdentityPoolResourceType
+}
+
+func (e *CognitoIdentityPoolEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	identityPools, err := e.repository.ListAllIdentityPools(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(identityPools))
+
+	for _, identityPool := range identityPools {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*identityPool.IdentityPoolId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CognitoUserPoolClientEnumerator struct {
+	repository repository.CognitoIdentityProviderRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCognitoUserPoolClientEnumerator(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) *CognitoUserPoolClientEnumerator {
+	return &CognitoUserPoolClientEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CognitoUserPoolClientEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCognitoUserPoolClientResourceType
+}
+
+func (e *CognitoUserPoolClientEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	userPools, err := e.repository.ListAllUserPools(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsCognitoUserPoolResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, userPool := range userPools {
+		clients, err := e.repository.ListAllUserPoolClients(ctx, *userPool.Id)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, client := range clients {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*client.ClientId,
+					map[string]interface{}{
+						"user_pool_id": *client.UserPoolId,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type CognitoUserPoolEnumerator struct {
+	repository repository.CognitoIdentityProviderRepository
+	factory    resource.ResourceFactory
+}
+
+func NewCognitoUserPoolEnumerator(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) *CognitoUserPoolEnumerator {
+	return &CognitoUserPoolEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *CognitoUserPoolEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsCognitoUserPoolResourceType
+}
+
+func (e *CognitoUserPoolEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	userPools, err := e.repository.ListAllUserPools(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(userPools))
+
+	for _, userPool := range userPools {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*userPool.Id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource/aws"
@@ -24,8 +26,8 @@ func (e *DefaultVPCEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDefaultVpcResourceType
 }
 
-func (e *DefaultVPCEnumerator) Enumerate() ([]*resource.Resource, error) {
-	_, defaultVPCs, err := e.repo.ListAllVPCs()
+func (e *DefaultVPCEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	_, defaultVPCs, err := e.repo.ListAllVPCs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type DocDBClusterEnumerator struct {
+	repository repository.DocDBRepository
+	factory    resource.ResourceFactory
+}
+
+func NewDocDBClusterEnumerator(repo repository.DocDBRepository, factory resource.ResourceFactory) *DocDBClusterEnumerator {
+	return &DocDBClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *DocDBClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsDocDBClusterResourceType
+}
+
+func (e *DocDBClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllDBClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.DBClusterIdentifier,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
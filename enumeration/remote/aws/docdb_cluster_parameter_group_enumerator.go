@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type DocDBClusterParameterGroupEnumerator struct {
+	repository repository.DocDBRepository
+	factory    resource.ResourceFactory
+}
+
+func NewDocDBClusterParameterGroupEnumerator(repo repository.DocDBRepository, factory resource.ResourceFactory) *DocDBClusterParameterGroupEnumerator {
+	return &DocDBClusterParameterGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *DocDBClusterParameterGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsDocDBClusterParameterGroupResourceType
+}
+
+func (e *DocDBClusterParameterGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	parameterGroups, err := e.repository.ListAllDBClusterParameterGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(parameterGroups))
+
+	for _, parameterGroup := range parameterGroups {
+		// Parameter groups are shared with RDS, only keep DocumentDB ones
+		if !strings.HasPrefix(awssdk.StringValue(parameterGroup.DBParameterGroupFamily), "docdb") {
+			continue
+		}
+		// Default parameter groups are managed by AWS and cannot be modified
+		if strings.HasPrefix(*parameterGroup.DBClusterParameterGroupName, "default.") {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*parameterGroup.DBClusterParameterGroupName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *DynamoDBTableEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsDynamodbTableResourceType
 }
 
-func (e *DynamoDBTableEnumerator) Enumerate() ([]*resource.Resource, error) {
-	tables, err := e.repository.ListAllTables()
+func (e *DynamoDBTableEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	tables, err := e.repository.ListAllTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
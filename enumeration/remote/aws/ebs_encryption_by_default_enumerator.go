@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2EbsEncryptionByDefaultEnumerator) SupportedType() resource.ResourceT
 	return aws.AwsEbsEncryptionByDefaultResourceType
 }
 
-func (e *EC2EbsEncryptionByDefaultEnumerator) Enumerate() ([]*resource.Resource, error) {
-	enabled, err := e.repository.IsEbsEncryptionEnabledByDefault()
+func (e *EC2EbsEncryptionByDefaultEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	enabled, err := e.repository.IsEbsEncryptionEnabledByDefault(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2EbsVolumeEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEbsVolumeResourceType
 }
 
-func (e *EC2EbsVolumeEnumerator) Enumerate() ([]*resource.Resource, error) {
-	volumes, err := e.repository.ListAllVolumes()
+func (e *EC2EbsVolumeEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	volumes, err := e.repository.ListAllVolumes(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
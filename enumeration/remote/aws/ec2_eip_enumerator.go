@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,8 +26,8 @@ func (e *EC2EipEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsEipResourceType
 }
 
-func (e *EC2EipEnumerator) Enumerate() ([]*resource.Resource, error) {
-	addresses, err := e.repository.ListAllAddresses()
+func (e *EC2EipEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	addresses, err := e.repository.ListAllAddresses(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2InstanceEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsInstanceResourceType
 }
 
-func (e *EC2InstanceEnumerator) Enumerate() ([]*resource.Resource, error) {
-	instances, err := e.repository.ListAllInstances()
+func (e *EC2InstanceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	instances, err := e.repository.ListAllInstances(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
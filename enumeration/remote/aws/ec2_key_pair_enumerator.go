@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2KeyPairEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsKeyPairResourceType
 }
 
-func (e *EC2KeyPairEnumerator) Enumerate() ([]*resource.Resource, error) {
-	keyPairs, err := e.repository.ListAllKeyPairs()
+func (e *EC2KeyPairEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	keyPairs, err := e.repository.ListAllKeyPairs(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
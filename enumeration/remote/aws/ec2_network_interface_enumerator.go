@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2NetworkInterfaceEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2NetworkInterfaceEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2NetworkInterfaceEnumerator {
+	return &EC2NetworkInterfaceEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2NetworkInterfaceEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsNetworkInterfaceResourceType
+}
+
+func (e *EC2NetworkInterfaceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	networkInterfaces, err := e.repository.ListAllNetworkInterfaces(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(networkInterfaces))
+
+	for _, networkInterface := range networkInterfaces {
+		// Interfaces created by AWS services on our behalf (NAT gateways, load balancers, VPC endpoints, lambda, ...)
+		if awssdk.BoolValue(networkInterface.RequesterManaged) {
+			continue
+		}
+		// Primary interfaces are created with their instance and deleted along with it
+		if attachment := networkInterface.Attachment; attachment != nil &&
+			awssdk.Int64Value(attachment.DeviceIndex) == 0 &&
+			awssdk.BoolValue(attachment.DeleteOnTermination) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*networkInterface.NetworkInterfaceId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
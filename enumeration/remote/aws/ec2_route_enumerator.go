@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *EC2RouteEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsRouteResourceType
 }
 
-func (e *EC2RouteEnumerator) Enumerate() ([]*resource.Resource, error) {
-	routeTables, err := e.repository.ListAllRouteTables()
+func (e *EC2RouteEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	routeTables, err := e.repository.ListAllRouteTables(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsRouteTableResourceType)
 	}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2TransitGatewayRouteTableEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayRouteTableEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayRouteTableEnumerator {
+	return &EC2TransitGatewayRouteTableEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayRouteTableEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayRouteTableResourceType
+}
+
+func (e *EC2TransitGatewayRouteTableEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	routeTables, err := e.repository.ListAllTransitGatewayRouteTables(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(routeTables))
+
+	for _, routeTable := range routeTables {
+		if awssdk.StringValue(routeTable.State) == ec2.TransitGatewayRouteTableStateDeleted {
+			continue
+		}
+		// The default association route table is created along with the transit gateway
+		// and cannot be managed by an aws_ec2_transit_gateway_route_table resource
+		if awssdk.BoolValue(routeTable.DefaultAssociationRouteTable) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*routeTable.TransitGatewayRouteTableId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
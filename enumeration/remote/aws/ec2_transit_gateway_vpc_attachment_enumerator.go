@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EC2TransitGatewayVpcAttachmentEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewEC2TransitGatewayVpcAttachmentEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *EC2TransitGatewayVpcAttachmentEnumerator {
+	return &EC2TransitGatewayVpcAttachmentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEc2TransitGatewayVpcAttachmentResourceType
+}
+
+func (e *EC2TransitGatewayVpcAttachmentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	attachments, err := e.repository.ListAllTransitGatewayVpcAttachments(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		switch awssdk.StringValue(attachment.State) {
+		case ec2.TransitGatewayAttachmentStateDeleted,
+			ec2.TransitGatewayAttachmentStateRejected,
+			ec2.TransitGatewayAttachmentStateFailed:
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*attachment.TransitGatewayAttachmentId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ECSCapacityProviderEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSCapacityProviderEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSCapacityProviderEnumerator {
+	return &ECSCapacityProviderEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSCapacityProviderEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsCapacityProviderResourceType
+}
+
+func (e *ECSCapacityProviderEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	providers, err := e.repository.ListAllCapacityProviders(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(providers))
+
+	for _, provider := range providers {
+		// FARGATE and FARGATE_SPOT are managed by AWS and cannot be created with terraform
+		if provider.CapacityProviderArn == nil || *provider.Name == "FARGATE" || *provider.Name == "FARGATE_SPOT" {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*provider.CapacityProviderArn,
+				map[string]interface{}{
+					"name": *provider.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ECSClusterEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSClusterEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSClusterEnumerator {
+	return &ECSClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsClusterResourceType
+}
+
+func (e *ECSClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		// Deleted clusters are still described for a while
+		if cluster.Status != nil && *cluster.Status == "INACTIVE" {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterArn,
+				map[string]interface{}{
+					"name": *cluster.ClusterName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
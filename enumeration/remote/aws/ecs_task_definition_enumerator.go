@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ECSTaskDefinitionEnumerator struct {
+	repository repository.ECSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewECSTaskDefinitionEnumerator(repo repository.ECSRepository, factory resource.ResourceFactory) *ECSTaskDefinitionEnumerator {
+	return &ECSTaskDefinitionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ECSTaskDefinitionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEcsTaskDefinitionResourceType
+}
+
+func (e *ECSTaskDefinitionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	arns, err := e.repository.ListAllTaskDefinitions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(arns))
+
+	for _, arn := range arns {
+		// Terraform identifies task definitions by family, ARNs end with task-definition/<family>:<revision>
+		family := (*arn)[strings.LastIndex(*arn, "/")+1:]
+		if separator := strings.LastIndex(family, ":"); separator != -1 {
+			family = family[:separator]
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				family,
+				map[string]interface{}{
+					// The ARN is required to read the task definition details
+					"arn":    *arn,
+					"family": family,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EFSFileSystemEnumerator struct {
+	repository repository.EFSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEFSFileSystemEnumerator(repo repository.EFSRepository, factory resource.ResourceFactory) *EFSFileSystemEnumerator {
+	return &EFSFileSystemEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EFSFileSystemEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEfsFileSystemResourceType
+}
+
+func (e *EFSFileSystemEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	fileSystems, err := e.repository.ListAllFileSystems(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(fileSystems))
+
+	for _, fileSystem := range fileSystems {
+		if awssdk.StringValue(fileSystem.LifeCycleState) == efs.LifeCycleStateDeleted {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*fileSystem.FileSystemId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EFSMountTargetEnumerator struct {
+	repository repository.EFSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEFSMountTargetEnumerator(repo repository.EFSRepository, factory resource.ResourceFactory) *EFSMountTargetEnumerator {
+	return &EFSMountTargetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EFSMountTargetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEfsMountTargetResourceType
+}
+
+func (e *EFSMountTargetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	fileSystems, err := e.repository.ListAllFileSystems(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEfsFileSystemResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, fileSystem := range fileSystems {
+		mountTargets, err := e.repository.ListAllMountTargets(ctx, *fileSystem.FileSystemId)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, mountTarget := range mountTargets {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*mountTarget.MountTargetId,
+					map[string]interface{}{},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
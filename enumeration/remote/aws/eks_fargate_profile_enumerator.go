@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EKSFargateProfileEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSFargateProfileEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSFargateProfileEnumerator {
+	return &EKSFargateProfileEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSFargateProfileEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksFargateProfileResourceType
+}
+
+func (e *EKSFargateProfileEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		profiles, err := e.repository.ListAllFargateProfiles(ctx, *cluster)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, profile := range profiles {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					strings.Join([]string{*cluster, *profile}, ":"),
+					map[string]interface{}{
+						"cluster_name": *cluster,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
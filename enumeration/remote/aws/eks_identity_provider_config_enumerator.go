@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EKSIdentityProviderConfigEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSIdentityProviderConfigEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSIdentityProviderConfigEnumerator {
+	return &EKSIdentityProviderConfigEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSIdentityProviderConfigEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksIdentityProviderConfigResourceType
+}
+
+func (e *EKSIdentityProviderConfigEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		configs, err := e.repository.ListAllIdentityProviderConfigs(ctx, *cluster)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, config := range configs {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					strings.Join([]string{*cluster, *config.Name}, ":"),
+					map[string]interface{}{
+						"cluster_name": *cluster,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
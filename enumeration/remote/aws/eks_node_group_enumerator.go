@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type EKSNodeGroupEnumerator struct {
+	repository repository.EKSRepository
+	factory    resource.ResourceFactory
+}
+
+func NewEKSNodeGroupEnumerator(repo repository.EKSRepository, factory resource.ResourceFactory) *EKSNodeGroupEnumerator {
+	return &EKSNodeGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *EKSNodeGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsEksNodeGroupResourceType
+}
+
+func (e *EKSNodeGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsEksClusterResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, cluster := range clusters {
+		nodeGroups, err := e.repository.ListAllNodeGroups(ctx, *cluster)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, nodeGroup := range nodeGroups {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					strings.Join([]string{*cluster, *nodeGroup}, ":"),
+					map[string]interface{}{
+						"cluster_name": *cluster,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// Domains running the OpenSearch engine are managed by aws_opensearch_domain
+func isOpenSearchDomain(domain *elasticsearchservice.ElasticsearchDomainStatus) bool {
+	return strings.HasPrefix(awssdk.StringValue(domain.ElasticsearchVersion), "OpenSearch_")
+}
+
+type ElasticsearchDomainEnumerator struct {
+	repository repository.ElasticsearchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewElasticsearchDomainEnumerator(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) *ElasticsearchDomainEnumerator {
+	return &ElasticsearchDomainEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ElasticsearchDomainEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsElasticsearchDomainResourceType
+}
+
+func (e *ElasticsearchDomainEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domains, err := e.repository.ListAllDomains(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(domains))
+
+	for _, domain := range domains {
+		if awssdk.BoolValue(domain.Deleted) || isOpenSearchDomain(domain) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*domain.ARN,
+				map[string]interface{}{
+					"domain_name": *domain.DomainName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
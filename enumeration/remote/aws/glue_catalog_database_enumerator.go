@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type GlueCatalogDatabaseEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueCatalogDatabaseEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueCatalogDatabaseEnumerator {
+	return &GlueCatalogDatabaseEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueCatalogDatabaseEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueCatalogDatabaseResourceType
+}
+
+func (e *GlueCatalogDatabaseEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	databases, err := e.repository.ListAllDatabases(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(databases))
+
+	for _, database := range databases {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				strings.Join([]string{*database.CatalogId, *database.Name}, ":"),
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type GlueCatalogTableEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueCatalogTableEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueCatalogTableEnumerator {
+	return &GlueCatalogTableEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueCatalogTableEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueCatalogTableResourceType
+}
+
+func (e *GlueCatalogTableEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	databases, err := e.repository.ListAllDatabases(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsGlueCatalogDatabaseResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, database := range databases {
+		tables, err := e.repository.ListAllTables(ctx, *database.Name)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, table := range tables {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					strings.Join([]string{*table.CatalogId, *table.DatabaseName, *table.Name}, ":"),
+					map[string]interface{}{},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type GlueCrawlerEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueCrawlerEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueCrawlerEnumerator {
+	return &GlueCrawlerEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueCrawlerEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueCrawlerResourceType
+}
+
+func (e *GlueCrawlerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	crawlers, err := e.repository.ListAllCrawlers(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(crawlers))
+
+	for _, crawler := range crawlers {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*crawler.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type GlueJobEnumerator struct {
+	repository repository.GlueRepository
+	factory    resource.ResourceFactory
+}
+
+func NewGlueJobEnumerator(repo repository.GlueRepository, factory resource.ResourceFactory) *GlueJobEnumerator {
+	return &GlueJobEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *GlueJobEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsGlueJobResourceType
+}
+
+func (e *GlueJobEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	jobs, err := e.repository.ListAllJobs(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(jobs))
+
+	for _, job := range jobs {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*job.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
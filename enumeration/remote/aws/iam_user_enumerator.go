@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -24,8 +26,8 @@ func (e *IamUserEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsIamUserResourceType
 }
 
-func (e *IamUserEnumerator) Enumerate() ([]*resource.Resource, error) {
-	users, err := e.repository.ListAllUsers()
+func (e *IamUserEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	users, err := e.repository.ListAllUsers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
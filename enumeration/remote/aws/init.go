@@ -3,6 +3,7 @@ package aws
 import (
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	client "github.com/snyk/driftctl/enumeration/remote/aws/client"
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -17,9 +18,9 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, hashes []string, source terraform.ProviderSource, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, mirror terraform.ProviderMirror, cacheTTL cache.TTLConfig, rateLimit float64) error {
 
-	provider, err := NewAWSTerraformProvider(version, progress, configDir)
+	provider, err := NewAWSTerraformProvider(version, hashes, source, progress, configDir, mirror)
 	if err != nil {
 		return err
 	}
@@ -32,7 +33,15 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 		return err
 	}
 
+	limiter := ratelimit.NewLimiter(rateLimit)
+	provider.SetRateLimiter(limiter)
+	remoteLibrary.SetRateLimiter(limiter)
+	remoteLibrary.SetAliasResolver(resolveAlias)
+
 	repositoryCache := cache.New(100)
+	if cacheTTL.Enabled() {
+		repositoryCache = cache.NewPersistentCache(repositoryCache, provider.CacheDirectory(configDir), cacheTTL)
+	}
 
 	s3Repository := repository.NewS3Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
 	s3ControlRepository := repository.NewS3ControlRepository(client.NewAWSClientFactory(provider.session), repositoryCache)
@@ -46,6 +55,14 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	cloudfrontRepository := repository.NewCloudfrontRepository(provider.session, repositoryCache)
 	dynamoDBRepository := repository.NewDynamoDBRepository(provider.session, repositoryCache)
 	ecrRepository := repository.NewECRRepository(provider.session, repositoryCache)
+	ecsRepository := repository.NewECSRepository(provider.session, repositoryCache)
+	eksRepository := repository.NewEKSRepository(provider.session, repositoryCache)
+	secretsManagerRepository := repository.NewSecretsManagerRepository(provider.session, repositoryCache)
+	ssmRepository := repository.NewSSMRepository(provider.session, repositoryCache)
+	cloudwatchEventsRepository := repository.NewCloudwatchEventsRepository(provider.session, repositoryCache)
+	sfnRepository := repository.NewSFNRepository(provider.session, repositoryCache)
+	cloudwatchRepository := repository.NewCloudwatchRepository(provider.session, repositoryCache)
+	cloudwatchLogsRepository := repository.NewCloudwatchLogsRepository(provider.session, repositoryCache)
 	kmsRepository := repository.NewKMSRepository(provider.session, repositoryCache)
 	iamRepository := repository.NewIAMRepository(provider.session, repositoryCache)
 	cloudformationRepository := repository.NewCloudformationRepository(provider.session, repositoryCache)
@@ -56,6 +73,22 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	autoscalingRepository := repository.NewAutoScalingRepository(provider.session, repositoryCache)
 	elbRepository := repository.NewELBRepository(provider.session, repositoryCache)
 	elasticacheRepository := repository.NewElastiCacheRepository(provider.session, repositoryCache)
+	acmRepository := repository.NewACMRepository(provider.session, repositoryCache)
+	wafv2Repository := repository.NewWAFV2Repository(client.NewAWSClientFactory(provider.session), repositoryCache)
+	shieldRepository := repository.NewShieldRepository(client.NewAWSClientFactory(provider.session), repositoryCache)
+	efsRepository := repository.NewEFSRepository(provider.session, repositoryCache)
+	redshiftRepository := repository.NewRedshiftRepository(provider.session, repositoryCache)
+	elasticsearchRepository := repository.NewElasticsearchRepository(provider.session, repositoryCache)
+	mskRepository := repository.NewMSKRepository(provider.session, repositoryCache)
+	docDBRepository := repository.NewDocDBRepository(provider.session, repositoryCache)
+	kinesisRepository := repository.NewKinesisRepository(provider.session, repositoryCache)
+	firehoseRepository := repository.NewFirehoseRepository(provider.session, repositoryCache)
+	glueRepository := repository.NewGlueRepository(provider.session, repositoryCache)
+	athenaRepository := repository.NewAthenaRepository(provider.session, repositoryCache)
+	cognitoIdentityProviderRepository := repository.NewCognitoIdentityProviderRepository(provider.session, repositoryCache)
+	cognitoIdentityRepository := repository.NewCognitoIdentityRepository(provider.session, repositoryCache)
+	sesRepository := repository.NewSESRepository(provider.session, repositoryCache)
+	sesv2Repository := repository.NewSESV2Repository(provider.session, repositoryCache)
 
 	deserializer := resource.NewDeserializer(factory)
 	providerLibrary.AddProvider(terraform.AWS, provider)
@@ -124,6 +157,22 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddEnumerator(NewLaunchTemplateEnumerator(ec2repository, factory))
 	remoteLibrary.AddDetailsFetcher(aws.AwsLaunchTemplateResourceType, common.NewGenericDetailsFetcher(aws.AwsLaunchTemplateResourceType, provider, deserializer))
 	remoteLibrary.AddEnumerator(NewEC2EbsEncryptionByDefaultEnumerator(ec2repository, factory))
+	remoteLibrary.AddEnumerator(NewVPCEndpointEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsVpcEndpointResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcEndpointResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewVPCPeeringConnectionEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsVpcPeeringConnectionResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcPeeringConnectionResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEC2TransitGatewayEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEc2TransitGatewayResourceType, common.NewGenericDetailsFetcher(aws.AwsEc2TransitGatewayResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEC2TransitGatewayVpcAttachmentEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEc2TransitGatewayVpcAttachmentResourceType, common.NewGenericDetailsFetcher(aws.AwsEc2TransitGatewayVpcAttachmentResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEC2TransitGatewayRouteTableEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEc2TransitGatewayRouteTableResourceType, common.NewGenericDetailsFetcher(aws.AwsEc2TransitGatewayRouteTableResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEC2FlowLogEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsFlowLogResourceType, common.NewGenericDetailsFetcher(aws.AwsFlowLogResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewVPCDhcpOptionsEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsVpcDhcpOptionsResourceType, common.NewGenericDetailsFetcher(aws.AwsVpcDhcpOptionsResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEC2NetworkInterfaceEnumerator(ec2repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsNetworkInterfaceResourceType, common.NewGenericDetailsFetcher(aws.AwsNetworkInterfaceResourceType, provider, deserializer))
 
 	remoteLibrary.AddEnumerator(NewKMSKeyEnumerator(kmsRepository, factory))
 	remoteLibrary.AddDetailsFetcher(aws.AwsKmsKeyResourceType, common.NewGenericDetailsFetcher(aws.AwsKmsKeyResourceType, provider, deserializer))
@@ -167,6 +216,10 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaFunctionResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaFunctionResourceType, provider, deserializer))
 	remoteLibrary.AddEnumerator(NewLambdaEventSourceMappingEnumerator(lambdaRepository, factory))
 	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaEventSourceMappingResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewLambdaPermissionEnumerator(lambdaRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaPermissionResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaPermissionResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewLambdaAliasEnumerator(lambdaRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsLambdaAliasResourceType, common.NewGenericDetailsFetcher(aws.AwsLambdaAliasResourceType, provider, deserializer))
 
 	remoteLibrary.AddEnumerator(NewIamUserEnumerator(iamRepository, factory))
 	remoteLibrary.AddDetailsFetcher(aws.AwsIamUserResourceType, common.NewGenericDetailsFetcher(aws.AwsIamUserResourceType, provider, deserializer))
@@ -190,6 +243,67 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	remoteLibrary.AddDetailsFetcher(aws.AwsEcrRepositoryResourceType, common.NewGenericDetailsFetcher(aws.AwsEcrRepositoryResourceType, provider, deserializer))
 	remoteLibrary.AddEnumerator(NewECRRepositoryPolicyEnumerator(ecrRepository, factory))
 
+	remoteLibrary.AddEnumerator(NewECSClusterEnumerator(ecsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEcsClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsClusterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewECSServiceEnumerator(ecsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEcsServiceResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsServiceResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewECSTaskDefinitionEnumerator(ecsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEcsTaskDefinitionResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsTaskDefinitionResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewECSCapacityProviderEnumerator(ecsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEcsCapacityProviderResourceType, common.NewGenericDetailsFetcher(aws.AwsEcsCapacityProviderResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewEKSClusterEnumerator(eksRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEksClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsEksClusterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEKSNodeGroupEnumerator(eksRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEksNodeGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsEksNodeGroupResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEKSAddonEnumerator(eksRepository, factory))
+	// aws_eks_addon is missing from the provider schema of older 3.x AWS providers
+	if _, exist := provider.Schema()[aws.AwsEksAddonResourceType]; exist {
+		remoteLibrary.AddDetailsFetcher(aws.AwsEksAddonResourceType, common.NewGenericDetailsFetcher(aws.AwsEksAddonResourceType, provider, deserializer))
+	}
+	remoteLibrary.AddEnumerator(NewEKSFargateProfileEnumerator(eksRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEksFargateProfileResourceType, common.NewGenericDetailsFetcher(aws.AwsEksFargateProfileResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEKSIdentityProviderConfigEnumerator(eksRepository, factory))
+	// aws_eks_identity_provider_config is missing from the provider schema of older 3.x AWS providers
+	if _, exist := provider.Schema()[aws.AwsEksIdentityProviderConfigResourceType]; exist {
+		remoteLibrary.AddDetailsFetcher(aws.AwsEksIdentityProviderConfigResourceType, common.NewGenericDetailsFetcher(aws.AwsEksIdentityProviderConfigResourceType, provider, deserializer))
+	}
+
+	remoteLibrary.AddEnumerator(NewSecretsManagerSecretEnumerator(secretsManagerRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSecretsManagerSecretResourceType, common.NewGenericDetailsFetcher(aws.AwsSecretsManagerSecretResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewSecretsManagerSecretPolicyEnumerator(secretsManagerRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSecretsManagerSecretPolicyResourceType, common.NewGenericDetailsFetcher(aws.AwsSecretsManagerSecretPolicyResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewSecretsManagerSecretRotationEnumerator(secretsManagerRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSecretsManagerSecretRotationResourceType, common.NewGenericDetailsFetcher(aws.AwsSecretsManagerSecretRotationResourceType, provider, deserializer))
+
+	// The provider reads parameters with their decrypted value, so they are never read in deep mode
+	remoteLibrary.AddEnumerator(NewSSMParameterEnumerator(ssmRepository, factory))
+	remoteLibrary.AddEnumerator(NewSSMDocumentEnumerator(ssmRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSsmDocumentResourceType, common.NewGenericDetailsFetcher(aws.AwsSsmDocumentResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewCloudwatchEventBusEnumerator(cloudwatchEventsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchEventBusResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchEventBusResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchEventRuleEnumerator(cloudwatchEventsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchEventRuleResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchEventRuleResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchEventTargetEnumerator(cloudwatchEventsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchEventTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchEventTargetResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewSFNStateMachineEnumerator(sfnRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSfnStateMachineResourceType, common.NewGenericDetailsFetcher(aws.AwsSfnStateMachineResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewSFNActivityEnumerator(sfnRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSfnActivityResourceType, common.NewGenericDetailsFetcher(aws.AwsSfnActivityResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewCloudwatchLogGroupEnumerator(cloudwatchLogsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchLogGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchLogGroupResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchLogMetricFilterEnumerator(cloudwatchLogsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchLogMetricFilterResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchLogMetricFilterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchLogSubscriptionFilterEnumerator(cloudwatchLogsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchLogSubscriptionFilterResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchLogSubscriptionFilterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchMetricAlarmEnumerator(cloudwatchRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchMetricAlarmResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchMetricAlarmResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCloudwatchDashboardEnumerator(cloudwatchRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCloudwatchDashboardResourceType, common.NewGenericDetailsFetcher(aws.AwsCloudwatchDashboardResourceType, provider, deserializer))
+
 	remoteLibrary.AddEnumerator(NewRDSClusterEnumerator(rdsRepository, factory))
 	remoteLibrary.AddDetailsFetcher(aws.AwsRDSClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsRDSClusterResourceType, provider, deserializer))
 
@@ -248,5 +362,78 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 
 	remoteLibrary.AddEnumerator(NewElastiCacheClusterEnumerator(elasticacheRepository, factory))
 
+	remoteLibrary.AddEnumerator(NewACMCertificateEnumerator(acmRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsAcmCertificateResourceType, common.NewGenericDetailsFetcher(aws.AwsAcmCertificateResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewWAFV2WebACLEnumerator(wafv2Repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsWafv2WebAclResourceType, common.NewGenericDetailsFetcher(aws.AwsWafv2WebAclResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewWAFV2WebACLAssociationEnumerator(wafv2Repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsWafv2WebAclAssociationResourceType, common.NewGenericDetailsFetcher(aws.AwsWafv2WebAclAssociationResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewWAFV2IPSetEnumerator(wafv2Repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsWafv2IpSetResourceType, common.NewGenericDetailsFetcher(aws.AwsWafv2IpSetResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewWAFV2RuleGroupEnumerator(wafv2Repository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsWafv2RuleGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsWafv2RuleGroupResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewShieldProtectionEnumerator(shieldRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsShieldProtectionResourceType, common.NewGenericDetailsFetcher(aws.AwsShieldProtectionResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewEFSFileSystemEnumerator(efsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEfsFileSystemResourceType, common.NewGenericDetailsFetcher(aws.AwsEfsFileSystemResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewEFSMountTargetEnumerator(efsRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsEfsMountTargetResourceType, common.NewGenericDetailsFetcher(aws.AwsEfsMountTargetResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewRedshiftClusterEnumerator(redshiftRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsRedshiftClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsRedshiftClusterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewRedshiftSubnetGroupEnumerator(redshiftRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsRedshiftSubnetGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsRedshiftSubnetGroupResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewRedshiftParameterGroupEnumerator(redshiftRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsRedshiftParameterGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsRedshiftParameterGroupResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewElasticsearchDomainEnumerator(elasticsearchRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsElasticsearchDomainResourceType, common.NewGenericDetailsFetcher(aws.AwsElasticsearchDomainResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewOpenSearchDomainEnumerator(elasticsearchRepository, factory))
+	// aws_opensearch_domain is only part of the provider schema starting from AWS provider 4.x
+	if _, exist := provider.Schema()[aws.AwsOpenSearchDomainResourceType]; exist {
+		remoteLibrary.AddDetailsFetcher(aws.AwsOpenSearchDomainResourceType, common.NewGenericDetailsFetcher(aws.AwsOpenSearchDomainResourceType, provider, deserializer))
+	}
+
+	remoteLibrary.AddEnumerator(NewMSKClusterEnumerator(mskRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsMskClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsMskClusterResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewDocDBClusterEnumerator(docDBRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsDocDBClusterResourceType, common.NewGenericDetailsFetcher(aws.AwsDocDBClusterResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewDocDBClusterParameterGroupEnumerator(docDBRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsDocDBClusterParameterGroupResourceType, common.NewGenericDetailsFetcher(aws.AwsDocDBClusterParameterGroupResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewKinesisStreamEnumerator(kinesisRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsKinesisStreamResourceType, common.NewGenericDetailsFetcher(aws.AwsKinesisStreamResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewKinesisFirehoseDeliveryStreamEnumerator(firehoseRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsKinesisFirehoseDeliveryStreamResourceType, common.NewGenericDetailsFetcher(aws.AwsKinesisFirehoseDeliveryStreamResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewGlueCatalogDatabaseEnumerator(glueRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsGlueCatalogDatabaseResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueCatalogDatabaseResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewGlueCatalogTableEnumerator(glueRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsGlueCatalogTableResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueCatalogTableResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewGlueJobEnumerator(glueRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsGlueJobResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueJobResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewGlueCrawlerEnumerator(glueRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsGlueCrawlerResourceType, common.NewGenericDetailsFetcher(aws.AwsGlueCrawlerResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewAthenaWorkgroupEnumerator(athenaRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsAthenaWorkgroupResourceType, common.NewGenericDetailsFetcher(aws.AwsAthenaWorkgroupResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewCognitoUserPoolEnumerator(cognitoIdentityProviderRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCognitoUserPoolResourceType, common.NewGenericDetailsFetcher(aws.AwsCognitoUserPoolResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCognitoUserPoolClientEnumerator(cognitoIdentityProviderRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCognitoUserPoolClientResourceType, common.NewGenericDetailsFetcher(aws.AwsCognitoUserPoolClientResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewCognitoIdentityPoolEnumerator(cognitoIdentityRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsCognitoIdentityPoolResourceType, common.NewGenericDetailsFetcher(aws.AwsCognitoIdentityPoolResourceType, provider, deserializer))
+
+	remoteLibrary.AddEnumerator(NewSESDomainIdentityEnumerator(sesRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSesDomainIdentityResourceType, common.NewGenericDetailsFetcher(aws.AwsSesDomainIdentityResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewSESEmailIdentityEnumerator(sesRepository, factory))
+	remoteLibrary.AddDetailsFetcher(aws.AwsSesEmailIdentityResourceType, common.NewGenericDetailsFetcher(aws.AwsSesEmailIdentityResourceType, provider, deserializer))
+	remoteLibrary.AddEnumerator(NewSESV2ConfigurationSetEnumerator(sesv2Repository, factory))
+
 	return nil
 }
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type KinesisFirehoseDeliveryStreamEnumerator struct {
+	repository repository.FirehoseRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKinesisFirehoseDeliveryStreamEnumerator(repo repository.FirehoseRepository, factory resource.ResourceFactory) *KinesisFirehoseDeliveryStreamEnumerator {
+	return &KinesisFirehoseDeliveryStreamEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KinesisFirehoseDeliveryStreamEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsKinesisFirehoseDeliveryStreamResourceType
+}
+
+func (e *KinesisFirehoseDeliveryStreamEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	deliveryStreams, err := e.repository.ListAllDeliveryStreams(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(deliveryStreams))
+
+	for _, deliveryStream := range deliveryStreams {
+		if awssdk.StringValue(deliveryStream.DeliveryStreamStatus) == firehose.DeliveryStreamStatusDeleting {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*deliveryStream.DeliveryStreamARN,
+				map[string]interface{}{
+					"name": *deliveryStream.DeliveryStreamName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
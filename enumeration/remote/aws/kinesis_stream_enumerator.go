@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type KinesisStreamEnumerator struct {
+	repository repository.KinesisRepository
+	factory    resource.ResourceFactory
+}
+
+func NewKinesisStreamEnumerator(repo repository.KinesisRepository, factory resource.ResourceFactory) *KinesisStreamEnumerator {
+	return &KinesisStreamEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *KinesisStreamEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsKinesisStreamResourceType
+}
+
+func (e *KinesisStreamEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	streams, err := e.repository.ListAllStreams(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(streams))
+
+	for _, stream := range streams {
+		if awssdk.StringValue(stream.StreamStatus) == kinesis.StreamStatusDeleting {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*stream.StreamARN,
+				map[string]interface{}{
+					"name": *stream.StreamName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type LambdaAliasEnumerator struct {
+	repository repository.LambdaRepository
+	factory    resource.ResourceFactory
+}
+
+func NewLambdaAliasEnumerator(repo repository.LambdaRepository, factory resource.ResourceFactory) *LambdaAliasEnumerator {
+	return &LambdaAliasEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *LambdaAliasEnumerator) SupportedType() resource.ResourceType {
+	return resourceaws.AwsLambdaAliasResourceType
+}
+
+func (e *LambdaAliasEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	functions, err := e.repository.ListAllLambdaFunctions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsLambdaFunctionResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, function := range functions {
+		aliases, err := e.repository.ListAllLambdaAliases(ctx, *function.FunctionName)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, alias := range aliases {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*alias.AliasArn,
+					map[string]interface{}{
+						"function_name": *function.FunctionName,
+						"name":          *alias.Name,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
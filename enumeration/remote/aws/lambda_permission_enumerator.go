@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type lambdaPolicyDocument struct {
+	Statement []struct {
+		Sid string
+	}
+}
+
+type LambdaPermissionEnumerator struct {
+	repository repository.LambdaRepository
+	factory    resource.ResourceFactory
+}
+
+func NewLambdaPermissionEnumerator(repo repository.LambdaRepository, factory resource.ResourceFactory) *LambdaPermissionEnumerator {
+	return &LambdaPermissionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *LambdaPermissionEnumerator) SupportedType() resource.ResourceType {
+	return resourceaws.AwsLambdaPermissionResourceType
+}
+
+func (e *LambdaPermissionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	functions, err := e.repository.ListAllLambdaFunctions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsLambdaFunctionResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, function := range functions {
+		policy, err := e.repository.GetLambdaFunctionPolicy(ctx, *function.FunctionName)
+		if _, ok := err.(*lambda.ResourceNotFoundException); ok {
+			continue
+		}
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		var document lambdaPolicyDocument
+		if err := json.Unmarshal([]byte(*policy), &document); err != nil {
+			return nil, err
+		}
+
+		// Each statement of the function policy is managed by terraform as a permission identified by its sid
+		for _, statement := range document.Statement {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					statement.Sid,
+					map[string]interface{}{
+						"function_name": *function.FunctionName,
+						"statement_id":  statement.Sid,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
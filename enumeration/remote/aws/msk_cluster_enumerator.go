@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type MSKClusterEnumerator struct {
+	repository repository.MSKRepository
+	factory    resource.ResourceFactory
+}
+
+func NewMSKClusterEnumerator(repo repository.MSKRepository, factory resource.ResourceFactory) *MSKClusterEnumerator {
+	return &MSKClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *MSKClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsMskClusterResourceType
+}
+
+func (e *MSKClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		if awssdk.StringValue(cluster.State) == kafka.ClusterStateDeleting {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterArn,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
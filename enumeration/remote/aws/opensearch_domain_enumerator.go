@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type OpenSearchDomainEnumerator struct {
+	repository repository.ElasticsearchRepository
+	factory    resource.ResourceFactory
+}
+
+func NewOpenSearchDomainEnumerator(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) *OpenSearchDomainEnumerator {
+	return &OpenSearchDomainEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *OpenSearchDomainEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsOpenSearchDomainResourceType
+}
+
+func (e *OpenSearchDomainEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	domains, err := e.repository.ListAllDomains(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(domains))
+
+	for _, domain := range domains {
+		if awssdk.BoolValue(domain.Deleted) || !isOpenSearchDomain(domain) {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*domain.ARN,
+				map[string]interface{}{
+					"domain_name": *domain.DomainName,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
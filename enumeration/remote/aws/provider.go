@@ -1,17 +1,28 @@
 package aws
 
 import (
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote/terraform"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 )
 
+// DefaultProviderVersion is the provider version used when none is configured
+const DefaultProviderVersion = "3.19.0"
+
 type awsConfig struct {
 	AccessKey     string
 	SecretKey     string
@@ -49,18 +60,22 @@ type AWSTerraformProvider struct {
 	accountId string
 }
 
-func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*AWSTerraformProvider, error) {
+func NewAWSTerraformProvider(version string, hashes []string, source tf.ProviderSource, progress enumeration.ProgressCounter, configDir string, mirror tf.ProviderMirror) (*AWSTerraformProvider, error) {
 	if version == "" {
-		version = "3.19.0"
+		version = DefaultProviderVersion
 	}
 	p := &AWSTerraformProvider{
 		version: version,
 		name:    "aws",
 	}
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Hostname:  source.Hostname,
+		Namespace: source.Namespace,
 		Key:       p.name,
 		Version:   version,
 		ConfigDir: configDir,
+		Mirror:    mirror,
+		Hashes:    hashes,
 	})
 	if err != nil {
 		return nil, err
@@ -92,6 +107,12 @@ func NewAWSTerraformProvider(version string, progress enumeration.ProgressCounte
 		return nil, err
 	}
 	p.TerraformProvider = tfProvider
+
+	// Count the requests of each enumerator when the scan is profiled
+	p.session.Handlers.Send.PushBack(func(r *request.Request) {
+		profile.CountApiCall(r.Context())
+	})
+
 	return p, err
 }
 
@@ -127,3 +148,98 @@ func (p *AWSTerraformProvider) CheckCredentialsExist() error {
 	p.accountId = aws.StringValue(identity.Account)
 	return nil
 }
+
+// CacheDirectory returns the directory used to persist enumeration results.
+// Results are scoped by account, region and provider version so they are never reused
+// against another environment.
+func (p *AWSTerraformProvider) CacheDirectory(configDir string) string {
+	return filepath.Join(configDir, ".driftctl", "cache", p.name, p.accountId, aws.StringValue(p.session.Config.Region), p.version)
+}
+
+// SetRateLimiter makes every request sent with the provider session or read through the terraform provider
+// wait for the rate limiter, and reports throttling errors to it so the request rate of the throttled service is reduced.
+// It must be called before creating clients from the session.
+func (p *AWSTerraformProvider) SetRateLimiter(limiter *ratelimit.Limiter) {
+	p.TerraformProvider.SetRateLimiter(limiter, resourceService)
+	p.session.Handlers.Send.PushFront(func(r *request.Request) {
+		if err := limiter.Wait(r.Context(), r.ClientInfo.ServiceName); err != nil {
+			r.Error = err
+		}
+	})
+	p.session.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+		if request.IsErrorThrottle(r.Error) {
+			limiter.Throttled(r.ClientInfo.ServiceName)
+			return
+		}
+		if r.Error == nil {
+			limiter.Succeeded(r.ClientInfo.ServiceName)
+		}
+	})
+	// Retry throttled requests more than the SDK does by default since the limiter backs off
+	p.session.Config.Retryer = client.DefaultRetryer{
+		NumMaxRetries:    10,
+		MinThrottleDelay: 500 * time.Millisecond,
+		MaxThrottleDelay: 30 * time.Second,
+	}
+}
+
+// resourceServices maps resource type prefixes to the API service name of the SDK (request.ClientInfo.ServiceName)
+// when it differs from the first word of the type, so that terraform provider reads and SDK calls share the same rate limit.
+// The longest matching prefix wins, e.g. aws_cloudwatch_log_group is read from logs while aws_cloudwatch_dashboard is read from monitoring.
+var resourceServices = map[string]string{
+	"ami":                  "ec2",
+	"default":              "ec2",
+	"ebs":                  "ec2",
+	"egress":               "ec2",
+	"eip":                  "ec2",
+	"flow":                 "ec2",
+	"instance":             "ec2",
+	"internet":             "ec2",
+	"key":                  "ec2",
+	"launch_template":      "ec2",
+	"nat":                  "ec2",
+	"network":              "ec2",
+	"route":                "ec2",
+	"security":             "ec2",
+	"subnet":               "ec2",
+	"vpc":                  "ec2",
+	"launch_configuration": "autoscaling",
+	"appautoscaling":       "autoscaling",
+	"db":                   "rds",
+	"alb":                  "elasticloadbalancing",
+	"elb":                  "elasticloadbalancing",
+	"lb":                   "elasticloadbalancing",
+	"api_gateway":          "apigateway",
+	"apigatewayv2":         "ApiGatewayV2",
+	"cloudwatch":           "monitoring",
+	"cloudwatch_event":     "events",
+	"cloudwatch_log":       "logs",
+	"cognito":              "cognito-idp",
+	"cognito_identity":     "cognito-identity",
+	"docdb":                "DocDB",
+	"efs":                  "elasticfilesystem",
+	"elasticsearch":        "es",
+	"opensearch":           "es",
+	"kinesis_firehose":     "firehose",
+	"msk":                  "Kafka",
+	"s3_account":           "S3 Control",
+	"ses":                  "email",
+	"sesv2":                "SESv2",
+	"sfn":                  "states",
+	"wafv2":                "WAFV2",
+}
+
+// resourceService returns the API service name a resource type is read from, e.g. ec2 for aws_instance
+func resourceService(resourceType string) string {
+	name := strings.TrimPrefix(resourceType, "aws_")
+	service, length := "", 0
+	for prefix, s := range resourceServices {
+		if len(prefix) > length && (name == prefix || strings.HasPrefix(name, prefix+"_")) {
+			service, length = s, len(prefix)
+		}
+	}
+	if service != "" {
+		return service
+	}
+	return strings.SplitN(name, "_", 2)[0]
+}
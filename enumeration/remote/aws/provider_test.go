@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceService(t *testing.T) {
+	cases := []struct {
+		resourceType string
+		service      string
+	}{
+		{aws.AwsAcmCertificateResourceType, "acm"},
+		{aws.AwsApplicationLoadBalancerResourceType, "elasticloadbalancing"},
+		{aws.AwsApplicationLoadBalancerListenerResourceType, "elasticloadbalancing"},
+		{aws.AwsAmiResourceType, "ec2"},
+		{aws.AwsApiGatewayAccountResourceType, "apigateway"},
+		{aws.AwsApiGatewayApiKeyResourceType, "apigateway"},
+		{aws.AwsApiGatewayAuthorizerResourceType, "apigateway"},
+		{aws.AwsApiGatewayBasePathMappingResourceType, "apigateway"},
+		{aws.AwsApiGatewayDeploymentResourceType, "apigateway"},
+		{aws.AwsApiGatewayDomainNameResourceType, "apigateway"},
+		{aws.AwsApiGatewayGatewayResponseResourceType, "apigateway"},
+		{aws.AwsApiGatewayIntegrationResourceType, "apigateway"},
+		{aws.AwsApiGatewayIntegrationResponseResourceType, "apigateway"},
+		{aws.AwsApiGatewayMethodResourceType, "apigateway"},
+		{aws.AwsApiGatewayMethodResponseResourceType, "apigateway"},
+		{aws.AwsApiGatewayMethodSettingsResourceType, "apigateway"},
+		{aws.AwsApiGatewayModelResourceType, "apigateway"},
+		{aws.AwsApiGatewayRequestValidatorResourceType, "apigateway"},
+		{aws.AwsApiGatewayResourceResourceType, "apigateway"},
+		{aws.AwsApiGatewayRestApiResourceType, "apigateway"},
+		{aws.AwsApiGatewayRestApiPolicyResourceType, "apigateway"},
+		{aws.AwsApiGatewayStageResourceType, "apigateway"},
+		{aws.AwsApiGatewayVpcLinkResourceType, "apigateway"},
+		{aws.AwsApiGatewayV2ApiResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2MappingResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2AuthorizerResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2DeploymentResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2DomainNameResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2IntegrationResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2IntegrationResponseResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2ModelResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2RouteResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2RouteResponseResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2StageResourceType, "ApiGatewayV2"},
+		{aws.AwsApiGatewayV2VpcLinkResourceType, "ApiGatewayV2"},
+		{aws.AwsAppAutoscalingPolicyResourceType, "autoscaling"},
+		{aws.AwsAppAutoscalingScheduledActionResourceType, "autoscaling"},
+		{aws.AwsAppAutoscalingTargetResourceType, "autoscaling"},
+		{aws.AwsAthenaWorkgroupResourceType, "athena"},
+		{aws.AwsCloudformationStackResourceType, "cloudformation"},
+		{aws.AwsCloudfrontDistributionResourceType, "cloudfront"},
+		{aws.AwsCloudtrailResourceType, "cloudtrail"},
+		{aws.AwsCloudwatchDashboardResourceType, "monitoring"},
+		{aws.AwsCloudwatchEventBusResourceType, "events"},
+		{aws.AwsCloudwatchEventRuleResourceType, "events"},
+		{aws.AwsCloudwatchEventTargetResourceType, "events"},
+		{aws.AwsCloudwatchLogGroupResourceType, "logs"},
+		{aws.AwsCloudwatchLogMetricFilterResourceType, "logs"},
+		{aws.AwsCloudwatchLogSubscriptionFilterResourceType, "logs"},
+		{aws.AwsCloudwatchMetricAlarmResourceType, "monitoring"},
+		{aws.AwsCognitoIdentityPoolResourceType, "cognito-identity"},
+		{aws.AwsCognitoUserPoolResourceType, "cognito-idp"},
+		{aws.AwsCognitoUserPoolClientResourceType, "cognito-idp"},
+		{aws.AwsDbInstanceResourceType, "rds"},
+		{aws.AwsDbSubnetGroupResourceType, "rds"},
+		{aws.AwsDefaultNetworkACLResourceType, "ec2"},
+		{aws.AwsDefaultRouteTableResourceType, "ec2"},
+		{aws.AwsDefaultSecurityGroupResourceType, "ec2"},
+		{aws.AwsDefaultSubnetResourceType, "ec2"},
+		{aws.AwsDefaultVpcResourceType, "ec2"},
+		{aws.AwsDocDBClusterResourceType, "DocDB"},
+		{aws.AwsDocDBClusterInstanceResourceType, "DocDB"},
+		{aws.AwsDocDBClusterParameterGroupResourceType, "DocDB"},
+		{aws.AwsDocDBSubnetGroupResourceType, "DocDB"},
+		{aws.AwsDynamodbTableResourceType, "dynamodb"},
+		{aws.AwsEbsEncryptionByDefaultResourceType, "ec2"},
+		{aws.AwsEbsSnapshotResourceType, "ec2"},
+		{aws.AwsEbsVolumeResourceType, "ec2"},
+		{aws.AwsEc2TransitGatewayResourceType, "ec2"},
+		{aws.AwsEc2TransitGatewayRouteTableResourceType, "ec2"},
+		{aws.AwsEc2TransitGatewayVpcAttachmentResourceType, "ec2"},
+		{aws.AwsEcrRepositoryResourceType, "ecr"},
+		{aws.AwsEcrRepositoryPolicyResourceType, "ecr"},
+		{aws.AwsEcsCapacityProviderResourceType, "ecs"},
+		{aws.AwsEcsClusterResourceType, "ecs"},
+		{aws.AwsEcsServiceResourceType, "ecs"},
+		{aws.AwsEcsTaskDefinitionResourceType, "ecs"},
+		{aws.AwsEfsFileSystemResourceType, "elasticfilesystem"},
+		{aws.AwsEfsMountTargetResourceType, "elasticfilesystem"},
+		{aws.AwsEipResourceType, "ec2"},
+		{aws.AwsEipAssociationResourceType, "ec2"},
+		{aws.AwsEksAddonResourceType, "eks"},
+		{aws.AwsEksClusterResourceType, "eks"},
+		{aws.AwsEksFargateProfileResourceType, "eks"},
+		{aws.AwsEksIdentityProviderConfigResourceType, "eks"},
+		{aws.AwsEksNodeGroupResourceType, "eks"},
+		{aws.AwsElastiCacheClusterResourceType, "elasticache"},
+		{aws.AwsElasticsearchDomainResourceType, "es"},
+		{aws.AwsClassicLoadBalancerResourceType, "elasticloadbalancing"},
+		{aws.AwsFlowLogResourceType, "ec2"},
+		{aws.AwsGlueCatalogDatabaseResourceType, "glue"},
+		{aws.AwsGlueCatalogTableResourceType, "glue"},
+		{aws.AwsGlueCrawlerResourceType, "glue"},
+		{aws.AwsGlueJobResourceType, "glue"},
+		{aws.AwsIamAccessKeyResourceType, "iam"},
+		{aws.AwsIamGroupResourceType, "iam"},
+		{aws.AwsIamGroupPolicyResourceType, "iam"},
+		{aws.AwsIamGroupPolicyAttachmentResourceType, "iam"},
+		{aws.AwsIamPolicyResourceType, "iam"},
+		{aws.AwsIamPolicyAttachmentResourceType, "iam"},
+		{aws.AwsIamRoleResourceType, "iam"},
+		{aws.AwsIamRolePolicyResourceType, "iam"},
+		{aws.AwsIamRolePolicyAttachmentResourceType, "iam"},
+		{aws.AwsIamUserResourceType, "iam"},
+		{aws.AwsIamUserPolicyResourceType, "iam"},
+		{aws.AwsIamUserPolicyAttachmentResourceType, "iam"},
+		{aws.AwsInstanceResourceType, "ec2"},
+		{aws.AwsInternetGatewayResourceType, "ec2"},
+		{aws.AwsKeyPairResourceType, "ec2"},
+		{aws.AwsKinesisFirehoseDeliveryStreamResourceType, "firehose"},
+		{aws.AwsKinesisStreamResourceType, "kinesis"},
+		{aws.AwsKmsAliasResourceType, "kms"},
+		{aws.AwsKmsKeyResourceType, "kms"},
+		{aws.AwsLambdaAliasResourceType, "lambda"},
+		{aws.AwsLambdaEventSourceMappingResourceType, "lambda"},
+		{aws.AwsLambdaFunctionResourceType, "lambda"},
+		{aws.AwsLambdaPermissionResourceType, "lambda"},
+		{aws.AwsLaunchConfigurationResourceType, "autoscaling"},
+		{aws.AwsLaunchTemplateResourceType, "ec2"},
+		{aws.AwsLoadBalancerResourceType, "elasticloadbalancing"},
+		{aws.AwsLoadBalancerListenerResourceType, "elasticloadbalancing"},
+		{aws.AwsMskClusterResourceType, "Kafka"},
+		{aws.AwsNatGatewayResourceType, "ec2"},
+		{aws.AwsNetworkACLResourceType, "ec2"},
+		{aws.AwsNetworkACLRuleResourceType, "ec2"},
+		{aws.AwsNetworkInterfaceResourceType, "ec2"},
+		{aws.AwsOpenSearchDomainResourceType, "es"},
+		{aws.AwsRDSClusterResourceType, "rds"},
+		{aws.AwsRDSClusterInstanceResourceType, "rds"},
+		{aws.AwsRedshiftClusterResourceType, "redshift"},
+		{aws.AwsRedshiftParameterGroupResourceType, "redshift"},
+		{aws.AwsRedshiftSubnetGroupResourceType, "redshift"},
+		{aws.AwsRouteResourceType, "ec2"},
+		{aws.AwsRoute53HealthCheckResourceType, "route53"},
+		{aws.AwsRoute53RecordResourceType, "route53"},
+		{aws.AwsRoute53ZoneResourceType, "route53"},
+		{aws.AwsRouteTableResourceType, "ec2"},
+		{aws.AwsRouteTableAssociationResourceType, "ec2"},
+		{aws.AwsS3AccountPublicAccessBlock, "S3 Control"},
+		{aws.AwsS3BucketResourceType, "s3"},
+		{aws.AwsS3BucketAnalyticsConfigurationResourceType, "s3"},
+		{aws.AwsS3BucketInventoryResourceType, "s3"},
+		{aws.AwsS3BucketMetricResourceType, "s3"},
+		{aws.AwsS3BucketNotificationResourceType, "s3"},
+		{aws.AwsS3BucketPolicyResourceType, "s3"},
+		{aws.AwsS3BucketPublicAccessBlockResourceType, "s3"},
+		{aws.AwsSecretsManagerSecretResourceType, "secretsmanager"},
+		{aws.AwsSecretsManagerSecretPolicyResourceType, "secretsmanager"},
+		{aws.AwsSecretsManagerSecretRotationResourceType, "secretsmanager"},
+		{aws.AwsSecurityGroupResourceType, "ec2"},
+		{aws.AwsSecurityGroupRuleResourceType, "ec2"},
+		{aws.AwsSesDomainIdentityResourceType, "email"},
+		{aws.AwsSesEmailIdentityResourceType, "email"},
+		{aws.AwsSesv2ConfigurationSetResourceType, "SESv2"},
+		{aws.AwsSfnActivityResourceType, "states"},
+		{aws.AwsSfnStateMachineResourceType, "states"},
+		{aws.AwsShieldProtectionResourceType, "shield"},
+		{aws.AwsSnsTopicResourceType, "sns"},
+		{aws.AwsSnsTopicPolicyResourceType, "sns"},
+		{aws.AwsSnsTopicSubscriptionResourceType, "sns"},
+		{aws.AwsSqsQueueResourceType, "sqs"},
+		{aws.AwsSqsQueuePolicyResourceType, "sqs"},
+		{aws.AwsSsmDocumentResourceType, "ssm"},
+		{aws.AwsSsmParameterResourceType, "ssm"},
+		{aws.AwsSubnetResourceType, "ec2"},
+		{aws.AwsVpcResourceType, "ec2"},
+		{aws.AwsVpcDhcpOptionsResourceType, "ec2"},
+		{aws.AwsVpcEndpointResourceType, "ec2"},
+		{aws.AwsVpcPeeringConnectionResourceType, "ec2"},
+		{aws.AwsWafv2IpSetResourceType, "WAFV2"},
+		{aws.AwsWafv2RuleGroupResourceType, "WAFV2"},
+		{aws.AwsWafv2WebAclResourceType, "WAFV2"},
+		{aws.AwsWafv2WebAclAssociationResourceType, "WAFV2"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.resourceType, func(t *testing.T) {
+			assert.Equal(t, c.service, resourceService(c.resourceType))
+		})
+	}
+}
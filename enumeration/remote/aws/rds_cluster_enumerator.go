@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *RDSClusterEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsRDSClusterResourceType
 }
 
-func (e *RDSClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
-	clusters, err := e.repository.ListAllDBClusters()
+func (e *RDSClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllDBClusters(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -32,6 +34,11 @@ func (e *RDSClusterEnumerator) Enumerate() ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0, len(clusters))
 
 	for _, cluster := range clusters {
+		// DocumentDB clusters are listed by the RDS API as well but are managed by aws_docdb_cluster
+		if cluster.Engine != nil && *cluster.Engine == "docdb" {
+			continue
+		}
+
 		var databaseName string
 
 		if v := cluster.DatabaseName; v != nil {
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type RedshiftClusterEnumerator struct {
+	repository repository.RedshiftRepository
+	factory    resource.ResourceFactory
+}
+
+func NewRedshiftClusterEnumerator(repo repository.RedshiftRepository, factory resource.ResourceFactory) *RedshiftClusterEnumerator {
+	return &RedshiftClusterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *RedshiftClusterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsRedshiftClusterResourceType
+}
+
+func (e *RedshiftClusterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	clusters, err := e.repository.ListAllClusters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*cluster.ClusterIdentifier,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type RedshiftParameterGroupEnumerator struct {
+	repository repository.RedshiftRepository
+	factory    resource.ResourceFactory
+}
+
+func NewRedshiftParameterGroupEnumerator(repo repository.RedshiftRepository, factory resource.ResourceFactory) *RedshiftParameterGroupEnumerator {
+	return &RedshiftParameterGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *RedshiftParameterGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsRedshiftParameterGroupResourceType
+}
+
+func (e *RedshiftParameterGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	parameterGroups, err := e.repository.ListAllParameterGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(parameterGroups))
+
+	for _, parameterGroup := range parameterGroups {
+		// Default parameter groups are managed by AWS and cannot be modified
+		if strings.HasPrefix(*parameterGroup.ParameterGroupName, "default.") {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*parameterGroup.ParameterGroupName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type RedshiftSubnetGroupEnumerator struct {
+	repository repository.RedshiftRepository
+	factory    resource.ResourceFactory
+}
+
+func NewRedshiftSubnetGroupEnumerator(repo repository.RedshiftRepository, factory resource.ResourceFactory) *RedshiftSubnetGroupEnumerator {
+	return &RedshiftSubnetGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *RedshiftSubnetGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsRedshiftSubnetGroupResourceType
+}
+
+func (e *RedshiftSubnetGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	subnetGroups, err := e.repository.ListAllSubnetGroups(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(subnetGroups))
+
+	for _, subnetGroup := range subnetGroups {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*subnetGroup.ClusterSubnetGroupName,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type ACMRepository interface {
+	ListAllCertificates(ctx context.Context) ([]*acm.CertificateSummary, error)
+}
+
+type acmRepository struct {
+	client acmiface.ACMAPI
+	cache  cache.Cache
+}
+
+func NewACMRepository(session *session.Session, c cache.Cache) *acmRepository {
+	return &acmRepository{
+		acm.New(session),
+		c,
+	}
+}
+
+func (r *acmRepository) ListAllCertificates(ctx context.Context) ([]*acm.CertificateSummary, error) {
+	if v := r.cache.Get("acmListAllCertificates"); v != nil {
+		return v.([]*acm.CertificateSummary), nil
+	}
+
+	var certificates []*acm.CertificateSummary
+	input := acm.ListCertificatesInput{
+		// Only RSA_1024 and RSA_2048 certificates are returned by default
+		Includes: &acm.Filters{
+			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+		},
+	}
+	err := r.client.ListCertificatesPagesWithContext(ctx, &input,
+		func(resp *acm.ListCertificatesOutput, lastPage bool) bool {
+			certificates = append(certificates, resp.CertificateSummaryList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("acmListAllCertificates", certificates)
+	return certificates, nil
+}
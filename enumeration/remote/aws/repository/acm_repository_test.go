@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_acmRepository_ListAllCertificates(t *testing.T) {
+	items := []*acm.CertificateSummary{
+		{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/1")},
+		{CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeACM, store *cache.MockCache)
+		want    []*acm.CertificateSummary
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeACM, store *cache.MockCache) {
+				client.On("ListCertificatesPagesWithContext", mock.Anything,
+					&acm.ListCertificatesInput{
+						Includes: &acm.Filters{
+							KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+						},
+					},
+					mock.MatchedBy(func(callback func(res *acm.ListCertificatesOutput, lastPage bool) bool) bool {
+						callback(&acm.ListCertificatesOutput{CertificateSummaryList: items[:1]}, false)
+						callback(&acm.ListCertificatesOutput{CertificateSummaryList: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "acmListAllCertificates").Return(nil).Times(1)
+				store.On("Put", "acmListAllCertificates", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeACM, store *cache.MockCache) {
+				store.On("Get", "acmListAllCertificates").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeACM, store *cache.MockCache) {
+				client.On("ListCertificatesPagesWithContext", mock.Anything,
+					&acm.ListCertificatesInput{
+						Includes: &acm.Filters{
+							KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+						},
+					},
+					mock.AnythingOfType("func(*acm.ListCertificatesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "acmListAllCertificates").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeACM{}
+			tt.mocks(client, store)
+			r := &acmRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllCertificates(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 
@@ -11,18 +12,18 @@ import (
 )
 
 type ApiGatewayRepository interface {
-	ListAllRestApis() ([]*apigateway.RestApi, error)
-	GetAccount() (*apigateway.Account, error)
-	ListAllApiKeys() ([]*apigateway.ApiKey, error)
-	ListAllRestApiAuthorizers(string) ([]*apigateway.Authorizer, error)
-	ListAllRestApiStages(string) ([]*apigateway.Stage, error)
-	ListAllRestApiResources(string) ([]*apigateway.Resource, error)
-	ListAllDomainNames() ([]*apigateway.DomainName, error)
-	ListAllVpcLinks() ([]*apigateway.UpdateVpcLinkOutput, error)
-	ListAllRestApiRequestValidators(string) ([]*apigateway.UpdateRequestValidatorOutput, error)
-	ListAllDomainNameBasePathMappings(string) ([]*apigateway.BasePathMapping, error)
-	ListAllRestApiModels(string) ([]*apigateway.Model, error)
-	ListAllRestApiGatewayResponses(string) ([]*apigateway.UpdateGatewayResponseOutput, error)
+	ListAllRestApis(ctx context.Context) ([]*apigateway.RestApi, error)
+	GetAccount(ctx context.Context) (*apigateway.Account, error)
+	ListAllApiKeys(ctx context.Context) ([]*apigateway.ApiKey, error)
+	ListAllRestApiAuthorizers(context.Context, string) ([]*apigateway.Authorizer, error)
+	ListAllRestApiStages(context.Context, string) ([]*apigateway.Stage, error)
+	ListAllRestApiResources(context.Context, string) ([]*apigateway.Resource, error)
+	ListAllDomainNames(ctx context.Context) ([]*apigateway.DomainName, error)
+	ListAllVpcLinks(ctx context.Context) ([]*apigateway.UpdateVpcLinkOutput, error)
+	ListAllRestApiRequestValidators(context.Context, string) ([]*apigateway.UpdateRequestValidatorOutput, error)
+	ListAllDomainNameBasePathMappings(context.Context, string) ([]*apigateway.BasePathMapping, error)
+	ListAllRestApiModels(context.Context, string) ([]*apigateway.Model, error)
+	ListAllRestApiGatewayResponses(context.Context, string) ([]*apigateway.UpdateGatewayResponseOutput, error)
 }
 
 type apigatewayRepository struct {
@@ -37,7 +38,7 @@ func NewApiGatewayRepository(session *session.Session, c cache.Cache) *apigatewa
 	}
 }
 
-func (r *apigatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error) {
+func (r *apigatewayRepository) ListAllRestApis(ctx context.Context) ([]*apigateway.RestApi, error) {
 	cacheKey := "apigatewayListAllRestApis"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -47,7 +48,7 @@ func (r *apigatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error)
 
 	var restApis []*apigateway.RestApi
 	input := apigateway.GetRestApisInput{}
-	err := r.client.GetRestApisPages(&input,
+	err := r.client.GetRestApisPagesWithContext(ctx, &input,
 		func(resp *apigateway.GetRestApisOutput, lastPage bool) bool {
 			restApis = append(restApis, resp.Items...)
 			return !lastPage
@@ -61,12 +62,12 @@ func (r *apigatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error)
 	return restApis, nil
 }
 
-func (r *apigatewayRepository) GetAccount() (*apigateway.Account, error) {
+func (r *apigatewayRepository) GetAccount(ctx context.Context) (*apigateway.Account, error) {
 	if v := r.cache.Get("apigatewayGetAccount"); v != nil {
 		return v.(*apigateway.Account), nil
 	}
 
-	account, err := r.client.GetAccount(&apigateway.GetAccountInput{})
+	account, err := r.client.GetAccountWithContext(ctx, &apigateway.GetAccountInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -75,14 +76,14 @@ func (r *apigatewayRepository) GetAccount() (*apigateway.Account, error) {
 	return account, nil
 }
 
-func (r *apigatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, error) {
+func (r *apigatewayRepository) ListAllApiKeys(ctx context.Context) ([]*apigateway.ApiKey, error) {
 	if v := r.cache.Get("apigatewayListAllApiKeys"); v != nil {
 		return v.([]*apigateway.ApiKey), nil
 	}
 
 	var apiKeys []*apigateway.ApiKey
 	input := apigateway.GetApiKeysInput{}
-	err := r.client.GetApiKeysPages(&input,
+	err := r.client.GetApiKeysPagesWithContext(ctx, &input,
 		func(resp *apigateway.GetApiKeysOutput, lastPage bool) bool {
 			apiKeys = append(apiKeys, resp.Items...)
 			return !lastPage
@@ -96,7 +97,7 @@ func (r *apigatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, error) {
 	return apiKeys, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiAuthorizers(apiId string) ([]*apigateway.Authorizer, error) {
+func (r *apigatewayRepository) ListAllRestApiAuthorizers(ctx context.Context, apiId string) ([]*apigateway.Authorizer, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiAuthorizers_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigateway.Authorizer), nil
@@ -105,7 +106,7 @@ func (r *apigatewayRepository) ListAllRestApiAuthorizers(apiId string) ([]*apiga
 	input := &apigateway.GetAuthorizersInput{
 		RestApiId: &apiId,
 	}
-	resources, err := r.client.GetAuthorizers(input)
+	resources, err := r.client.GetAuthorizersWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +115,7 @@ func (r *apigatewayRepository) ListAllRestApiAuthorizers(apiId string) ([]*apiga
 	return resources.Items, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiStages(apiId string) ([]*apigateway.Stage, error) {
+func (r *apigatewayRepository) ListAllRestApiStages(ctx context.Context, apiId string) ([]*apigateway.Stage, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiStages_api_%s", apiId)
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -125,7 +126,7 @@ func (r *apigatewayRepository) ListAllRestApiStages(apiId string) ([]*apigateway
 	input := &apigateway.GetStagesInput{
 		RestApiId: &apiId,
 	}
-	resources, err := r.client.GetStages(input)
+	resources, err := r.client.GetStagesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +135,7 @@ func (r *apigatewayRepository) ListAllRestApiStages(apiId string) ([]*apigateway
 	return resources.Item, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiResources(apiId string) ([]*apigateway.Resource, error) {
+func (r *apigatewayRepository) ListAllRestApiResources(ctx context.Context, apiId string) ([]*apigateway.Resource, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiResources_api_%s", apiId)
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -147,7 +148,7 @@ func (r *apigatewayRepository) ListAllRestApiResources(apiId string) ([]*apigate
 		RestApiId: &apiId,
 		Embed:     []*string{aws.String("methods")},
 	}
-	err := r.client.GetResourcesPages(input, func(res *apigateway.GetResourcesOutput, lastPage bool) bool {
+	err := r.client.GetResourcesPagesWithContext(ctx, input, func(res *apigateway.GetResourcesOutput, lastPage bool) bool {
 		resources = append(resources, res.Items...)
 		return !lastPage
 	})
@@ -159,7 +160,7 @@ func (r *apigatewayRepository) ListAllRestApiResources(apiId string) ([]*apigate
 	return resources, nil
 }
 
-func (r *apigatewayRepository) ListAllDomainNames() ([]*apigateway.DomainName, error) {
+func (r *apigatewayRepository) ListAllDomainNames(ctx context.Context) ([]*apigateway.DomainName, error) {
 	cacheKey := "apigatewayListAllDomainNames"
 	v := r.cache.GetAndLock(cacheKey)
 	defer r.cache.Unlock(cacheKey)
@@ -169,7 +170,7 @@ func (r *apigatewayRepository) ListAllDomainNames() ([]*apigateway.DomainName, e
 
 	var domainNames []*apigateway.DomainName
 	input := apigateway.GetDomainNamesInput{}
-	err := r.client.GetDomainNamesPages(&input,
+	err := r.client.GetDomainNamesPagesWithContext(ctx, &input,
 		func(resp *apigateway.GetDomainNamesOutput, lastPage bool) bool {
 			domainNames = append(domainNames, resp.Items...)
 			return !lastPage
@@ -183,14 +184,14 @@ func (r *apigatewayRepository) ListAllDomainNames() ([]*apigateway.DomainName, e
 	return domainNames, nil
 }
 
-func (r *apigatewayRepository) ListAllVpcLinks() ([]*apigateway.UpdateVpcLinkOutput, error) {
+func (r *apigatewayRepository) ListAllVpcLinks(ctx context.Context) ([]*apigateway.UpdateVpcLinkOutput, error) {
 	if v := r.cache.Get("apigatewayListAllVpcLinks"); v != nil {
 		return v.([]*apigateway.UpdateVpcLinkOutput), nil
 	}
 
 	var vpcLinks []*apigateway.UpdateVpcLinkOutput
 	input := apigateway.GetVpcLinksInput{}
-	err := r.client.GetVpcLinksPages(&input,
+	err := r.client.GetVpcLinksPagesWithContext(ctx, &input,
 		func(resp *apigateway.GetVpcLinksOutput, lastPage bool) bool {
 			vpcLinks = append(vpcLinks, resp.Items...)
 			return !lastPage
@@ -204,7 +205,7 @@ func (r *apigatewayRepository) ListAllVpcLinks() ([]*apigateway.UpdateVpcLinkOut
 	return vpcLinks, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiRequestValidators(apiId string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
+func (r *apigatewayRepository) ListAllRestApiRequestValidators(ctx context.Context, apiId string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiRequestValidators_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigateway.UpdateRequestValidatorOutput), nil
@@ -213,7 +214,7 @@ func (r *apigatewayRepository) ListAllRestApiRequestValidators(apiId string) ([]
 	input := &apigateway.GetRequestValidatorsInput{
 		RestApiId: &apiId,
 	}
-	resources, err := r.client.GetRequestValidators(input)
+	resources, err := r.client.GetRequestValidatorsWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +223,7 @@ func (r *apigatewayRepository) ListAllRestApiRequestValidators(apiId string) ([]
 	return resources.Items, nil
 }
 
-func (r *apigatewayRepository) ListAllDomainNameBasePathMappings(domainName string) ([]*apigateway.BasePathMapping, error) {
+func (r *apigatewayRepository) ListAllDomainNameBasePathMappings(ctx context.Context, domainName string) ([]*apigateway.BasePathMapping, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllDomainNameBasePathMappings_domainName_%s", domainName)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigateway.BasePathMapping), nil
@@ -232,7 +233,7 @@ func (r *apigatewayRepository) ListAllDomainNameBasePathMappings(domainName stri
 	input := &apigateway.GetBasePathMappingsInput{
 		DomainName: &domainName,
 	}
-	err := r.client.GetBasePathMappingsPages(input, func(res *apigateway.GetBasePathMappingsOutput, lastPage bool) bool {
+	err := r.client.GetBasePathMappingsPagesWithContext(ctx, input, func(res *apigateway.GetBasePathMappingsOutput, lastPage bool) bool {
 		mappings = append(mappings, res.Items...)
 		return !lastPage
 	})
@@ -244,7 +245,7 @@ func (r *apigatewayRepository) ListAllDomainNameBasePathMappings(domainName stri
 	return mappings, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiModels(apiId string) ([]*apigateway.Model, error) {
+func (r *apigatewayRepository) ListAllRestApiModels(ctx context.Context, apiId string) ([]*apigateway.Model, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiModels_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigateway.Model), nil
@@ -254,7 +255,7 @@ func (r *apigatewayRepository) ListAllRestApiModels(apiId string) ([]*apigateway
 	input := &apigateway.GetModelsInput{
 		RestApiId: &apiId,
 	}
-	err := r.client.GetModelsPages(input, func(res *apigateway.GetModelsOutput, lastPage bool) bool {
+	err := r.client.GetModelsPagesWithContext(ctx, input, func(res *apigateway.GetModelsOutput, lastPage bool) bool {
 		resources = append(resources, res.Items...)
 		return !lastPage
 	})
@@ -266,7 +267,7 @@ func (r *apigatewayRepository) ListAllRestApiModels(apiId string) ([]*apigateway
 	return resources, nil
 }
 
-func (r *apigatewayRepository) ListAllRestApiGatewayResponses(apiId string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
+func (r *apigatewayRepository) ListAllRestApiGatewayResponses(ctx context.Context, apiId string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
 	cacheKey := fmt.Sprintf("apigatewayListAllRestApiGatewayResponses_api_%s", apiId)
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*apigateway.UpdateGatewayResponseOutput), nil
@@ -275,7 +276,7 @@ func (r *apigatewayRepository) ListAllRestApiGatewayResponses(apiId string) ([]*
 	input := &apigateway.GetGatewayResponsesInput{
 		RestApiId: &apiId,
 	}
-	resources, err := r.client.GetGatewayResponses(input)
+	resources, err := r.client.GetGatewayResponsesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
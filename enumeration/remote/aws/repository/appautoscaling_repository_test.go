@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
@@ -32,7 +34,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScalableTargets",
+				client.On("DescribeScalableTargetsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalableTargetsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -54,7 +56,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScalableTargets",
+				client.On("DescribeScalableTargetsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalableTargetsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScalableTargetsOutput{
@@ -101,7 +103,7 @@ func Test_appautoscalingRepository_DescribeScalableTargets(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScalableTargets(tt.args.namespace)
+			got, err := r.DescribeScalableTargets(context.TODO(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
@@ -141,7 +143,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScalingPolicies",
+				client.On("DescribeScalingPoliciesWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalingPoliciesInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -163,7 +165,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScalingPolicies",
+				client.On("DescribeScalingPoliciesWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScalingPoliciesInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScalingPoliciesOutput{
@@ -210,7 +212,7 @@ func Test_appautoscalingRepository_DescribeScalingPolicies(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScalingPolicies(tt.args.namespace)
+			got, err := r.DescribeScalingPolicies(context.TODO(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
@@ -250,7 +252,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 				namespace: "test",
 			},
 			mocks: func(client *awstest.MockFakeApplicationAutoScaling, c *cache.MockCache) {
-				client.On("DescribeScheduledActions",
+				client.On("DescribeScheduledActionsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScheduledActionsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(nil, errors.New("remote error")).Once()
@@ -272,7 +274,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 					},
 				}
 
-				client.On("DescribeScheduledActions",
+				client.On("DescribeScheduledActionsWithContext", mock.Anything,
 					&applicationautoscaling.DescribeScheduledActionsInput{
 						ServiceNamespace: aws.String("test"),
 					}).Return(&applicationautoscaling.DescribeScheduledActionsOutput{
@@ -319,7 +321,7 @@ func Test_appautoscalingRepository_DescribeScheduledActions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeScheduledActions(tt.args.namespace)
+			got, err := r.DescribeScheduledActions(context.TODO(), tt.args.namespace)
 			if err != nil {
 				assert.EqualError(t, tt.wantErr, err.Error())
 			} else {
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type AthenaRepository interface {
+	ListAllWorkGroups(ctx context.Context) ([]*athena.WorkGroupSummary, error)
+}
+
+type athenaRepository struct {
+	client athenaiface.AthenaAPI
+	cache  cache.Cache
+}
+
+func NewAthenaRepository(session *session.Session, c cache.Cache) *athenaRepository {
+	return &athenaRepository{
+		athena.New(session),
+		c,
+	}
+}
+
+func (r *athenaRepository) ListAllWorkGroups(ctx context.Context) ([]*athena.WorkGroupSummary, error) {
+	if v := r.cache.Get("athenaListAllWorkGroups"); v != nil {
+		return v.([]*athena.WorkGroupSummary), nil
+	}
+
+	var workGroups []*athena.WorkGroupSummary
+	input := athena.ListWorkGroupsInput{}
+	err := r.client.ListWorkGroupsPagesWithContext(ctx, &input,
+		func(resp *athena.ListWorkGroupsOutput, lastPage bool) bool {
+			workGroups = append(workGroups, resp.WorkGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("athenaListAllWorkGroups", workGroups)
+	return workGroups, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_athenaRepository_ListAllWorkGroups(t *testing.T) {
+	items := []*athena.WorkGroupSummary{
+		{Name: aws.String("primary")},
+		{Name: aws.String("analysts")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeAthena, store *cache.MockCache)
+		want    []*athena.WorkGroupSummary
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeAthena, store *cache.MockCache) {
+				client.On("ListWorkGroupsPagesWithContext", mock.Anything,
+					&athena.ListWorkGroupsInput{},
+					mock.MatchedBy(func(callback func(res *athena.ListWorkGroupsOutput, lastPage bool) bool) bool {
+						callback(&athena.ListWorkGroupsOutput{WorkGroups: items[:1]}, false)
+						callback(&athena.ListWorkGroupsOutput{WorkGroups: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "athenaListAllWorkGroups").Return(nil).Times(1)
+				store.On("Put", "athenaListAllWorkGroups", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeAthena, store *cache.MockCache) {
+				store.On("Get", "athenaListAllWorkGroups").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeAthena, store *cache.MockCache) {
+				client.On("ListWorkGroupsPagesWithContext", mock.Anything,
+					&athena.ListWorkGroupsInput{},
+					mock.AnythingOfType("func(*athena.ListWorkGroupsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "athenaListAllWorkGroups").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeAthena{}
+			tt.mocks(client, store)
+			r := &athenaRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllWorkGroups(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
@@ -8,7 +10,7 @@ import (
 )
 
 type AutoScalingRepository interface {
-	DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error)
+	DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error)
 }
 
 type autoScalingRepository struct {
@@ -23,7 +25,7 @@ func NewAutoScalingRepository(session *session.Session, c cache.Cache) *autoScal
 	}
 }
 
-func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error) {
+func (r *autoScalingRepository) DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error) {
 	cacheKey := "DescribeLaunchConfigurations"
 	if v := r.cache.Get(cacheKey); v != nil {
 		return v.([]*autoscaling.LaunchConfiguration), nil
@@ -31,7 +33,7 @@ func (r *autoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.L
 
 	var results []*autoscaling.LaunchConfiguration
 	input := &autoscaling.DescribeLaunchConfigurationsInput{}
-	err := r.client.DescribeLaunchConfigurationsPages(input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+	err := r.client.DescribeLaunchConfigurationsPagesWithContext(ctx, input, func(resp *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
 		results = append(results, resp.LaunchConfigurations...)
 		return !lastPage
 	})
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -37,7 +38,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
 				store.On("Get", "DescribeLaunchConfigurations").Return(nil).Once()
 
-				client.On("DescribeLaunchConfigurationsPages",
+				client.On("DescribeLaunchConfigurationsPagesWithContext", mock.Anything,
 					&autoscaling.DescribeLaunchConfigurationsInput{},
 					mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
 						callback(&autoscaling.DescribeLaunchConfigurationsOutput{
@@ -65,7 +66,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 			mocks: func(client *awstest.MockFakeAutoscaling, store *cache.MockCache) {
 				store.On("Get", "DescribeLaunchConfigurations").Return(nil).Once()
 
-				client.On("DescribeLaunchConfigurationsPages", &autoscaling.DescribeLaunchConfigurationsInput{}, mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
+				client.On("DescribeLaunchConfigurationsPagesWithContext", mock.Anything, &autoscaling.DescribeLaunchConfigurationsInput{}, mock.MatchedBy(func(callback func(res *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool) bool {
 					callback(&autoscaling.DescribeLaunchConfigurationsOutput{
 						LaunchConfigurations: []*autoscaling.LaunchConfiguration{},
 					}, true)
@@ -85,7 +86,7 @@ func Test_AutoscalingRepository_DescribeLaunchConfigurations(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.DescribeLaunchConfigurations()
+			got, err := r.DescribeLaunchConfigurations(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
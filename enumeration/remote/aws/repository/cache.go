@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// Register values cached by AWS repositories so they can be persisted on disk between scans.
+// Generic values like []*string or []string are shared between several repositories, so they
+// are registered using their cache key to know which resources they belong to.
+func init() {
+	cache.RegisterPersistentKey("dynamodbListAllTables", []*string{}, aws.AwsDynamodbTableResourceType)
+	cache.RegisterPersistentKey("ec2IsEbsEncryptionEnabledByDefault", false, aws.AwsEbsEncryptionByDefaultResourceType)
+	cache.RegisterPersistentKey("ecsListAllTaskDefinitions", []*string{}, aws.AwsEcsTaskDefinitionResourceType)
+	cache.RegisterPersistentKey("eksListAllClusters", []*string{}, aws.AwsEksClusterResourceType, aws.AwsEksNodeGroupResourceType, aws.AwsEksAddonResourceType, aws.AwsEksFargateProfileResourceType, aws.AwsEksIdentityProviderConfigResourceType)
+	cache.RegisterPersistentKey("eksListAllNodeGroups_", []*string{}, aws.AwsEksNodeGroupResourceType)
+	cache.RegisterPersistentKey("eksListAllAddons_", []*string{}, aws.AwsEksAddonResourceType)
+	cache.RegisterPersistentKey("eksListAllFargateProfiles_", []*string{}, aws.AwsEksFargateProfileResourceType)
+	cache.RegisterPersistentKey("iamListAllUserPolicies_", []string{}, aws.AwsIamUserPolicyResourceType)
+	cache.RegisterPersistentKey("iamListAllGroupPolicies_", []string{}, aws.AwsIamGroupPolicyResourceType)
+	cache.RegisterPersistentKey("lambdaGetLambdaFunctionPolicy_", (*string)(nil), aws.AwsLambdaPermissionResourceType)
+	cache.RegisterPersistentKey("s3GetBucketPolicy_", (*string)(nil), aws.AwsS3BucketPolicyResourceType)
+	cache.RegisterPersistentKey("s3GetBucketLocation_", "", aws.AwsS3BucketResourceType, aws.AwsS3BucketPolicyResourceType, aws.AwsS3BucketMetricResourceType, aws.AwsS3BucketInventoryResourceType, aws.AwsS3BucketAnalyticsConfigurationResourceType, aws.AwsS3BucketNotificationResourceType, aws.AwsS3BucketPublicAccessBlockResourceType)
+	cache.RegisterPersistentKey("secretsmanagerGetSecretPolicy_", (*string)(nil), aws.AwsSecretsManagerSecretPolicyResourceType)
+	cache.RegisterPersistentKey("sesListAllIdentities_", []*string{}, aws.AwsSesDomainIdentityResourceType, aws.AwsSesEmailIdentityResourceType)
+	cache.RegisterPersistentKey("sesv2ListAllConfigurationSets", []*string{}, aws.AwsSesv2ConfigurationSetResourceType)
+	cache.RegisterPersistentKey("sqsListAllQueues", []*string{}, aws.AwsSqsQueueResourceType, aws.AwsSqsQueuePolicyResourceType)
+	cache.RegisterPersistentKey("wafv2ListAllResourcesForWebACL_", []*string{}, aws.AwsWafv2WebAclAssociationResourceType)
+
+	cache.RegisterPersistentType([]*acm.CertificateSummary{}, aws.AwsAcmCertificateResourceType)
+
+	cache.RegisterPersistentType([]*apigateway.RestApi{}, aws.AwsApiGatewayRestApiResourceType, aws.AwsApiGatewayRestApiPolicyResourceType)
+	cache.RegisterPersistentType(&apigateway.Account{}, aws.AwsApiGatewayAccountResourceType)
+	cache.RegisterPersistentType([]*apigateway.ApiKey{}, aws.AwsApiGatewayApiKeyResourceType)
+	cache.RegisterPersistentType([]*apigateway.Authorizer{}, aws.AwsApiGatewayAuthorizerResourceType)
+	cache.RegisterPersistentType([]*apigateway.Stage{}, aws.AwsApiGatewayStageResourceType, aws.AwsApiGatewayMethodSettingsResourceType)
+	cache.RegisterPersistentType([]*apigateway.Resource{}, aws.AwsApiGatewayResourceResourceType, aws.AwsApiGatewayMethodResourceType, aws.AwsApiGatewayMethodResponseResourceType, aws.AwsApiGatewayIntegrationResourceType, aws.AwsApiGatewayIntegrationResponseResourceType)
+	cache.RegisterPersistentType([]*apigateway.DomainName{}, aws.AwsApiGatewayDomainNameResourceType)
+	cache.RegisterPersistentType([]*apigateway.BasePathMapping{}, aws.AwsApiGatewayBasePathMappingResourceType)
+	cache.RegisterPersistentType([]*apigateway.Model{}, aws.AwsApiGatewayModelResourceType)
+	cache.RegisterPersistentType([]*apigateway.UpdateVpcLinkOutput{}, aws.AwsApiGatewayVpcLinkResourceType)
+	cache.RegisterPersistentType([]*apigateway.UpdateRequestValidatorOutput{}, aws.AwsApiGatewayRequestValidatorResourceType)
+	cache.RegisterPersistentType([]*apigateway.UpdateGatewayResponseOutput{}, aws.AwsApiGatewayGatewayResponseResourceType)
+
+	cache.RegisterPersistentType([]*apigatewayv2.Api{}, aws.AwsApiGatewayV2ApiResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Route{}, aws.AwsApiGatewayV2RouteResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.RouteResponse{}, aws.AwsApiGatewayV2RouteResponseResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Deployment{}, aws.AwsApiGatewayV2DeploymentResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.VpcLink{}, aws.AwsApiGatewayV2VpcLinkResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Authorizer{}, aws.AwsApiGatewayV2AuthorizerResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Integration{}, aws.AwsApiGatewayV2IntegrationResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.IntegrationResponse{}, aws.AwsApiGatewayV2IntegrationResponseResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Model{}, aws.AwsApiGatewayV2ModelResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.Stage{}, aws.AwsApiGatewayV2StageResourceType)
+	cache.RegisterPersistentType([]*apigatewayv2.ApiMapping{}, aws.AwsApiGatewayV2MappingResourceType)
+
+	cache.RegisterPersistentType([]*applicationautoscaling.ScalableTarget{}, aws.AwsAppAutoscalingTargetResourceType)
+	cache.RegisterPersistentType([]*applicationautoscaling.ScalingPolicy{}, aws.AwsAppAutoscalingPolicyResourceType)
+	cache.RegisterPersistentType([]*applicationautoscaling.ScheduledAction{}, aws.AwsAppAutoscalingScheduledActionResourceType)
+
+	cache.RegisterPersistentType([]*autoscaling.LaunchConfiguration{}, aws.AwsLaunchConfigurationResourceType)
+	cache.RegisterPersistentType([]*cloudformation.Stack{}, aws.AwsCloudformationStackResourceType)
+	cache.RegisterPersistentType([]*cloudfront.DistributionSummary{}, aws.AwsCloudfrontDistributionResourceType)
+	cache.RegisterPersistentType([]*cloudtrail.TrailInfo{}, aws.AwsCloudtrailResourceType)
+
+	cache.RegisterPersistentType([]*ec2.Image{}, aws.AwsAmiResourceType)
+	cache.RegisterPersistentType([]*ec2.Snapshot{}, aws.AwsEbsSnapshotResourceType)
+	cache.RegisterPersistentType([]*ec2.Volume{}, aws.AwsEbsVolumeResourceType)
+	cache.RegisterPersistentType([]*ec2.Address{}, aws.AwsEipResourceType, aws.AwsEipAssociationResourceType)
+	cache.RegisterPersistentType([]*ec2.Instance{}, aws.AwsInstanceResourceType)
+	cache.RegisterPersistentType([]*ec2.KeyPairInfo{}, aws.AwsKeyPairResourceType)
+	cache.RegisterPersistentType([]*ec2.InternetGateway{}, aws.AwsInternetGatewayResourceType)
+	cache.RegisterPersistentType([]*ec2.Subnet{}, aws.AwsSubnetResourceType, aws.AwsDefaultSubnetResourceType)
+	cache.RegisterPersistentType([]*ec2.Vpc{}, aws.AwsVpcResourceType, aws.AwsDefaultVpcResourceType)
+	cache.RegisterPersistentType([]*ec2.SecurityGroup{}, aws.AwsSecurityGroupResourceType, aws.AwsDefaultSecurityGroupResourceType, aws.AwsSecurityGroupRuleResourceType)
+	cache.RegisterPersistentType([]*ec2.NatGateway{}, aws.AwsNatGatewayResourceType)
+	cache.RegisterPersistentType([]*ec2.RouteTable{}, aws.AwsRouteTableResourceType, aws.AwsDefaultRouteTableResourceType, aws.AwsRouteResourceType, aws.AwsRouteTableAssociationResourceType)
+	cache.RegisterPersistentType([]*ec2.NetworkAcl{}, aws.AwsNetworkACLResourceType, aws.AwsDefaultNetworkACLResourceType, aws.AwsNetworkACLRuleResourceType)
+	cache.RegisterPersistentType([]*ec2.LaunchTemplate{}, aws.AwsLaunchTemplateResourceType)
+	cache.RegisterPersistentType([]*ec2.VpcEndpoint{}, aws.AwsVpcEndpointResourceType)
+	cache.RegisterPersistentType([]*ec2.VpcPeeringConnection{}, aws.AwsVpcPeeringConnectionResourceType)
+	cache.RegisterPersistentType([]*ec2.TransitGateway{}, aws.AwsEc2TransitGatewayResourceType)
+	cache.RegisterPersistentType([]*ec2.TransitGatewayVpcAttachment{}, aws.AwsEc2TransitGatewayVpcAttachmentResourceType)
+	cache.RegisterPersistentType([]*ec2.TransitGatewayRouteTable{}, aws.AwsEc2TransitGatewayRouteTableResourceType)
+	cache.RegisterPersistentType([]*ec2.FlowLog{}, aws.AwsFlowLogResourceType)
+	cache.RegisterPersistentType([]*ec2.DhcpOptions{}, aws.AwsVpcDhcpOptionsResourceType)
+	cache.RegisterPersistentType([]*ec2.NetworkInterface{}, aws.AwsNetworkInterfaceResourceType)
+
+	cache.RegisterPersistentType([]*ecr.Repository{}, aws.AwsEcrRepositoryResourceType)
+	cache.RegisterPersistentType(&ecr.GetRepositoryPolicyOutput{}, aws.AwsEcrRepositoryPolicyResourceType)
+	cache.RegisterPersistentType([]*ecs.Cluster{}, aws.AwsEcsClusterResourceType, aws.AwsEcsServiceResourceType)
+	cache.RegisterPersistentType([]*ecs.Service{}, aws.AwsEcsServiceResourceType)
+	cache.RegisterPersistentType([]*ecs.CapacityProvider{}, aws.AwsEcsCapacityProviderResourceType)
+	cache.RegisterPersistentType([]*eks.IdentityProviderConfig{}, aws.AwsEksIdentityProviderConfigResourceType)
+	cache.RegisterPersistentType([]*secretsmanager.SecretListEntry{}, aws.AwsSecretsManagerSecretResourceType, aws.AwsSecretsManagerSecretPolicyResourceType, aws.AwsSecretsManagerSecretRotationResourceType)
+	cache.RegisterPersistentType([]*ssm.ParameterMetadata{}, aws.AwsSsmParameterResourceType)
+	cache.RegisterPersistentType([]*ssm.DocumentIdentifier{}, aws.AwsSsmDocumentResourceType)
+	cache.RegisterPersistentType([]*cloudwatchevents.EventBus{}, aws.AwsCloudwatchEventBusResourceType, aws.AwsCloudwatchEventRuleResourceType, aws.AwsCloudwatchEventTargetResourceType)
+	cache.RegisterPersistentType([]*cloudwatchevents.Rule{}, aws.AwsCloudwatchEventRuleResourceType, aws.AwsCloudwatchEventTargetResourceType)
+	cache.RegisterPersistentType([]*cloudwatchevents.Target{}, aws.AwsCloudwatchEventTargetResourceType)
+	cache.RegisterPersistentType([]*sfn.StateMachineListItem{}, aws.AwsSfnStateMachineResourceType)
+	cache.RegisterPersistentType([]*sfn.ActivityListItem{}, aws.AwsSfnActivityResourceType)
+	cache.RegisterPersistentType([]*cloudwatchlogs.LogGroup{}, aws.AwsCloudwatchLogGroupResourceType, aws.AwsCloudwatchLogSubscriptionFilterResourceType)
+	cache.RegisterPersistentType([]*cloudwatchlogs.MetricFilter{}, aws.AwsCloudwatchLogMetricFilterResourceType)
+	cache.RegisterPersistentType([]*cloudwatchlogs.SubscriptionFilter{}, aws.AwsCloudwatchLogSubscriptionFilterResourceType)
+	cache.RegisterPersistentType([]*cloudwatch.MetricAlarm{}, aws.AwsCloudwatchMetricAlarmResourceType)
+	cache.RegisterPersistentType([]*cloudwatch.DashboardEntry{}, aws.AwsCloudwatchDashboardResourceType)
+	cache.RegisterPersistentType([]*elasticache.CacheCluster{}, aws.AwsElastiCacheClusterResourceType)
+	cache.RegisterPersistentType([]*elb.LoadBalancerDescription{}, aws.AwsClassicLoadBalancerResourceType)
+	cache.RegisterPersistentType([]*elbv2.LoadBalancer{}, aws.AwsLoadBalancerResourceType, aws.AwsApplicationLoadBalancerResourceType)
+	cache.RegisterPersistentType([]*elbv2.Listener{}, aws.AwsLoadBalancerListenerResourceType, aws.AwsApplicationLoadBalancerListenerResourceType)
+
+	cache.RegisterPersistentType([]*iam.User{}, aws.AwsIamUserResourceType, aws.AwsIamUserPolicyResourceType, aws.AwsIamUserPolicyAttachmentResourceType, aws.AwsIamAccessKeyResourceType)
+	cache.RegisterPersistentType([]*iam.Role{}, aws.AwsIamRoleResourceType, aws.AwsIamRolePolicyResourceType, aws.AwsIamRolePolicyAttachmentResourceType)
+	cache.RegisterPersistentType([]*iam.Group{}, aws.AwsIamGroupResourceType, aws.AwsIamGroupPolicyResourceType, aws.AwsIamGroupPolicyAttachmentResourceType)
+	cache.RegisterPersistentType([]*iam.Policy{}, aws.AwsIamPolicyResourceType)
+	cache.RegisterPersistentType([]*iam.AccessKeyMetadata{}, aws.AwsIamAccessKeyResourceType)
+	cache.RegisterPersistentType([]*AttachedUserPolicy{}, aws.AwsIamUserPolicyAttachmentResourceType, aws.AwsIamPolicyAttachmentResourceType)
+	cache.RegisterPersistentType([]*AttachedRolePolicy{}, aws.AwsIamRolePolicyAttachmentResourceType, aws.AwsIamPolicyAttachmentResourceType)
+	cache.RegisterPersistentType([]*AttachedGroupPolicy{}, aws.AwsIamGroupPolicyAttachmentResourceType, aws.AwsIamPolicyAttachmentResourceType)
+	cache.RegisterPersistentType([]RolePolicy{}, aws.AwsIamRolePolicyResourceType)
+
+	cache.RegisterPersistentType([]*kms.KeyListEntry{}, aws.AwsKmsKeyResourceType)
+	cache.RegisterPersistentType([]*kms.AliasListEntry{}, aws.AwsKmsAliasResourceType)
+	cache.RegisterPersistentType(&kms.DescribeKeyOutput{}, aws.AwsKmsKeyResourceType)
+
+	cache.RegisterPersistentType([]*lambda.FunctionConfiguration{}, aws.AwsLambdaFunctionResourceType, aws.AwsLambdaPermissionResourceType, aws.AwsLambdaAliasResourceType)
+	cache.RegisterPersistentType([]*lambda.EventSourceMappingConfiguration{}, aws.AwsLambdaEventSourceMappingResourceType)
+	cache.RegisterPersistentType([]*lambda.AliasConfiguration{}, aws.AwsLambdaAliasResourceType)
+
+	cache.RegisterPersistentType([]*rds.DBInstance{}, aws.AwsDbInstanceResourceType)
+	cache.RegisterPersistentType([]*rds.DBSubnetGroup{}, aws.AwsDbSubnetGroupResourceType)
+	cache.RegisterPersistentType([]*rds.DBCluster{}, aws.AwsRDSClusterResourceType, aws.AwsRDSClusterInstanceResourceType)
+
+	cache.RegisterPersistentType([]*docdb.DBCluster{}, aws.AwsDocDBClusterResourceType)
+	cache.RegisterPersistentType([]*docdb.DBClusterParameterGroup{}, aws.AwsDocDBClusterParameterGroupResourceType)
+
+	cache.RegisterPersistentType([]*efs.FileSystemDescription{}, aws.AwsEfsFileSystemResourceType, aws.AwsEfsMountTargetResourceType)
+	cache.RegisterPersistentType([]*efs.MountTargetDescription{}, aws.AwsEfsMountTargetResourceType)
+
+	cache.RegisterPersistentType([]*elasticsearchservice.ElasticsearchDomainStatus{}, aws.AwsElasticsearchDomainResourceType, aws.AwsOpenSearchDomainResourceType)
+
+	cache.RegisterPersistentType([]*kafka.ClusterInfo{}, aws.AwsMskClusterResourceType)
+
+	cache.RegisterPersistentType([]*redshift.Cluster{}, aws.AwsRedshiftClusterResourceType)
+	cache.RegisterPersistentType([]*redshift.ClusterSubnetGroup{}, aws.AwsRedshiftSubnetGroupResourceType)
+	cache.RegisterPersistentType([]*redshift.ClusterParameterGroup{}, aws.AwsRedshiftParameterGroupResourceType)
+
+	cache.RegisterPersistentType([]*kinesis.StreamDescriptionSummary{}, aws.AwsKinesisStreamResourceType)
+	cache.RegisterPersistentType([]*firehose.DeliveryStreamDescription{}, aws.AwsKinesisFirehoseDeliveryStreamResourceType)
+
+	cache.RegisterPersistentType([]*glue.Database{}, aws.AwsGlueCatalogDatabaseResourceType, aws.AwsGlueCatalogTableResourceType)
+	cache.RegisterPersistentType([]*glue.TableData{}, aws.AwsGlueCatalogTableResourceType)
+	cache.RegisterPersistentType([]*glue.Job{}, aws.AwsGlueJobResourceType)
+	cache.RegisterPersistentType([]*glue.Crawler{}, aws.AwsGlueCrawlerResourceType)
+
+	cache.RegisterPersistentType([]*athena.WorkGroupSummary{}, aws.AwsAthenaWorkgroupResourceType)
+
+	cache.RegisterPersistentType([]*cognitoidentityprovider.UserPoolDescriptionType{}, aws.AwsCognitoUserPoolResourceType, aws.AwsCognitoUserPoolClientResourceType)
+	cache.RegisterPersistentType([]*cognitoidentityprovider.UserPoolClientDescription{}, aws.AwsCognitoUserPoolClientResourceType)
+	cache.RegisterPersistentType([]*cognitoidentity.IdentityPoolShortDescription{}, aws.AwsCognitoIdentityPoolResourceType)
+
+	cache.RegisterPersistentType([]*route53.HealthCheck{}, aws.AwsRoute53HealthCheckResourceType)
+	cache.RegisterPersistentType([]*route53.HostedZone{}, aws.AwsRoute53ZoneResourceType)
+	cache.RegisterPersistentType([]*route53.ResourceRecordSet{}, aws.AwsRoute53RecordResourceType)
+
+	cache.RegisterPersistentType([]*s3.Bucket{}, aws.AwsS3BucketResourceType, aws.AwsS3BucketPolicyResourceType)
+	cache.RegisterPersistentType([]*s3.MetricsConfiguration{}, aws.AwsS3BucketMetricResourceType)
+	cache.RegisterPersistentType([]*s3.InventoryConfiguration{}, aws.AwsS3BucketInventoryResourceType)
+	cache.RegisterPersistentType([]*s3.AnalyticsConfiguration{}, aws.AwsS3BucketAnalyticsConfigurationResourceType)
+	cache.RegisterPersistentType(&s3.NotificationConfiguration{}, aws.AwsS3BucketNotificationResourceType)
+	cache.RegisterPersistentType(&s3.PublicAccessBlockConfiguration{}, aws.AwsS3BucketPublicAccessBlockResourceType)
+	cache.RegisterPersistentType(&s3control.PublicAccessBlockConfiguration{}, aws.AwsS3BucketPublicAccessBlockResourceType)
+
+	cache.RegisterPersistentType([]*shield.Protection{}, aws.AwsShieldProtectionResourceType)
+
+	cache.RegisterPersistentType([]*sns.Topic{}, aws.AwsSnsTopicResourceType, aws.AwsSnsTopicPolicyResourceType)
+	cache.RegisterPersistentType([]*sns.Subscription{}, aws.AwsSnsTopicSubscriptionResourceType)
+	cache.RegisterPersistentType(&sqs.GetQueueAttributesOutput{}, aws.AwsSqsQueueResourceType, aws.AwsSqsQueuePolicyResourceType)
+
+	cache.RegisterPersistentType([]*wafv2.WebACLSummary{}, aws.AwsWafv2WebAclResourceType, aws.AwsWafv2WebAclAssociationResourceType)
+	cache.RegisterPersistentType([]*wafv2.IPSetSummary{}, aws.AwsWafv2IpSetResourceType)
+	cache.RegisterPersistentType([]*wafv2.RuleGroupSummary{}, aws.AwsWafv2RuleGroupResourceType)
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+// cachedValue is a value put in the cache by a repository method
+type cachedValue struct {
+	position string
+	// key is the cache key up to its first formatting verb
+	key string
+	// typ is the first result type of the repository method
+	typ string
+}
+
+// listCachedValues reads repositories source code to find every value they put in the cache
+func listCachedValues(t *testing.T) []cachedValue {
+	files, err := filepath.Glob("*_repository.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make([]cachedValue, 0)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Type.Results == nil {
+				continue
+			}
+			keys := map[string]string{}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.AssignStmt:
+					if ident, ok := n.Lhs[0].(*ast.Ident); ok && len(n.Rhs) == 1 {
+						if key, ok := cacheKeyLiteral(n.Rhs[0]); ok {
+							keys[ident.Name] = key
+						}
+					}
+				case *ast.CallExpr:
+					sel, ok := n.Fun.(*ast.SelectorExpr)
+					if !ok || sel.Sel.Name != "Put" || len(n.Args) != 2 {
+						return true
+					}
+					key, ok := cacheKeyLiteral(n.Args[0])
+					if ident, isIdent := n.Args[0].(*ast.Ident); !ok && isIdent {
+						key, ok = keys[ident.Name]
+					}
+					if !ok {
+						t.Errorf("%s: unable to find the cache key used in %s", fset.Position(n.Pos()), fn.Name.Name)
+						return true
+					}
+					values = append(values, cachedValue{
+						position: fset.Position(n.Pos()).String(),
+						key:      key,
+						typ:      qualifiedTypeName(types.ExprString(fn.Type.Results.List[0].Type)),
+					})
+				}
+				return true
+			})
+		}
+	}
+	return values
+}
+
+// cacheKeyLiteral returns the constant part of a cache key built from a string literal or fmt.Sprintf
+func cacheKeyLiteral(expr ast.Expr) (string, bool) {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) > 0 {
+		expr = call.Args[0]
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	key, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	if i := strings.Index(key, "%"); i >= 0 {
+		key = key[:i]
+	}
+	return key, true
+}
+
+// qualifiedTypeName prefixes types declared in this package with its name, as reflect does
+func qualifiedTypeName(name string) string {
+	elem := strings.TrimLeft(name, "[]*")
+	if strings.Contains(elem, ".") || strings.ToLower(elem[:1]) == elem[:1] {
+		return name
+	}
+	return name[:len(name)-len(elem)] + "repository." + elem
+}
+
+// sampleValue builds a non empty value of a given type so that its content goes through serialization
+func sampleValue(typ reflect.Type) reflect.Value {
+	switch typ.Kind() {
+	case reflect.Ptr:
+		v := reflect.New(typ.Elem())
+		v.Elem().Set(sampleValue(typ.Elem()))
+		return v
+	case reflect.Slice:
+		return reflect.Append(reflect.MakeSlice(typ, 0, 1), sampleValue(typ.Elem()))
+	case reflect.String:
+		return reflect.ValueOf("sample").Convert(typ)
+	case reflect.Bool:
+		return reflect.ValueOf(true).Convert(typ)
+	case reflect.Struct:
+		v := reflect.New(typ).Elem()
+		for i := 0; i < typ.NumField(); i++ {
+			field := v.Field(i)
+			if field.CanSet() && (field.Kind() == reflect.String || (field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.String)) {
+				field.Set(sampleValue(field.Type()))
+			}
+		}
+		return v
+	default:
+		return reflect.New(typ).Elem()
+	}
+}
+
+func TestCachedValuesArePersisted(t *testing.T) {
+	values := listCachedValues(t)
+	assert.NotEmpty(t, values)
+
+	for _, v := range values {
+		t.Run(v.key, func(t *testing.T) {
+			typ, registered := cache.PersistentType(v.key, v.typ)
+			if !assert.True(t, registered, "%s: values of type %s cached under %s are not persisted", v.position, v.typ, v.key) {
+				return
+			}
+
+			value := sampleValue(typ).Interface()
+			dir := t.TempDir()
+			c := cache.NewPersistentCache(cache.New(1), dir, cache.TTLConfig{Default: time.Hour})
+			c.Put(v.key, value)
+
+			c = cache.NewPersistentCache(cache.New(1), dir, cache.TTLConfig{Default: time.Hour})
+			assert.Equal(t, value, c.Get(v.key), "%s: values of type %s cached under %s do not round-trip", v.position, v.typ, v.key)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type CloudwatchRepository interface {
+	ListAllMetricAlarms(ctx context.Context) ([]*cloudwatch.MetricAlarm, error)
+	ListAllDashboards(ctx context.Context) ([]*cloudwatch.DashboardEntry, error)
+}
+
+type cloudwatchRepository struct {
+	client cloudwatchiface.CloudWatchAPI
+	cache  cache.Cache
+}
+
+func NewCloudwatchRepository(session *session.Session, c cache.Cache) *cloudwatchRepository {
+	return &cloudwatchRepository{
+		cloudwatch.New(session),
+		c,
+	}
+}
+
+func (r *cloudwatchRepository) ListAllMetricAlarms(ctx context.Context) ([]*cloudwatch.MetricAlarm, error) {
+	if v := r.cache.Get("cloudwatchListAllMetricAlarms"); v != nil {
+		return v.([]*cloudwatch.MetricAlarm), nil
+	}
+
+	var alarms []*cloudwatch.MetricAlarm
+	input := &cloudwatch.DescribeAlarmsInput{}
+	err := r.client.DescribeAlarmsPagesWithContext(ctx, input, func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+		alarms = append(alarms, res.MetricAlarms...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchListAllMetricAlarms", alarms)
+	return alarms, nil
+}
+
+func (r *cloudwatchRepository) ListAllDashboards(ctx context.Context) ([]*cloudwatch.DashboardEntry, error) {
+	if v := r.cache.Get("cloudwatchListAllDashboards"); v != nil {
+		return v.([]*cloudwatch.DashboardEntry), nil
+	}
+
+	var dashboards []*cloudwatch.DashboardEntry
+	input := &cloudwatch.ListDashboardsInput{}
+	err := r.client.ListDashboardsPagesWithContext(ctx, input, func(res *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
+		dashboards = append(dashboards, res.DashboardEntries...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchListAllDashboards", dashboards)
+	return dashboards, nil
+}
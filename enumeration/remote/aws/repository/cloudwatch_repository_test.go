@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudwatchRepository_ListAllMetricAlarms(t *testing.T) {
+	items := []*cloudwatch.MetricAlarm{
+		{AlarmName: aws.String("cpu-high")},
+		{AlarmName: aws.String("errors")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatch, store *cache.MockCache)
+		want    []*cloudwatch.MetricAlarm
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				client.On("DescribeAlarmsPagesWithContext", mock.Anything,
+					&cloudwatch.DescribeAlarmsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: items[:1]}, false)
+						callback(&cloudwatch.DescribeAlarmsOutput{MetricAlarms: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cloudwatchListAllMetricAlarms").Return(nil).Times(1)
+				store.On("Put", "cloudwatchListAllMetricAlarms", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				store.On("Get", "cloudwatchListAllMetricAlarms").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				client.On("DescribeAlarmsPagesWithContext", mock.Anything,
+					&cloudwatch.DescribeAlarmsInput{},
+					mock.AnythingOfType("func(*cloudwatch.DescribeAlarmsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cloudwatchListAllMetricAlarms").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatch{}
+			tt.mocks(client, store)
+			r := &cloudwatchRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllMetricAlarms(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchRepository_ListAllDashboards(t *testing.T) {
+	items := []*cloudwatch.DashboardEntry{
+		{DashboardName: aws.String("overview")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatch, store *cache.MockCache)
+		want    []*cloudwatch.DashboardEntry
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				client.On("ListDashboardsPagesWithContext", mock.Anything,
+					&cloudwatch.ListDashboardsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatch.ListDashboardsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatch.ListDashboardsOutput{DashboardEntries: items}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cloudwatchListAllDashboards").Return(nil).Times(1)
+				store.On("Put", "cloudwatchListAllDashboards", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				store.On("Get", "cloudwatchListAllDashboards").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatch, store *cache.MockCache) {
+				client.On("ListDashboardsPagesWithContext", mock.Anything,
+					&cloudwatch.ListDashboardsInput{},
+					mock.AnythingOfType("func(*cloudwatch.ListDashboardsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cloudwatchListAllDashboards").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatch{}
+			tt.mocks(client, store)
+			r := &cloudwatchRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDashboards(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
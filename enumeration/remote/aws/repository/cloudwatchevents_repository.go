@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type CloudwatchEventsRepository interface {
+	ListAllEventBuses(ctx context.Context) ([]*cloudwatchevents.EventBus, error)
+	ListAllRules(ctx context.Context, eventBusName string) ([]*cloudwatchevents.Rule, error)
+	ListAllTargets(ctx context.Context, eventBusName, ruleName string) ([]*cloudwatchevents.Target, error)
+}
+
+type cloudwatchEventsRepository struct {
+	client cloudwatcheventsiface.CloudWatchEventsAPI
+	cache  cache.Cache
+}
+
+func NewCloudwatchEventsRepository(session *session.Session, c cache.Cache) *cloudwatchEventsRepository {
+	return &cloudwatchEventsRepository{
+		cloudwatchevents.New(session),
+		c,
+	}
+}
+
+func (r *cloudwatchEventsRepository) ListAllEventBuses(ctx context.Context) ([]*cloudwatchevents.EventBus, error) {
+	cacheKey := "cloudwatcheventsListAllEventBuses"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cloudwatchevents.EventBus), nil
+	}
+
+	var eventBuses []*cloudwatchevents.EventBus
+	input := &cloudwatchevents.ListEventBusesInput{}
+	for {
+		res, err := r.client.ListEventBusesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		eventBuses = append(eventBuses, res.EventBuses...)
+		if res.NextToken == nil {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+
+	r.cache.Put(cacheKey, eventBuses)
+	return eventBuses, nil
+}
+
+func (r *cloudwatchEventsRepository) ListAllRules(ctx context.Context, eventBusName string) ([]*cloudwatchevents.Rule, error) {
+	cacheKey := fmt.Sprintf("cloudwatcheventsListAllRules_%s", eventBusName)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cloudwatchevents.Rule), nil
+	}
+
+	var rules []*cloudwatchevents.Rule
+	input := &cloudwatchevents.ListRulesInput{
+		EventBusName: aws.String(eventBusName),
+	}
+	for {
+		res, err := r.client.ListRulesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, res.Rules...)
+		if res.NextToken == nil {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+
+	r.cache.Put(cacheKey, rules)
+	return rules, nil
+}
+
+func (r *cloudwatchEventsRepository) ListAllTargets(ctx context.Context, eventBusName, ruleName string) ([]*cloudwatchevents.Target, error) {
+	cacheKey := fmt.Sprintf("cloudwatcheventsListAllTargets_%s_%s", eventBusName, ruleName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*cloudwatchevents.Target), nil
+	}
+
+	var targets []*cloudwatchevents.Target
+	input := &cloudwatchevents.ListTargetsByRuleInput{
+		EventBusName: aws.String(eventBusName),
+		Rule:         aws.String(ruleName),
+	}
+	for {
+		res, err := r.client.ListTargetsByRuleWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, res.Targets...)
+		if res.NextToken == nil {
+			break
+		}
+		input.NextToken = res.NextToken
+	}
+
+	r.cache.Put(cacheKey, targets)
+	return targets, nil
+}
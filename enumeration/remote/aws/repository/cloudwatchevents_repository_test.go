@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudwatchEventsRepository_ListAllEventBuses(t *testing.T) {
+	eventBuses := []*cloudwatchevents.EventBus{
+		{Name: aws.String("default")},
+		{Name: aws.String("orders")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache)
+		want    []*cloudwatchevents.EventBus
+		wantErr error
+	}{
+		{
+			name: "list event buses with 2 pages",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListEventBusesWithContext", mock.Anything, &cloudwatchevents.ListEventBusesInput{}).
+					Return(&cloudwatchevents.ListEventBusesOutput{
+						EventBuses: eventBuses[:1],
+						NextToken:  aws.String("next"),
+					}, nil).Once()
+				client.On("ListEventBusesWithContext", mock.Anything, &cloudwatchevents.ListEventBusesInput{NextToken: aws.String("next")}).
+					Return(&cloudwatchevents.ListEventBusesOutput{
+						EventBuses: eventBuses[1:],
+					}, nil).Once()
+				store.On("GetAndLock", "cloudwatcheventsListAllEventBuses").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatcheventsListAllEventBuses").Times(1)
+				store.On("Put", "cloudwatcheventsListAllEventBuses", eventBuses).Return(false).Times(1)
+			},
+			want: eventBuses,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				store.On("GetAndLock", "cloudwatcheventsListAllEventBuses").Return(eventBuses).Times(1)
+				store.On("Unlock", "cloudwatcheventsListAllEventBuses").Times(1)
+			},
+			want: eventBuses,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListEventBusesWithContext", mock.Anything, &cloudwatchevents.ListEventBusesInput{}).
+					Return(nil, remoteError).Once()
+				store.On("GetAndLock", "cloudwatcheventsListAllEventBuses").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatcheventsListAllEventBuses").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchEvents{}
+			tt.mocks(client, store)
+			r := &cloudwatchEventsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllEventBuses(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchEventsRepository_ListAllRules(t *testing.T) {
+	rules := []*cloudwatchevents.Rule{
+		{Name: aws.String("nightly"), EventBusName: aws.String("orders")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache)
+		want    []*cloudwatchevents.Rule
+		wantErr error
+	}{
+		{
+			name: "list rules of an event bus",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListRulesWithContext", mock.Anything, &cloudwatchevents.ListRulesInput{EventBusName: aws.String("orders")}).
+					Return(&cloudwatchevents.ListRulesOutput{Rules: rules}, nil).Once()
+				store.On("GetAndLock", "cloudwatcheventsListAllRules_orders").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatcheventsListAllRules_orders").Times(1)
+				store.On("Put", "cloudwatcheventsListAllRules_orders", rules).Return(false).Times(1)
+			},
+			want: rules,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListRulesWithContext", mock.Anything, &cloudwatchevents.ListRulesInput{EventBusName: aws.String("orders")}).
+					Return(nil, remoteError).Once()
+				store.On("GetAndLock", "cloudwatcheventsListAllRules_orders").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatcheventsListAllRules_orders").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchEvents{}
+			tt.mocks(client, store)
+			r := &cloudwatchEventsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllRules(context.TODO(), "orders")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchEventsRepository_ListAllTargets(t *testing.T) {
+	targets := []*cloudwatchevents.Target{
+		{Id: aws.String("lambda"), Arn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:process")},
+	}
+	input := &cloudwatchevents.ListTargetsByRuleInput{
+		EventBusName: aws.String("orders"),
+		Rule:         aws.String("nightly"),
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache)
+		want    []*cloudwatchevents.Target
+		wantErr error
+	}{
+		{
+			name: "list targets of a rule",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListTargetsByRuleWithContext", mock.Anything, input).
+					Return(&cloudwatchevents.ListTargetsByRuleOutput{Targets: targets}, nil).Once()
+				store.On("Get", "cloudwatcheventsListAllTargets_orders_nightly").Return(nil).Times(1)
+				store.On("Put", "cloudwatcheventsListAllTargets_orders_nightly", targets).Return(false).Times(1)
+			},
+			want: targets,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				store.On("Get", "cloudwatcheventsListAllTargets_orders_nightly").Return(targets).Times(1)
+			},
+			want: targets,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchEvents, store *cache.MockCache) {
+				client.On("ListTargetsByRuleWithContext", mock.Anything, input).Return(nil, remoteError).Once()
+				store.On("Get", "cloudwatcheventsListAllTargets_orders_nightly").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchEvents{}
+			tt.mocks(client, store)
+			r := &cloudwatchEventsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTargets(context.TODO(), "orders", "nightly")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
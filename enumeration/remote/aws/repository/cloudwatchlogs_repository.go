@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type CloudwatchLogsRepository interface {
+	ListAllLogGroups(ctx context.Context) ([]*cloudwatchlogs.LogGroup, error)
+	ListAllMetricFilters(ctx context.Context) ([]*cloudwatchlogs.MetricFilter, error)
+	ListAllSubscriptionFilters(ctx context.Context, logGroupName string) ([]*cloudwatchlogs.SubscriptionFilter, error)
+}
+
+type cloudwatchLogsRepository struct {
+	client cloudwatchlogsiface.CloudWatchLogsAPI
+	cache  cache.Cache
+}
+
+func NewCloudwatchLogsRepository(session *session.Session, c cache.Cache) *cloudwatchLogsRepository {
+	return &cloudwatchLogsRepository{
+		cloudwatchlogs.New(session),
+		c,
+	}
+}
+
+func (r *cloudwatchLogsRepository) ListAllLogGroups(ctx context.Context) ([]*cloudwatchlogs.LogGroup, error) {
+	cacheKey := "cloudwatchlogsListAllLogGroups"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cloudwatchlogs.LogGroup), nil
+	}
+
+	var logGroups []*cloudwatchlogs.LogGroup
+	input := &cloudwatchlogs.DescribeLogGroupsInput{}
+	err := r.client.DescribeLogGroupsPagesWithContext(ctx, input, func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		logGroups = append(logGroups, res.LogGroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, logGroups)
+	return logGroups, nil
+}
+
+// ListAllMetricFilters returns the metric filters of every log group of the region
+func (r *cloudwatchLogsRepository) ListAllMetricFilters(ctx context.Context) ([]*cloudwatchlogs.MetricFilter, error) {
+	if v := r.cache.Get("cloudwatchlogsListAllMetricFilters"); v != nil {
+		return v.([]*cloudwatchlogs.MetricFilter), nil
+	}
+
+	var metricFilters []*cloudwatchlogs.MetricFilter
+	input := &cloudwatchlogs.DescribeMetricFiltersInput{}
+	err := r.client.DescribeMetricFiltersPagesWithContext(ctx, input, func(res *cloudwatchlogs.DescribeMetricFiltersOutput, lastPage bool) bool {
+		metricFilters = append(metricFilters, res.MetricFilters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cloudwatchlogsListAllMetricFilters", metricFilters)
+	return metricFilters, nil
+}
+
+func (r *cloudwatchLogsRepository) ListAllSubscriptionFilters(ctx context.Context, logGroupName string) ([]*cloudwatchlogs.SubscriptionFilter, error) {
+	cacheKey := fmt.Sprintf("cloudwatchlogsListAllSubscriptionFilters_%s", logGroupName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*cloudwatchlogs.SubscriptionFilter), nil
+	}
+
+	var subscriptionFilters []*cloudwatchlogs.SubscriptionFilter
+	input := &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+		LogGroupName: &logGroupName,
+	}
+	err := r.client.DescribeSubscriptionFiltersPagesWithContext(ctx, input, func(res *cloudwatchlogs.DescribeSubscriptionFiltersOutput, lastPage bool) bool {
+		subscriptionFilters = append(subscriptionFilters, res.SubscriptionFilters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, subscriptionFilters)
+	return subscriptionFilters, nil
+}
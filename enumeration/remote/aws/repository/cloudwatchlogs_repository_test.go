@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cloudwatchLogsRepository_ListAllLogGroups(t *testing.T) {
+	items := []*cloudwatchlogs.LogGroup{
+		{LogGroupName: aws.String("application")},
+		{LogGroupName: aws.String("/aws/lambda/foo")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache)
+		want    []*cloudwatchlogs.LogGroup
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeLogGroupsPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: items[:1]}, false)
+						callback(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("GetAndLock", "cloudwatchlogsListAllLogGroups").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatchlogsListAllLogGroups").Times(1)
+				store.On("Put", "cloudwatchlogsListAllLogGroups", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				store.On("GetAndLock", "cloudwatchlogsListAllLogGroups").Return(items).Times(1)
+				store.On("Unlock", "cloudwatchlogsListAllLogGroups").Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeLogGroupsPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeLogGroupsInput{},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeLogGroupsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("GetAndLock", "cloudwatchlogsListAllLogGroups").Return(nil).Times(1)
+				store.On("Unlock", "cloudwatchlogsListAllLogGroups").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchLogs{}
+			tt.mocks(client, store)
+			r := &cloudwatchLogsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllLogGroups(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchLogsRepository_ListAllMetricFilters(t *testing.T) {
+	items := []*cloudwatchlogs.MetricFilter{
+		{FilterName: aws.String("errors"), LogGroupName: aws.String("application")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache)
+		want    []*cloudwatchlogs.MetricFilter
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeMetricFiltersPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeMetricFiltersInput{},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeMetricFiltersOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeMetricFiltersOutput{MetricFilters: items}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cloudwatchlogsListAllMetricFilters").Return(nil).Times(1)
+				store.On("Put", "cloudwatchlogsListAllMetricFilters", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				store.On("Get", "cloudwatchlogsListAllMetricFilters").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeMetricFiltersPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeMetricFiltersInput{},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeMetricFiltersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cloudwatchlogsListAllMetricFilters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchLogs{}
+			tt.mocks(client, store)
+			r := &cloudwatchLogsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllMetricFilters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cloudwatchLogsRepository_ListAllSubscriptionFilters(t *testing.T) {
+	items := []*cloudwatchlogs.SubscriptionFilter{
+		{FilterName: aws.String("to-kinesis"), LogGroupName: aws.String("application")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache)
+		want    []*cloudwatchlogs.SubscriptionFilter
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeSubscriptionFiltersPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeSubscriptionFiltersInput{LogGroupName: aws.String("application")},
+					mock.MatchedBy(func(callback func(res *cloudwatchlogs.DescribeSubscriptionFiltersOutput, lastPage bool) bool) bool {
+						callback(&cloudwatchlogs.DescribeSubscriptionFiltersOutput{SubscriptionFilters: items}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cloudwatchlogsListAllSubscriptionFilters_application").Return(nil).Times(1)
+				store.On("Put", "cloudwatchlogsListAllSubscriptionFilters_application", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				store.On("Get", "cloudwatchlogsListAllSubscriptionFilters_application").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCloudwatchLogs, store *cache.MockCache) {
+				client.On("DescribeSubscriptionFiltersPagesWithContext", mock.Anything,
+					&cloudwatchlogs.DescribeSubscriptionFiltersInput{LogGroupName: aws.String("application")},
+					mock.AnythingOfType("func(*cloudwatchlogs.DescribeSubscriptionFiltersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cloudwatchlogsListAllSubscriptionFilters_application").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCloudwatchLogs{}
+			tt.mocks(client, store)
+			r := &cloudwatchLogsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllSubscriptionFilters(context.TODO(), "application")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
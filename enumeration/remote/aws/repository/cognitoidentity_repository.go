@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// Maximum page size accepted by the identity pool listing endpoint
+const cognitoIdentityMaxResults = 60
+
+type CognitoIdentityRepository interface {
+	ListAllIdentityPools(ctx context.Context) ([]*cognitoidentity.IdentityPoolShortDescription, error)
+}
+
+type cognitoIdentityRepository struct {
+	client cognitoidentityiface.CognitoIdentityAPI
+	cache  cache.Cache
+}
+
+func NewCognitoIdentityRepository(session *session.Session, c cache.Cache) *cognitoIdentityRepository {
+	return &cognitoIdentityRepository{
+		cognitoidentity.New(session),
+		c,
+	}
+}
+
+func (r *cognitoIdentityRepository) ListAllIdentityPools(ctx context.Context) ([]*cognitoidentity.IdentityPoolShortDescription, error) {
+	if v := r.cache.Get("cognitoIdentityListAllIdentityPools"); v != nil {
+		return v.([]*cognitoidentity.IdentityPoolShortDescription), nil
+	}
+
+	var identityPools []*cognitoidentity.IdentityPoolShortDescription
+	input := cognitoidentity.ListIdentityPoolsInput{
+		MaxResults: aws.Int64(cognitoIdentityMaxResults),
+	}
+	err := r.client.ListIdentityPoolsPagesWithContext(ctx, &input,
+		func(resp *cognitoidentity.ListIdentityPoolsOutput, lastPage bool) bool {
+			identityPools = append(identityPools, resp.IdentityPools...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("cognitoIdentityListAllIdentityPools", identityPools)
+	return identityPools, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cognitoIdentityRepository_ListAllIdentityPools(t *testing.T) {
+	items := []*cognitoidentity.IdentityPoolShortDescription{
+		{IdentityPoolId: aws.String("eu-west-3:11111111-2222-3333-4444-555555555555"), IdentityPoolName: aws.String("first")},
+		{IdentityPoolId: aws.String("eu-west-3:66666666-7777-8888-9999-000000000000"), IdentityPoolName: aws.String("second")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCognitoIdentity, store *cache.MockCache)
+		want    []*cognitoidentity.IdentityPoolShortDescription
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCognitoIdentity, store *cache.MockCache) {
+				client.On("ListIdentityPoolsPagesWithContext", mock.Anything,
+					&cognitoidentity.ListIdentityPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.MatchedBy(func(callback func(res *cognitoidentity.ListIdentityPoolsOutput, lastPage bool) bool) bool {
+						callback(&cognitoidentity.ListIdentityPoolsOutput{IdentityPools: items[:1]}, false)
+						callback(&cognitoidentity.ListIdentityPoolsOutput{IdentityPools: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cognitoIdentityListAllIdentityPools").Return(nil).Times(1)
+				store.On("Put", "cognitoIdentityListAllIdentityPools", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCognitoIdentity, store *cache.MockCache) {
+				store.On("Get", "cognitoIdentityListAllIdentityPools").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCognitoIdentity, store *cache.MockCache) {
+				client.On("ListIdentityPoolsPagesWithContext", mock.Anything,
+					&cognitoidentity.ListIdentityPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.AnythingOfType("func(*cognitoidentity.ListIdentityPoolsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cognitoIdentityListAllIdentityPools").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCognitoIdentity{}
+			tt.mocks(client, store)
+			r := &cognitoIdentityRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllIdentityPools(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
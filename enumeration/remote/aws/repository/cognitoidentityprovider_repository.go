@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// Maximum page size accepted by the user pool listing endpoints
+const cognitoIdentityProviderMaxResults = 60
+
+type CognitoIdentityProviderRepository interface {
+	ListAllUserPools(ctx context.Context) ([]*cognitoidentityprovider.UserPoolDescriptionType, error)
+	ListAllUserPoolClients(ctx context.Context, userPoolId string) ([]*cognitoidentityprovider.UserPoolClientDescription, error)
+}
+
+type cognitoIdentityProviderRepository struct {
+	client cognitoidentityprovideriface.CognitoIdentityProviderAPI
+	cache  cache.Cache
+}
+
+func NewCognitoIdentityProviderRepository(session *session.Session, c cache.Cache) *cognitoIdentityProviderRepository {
+	return &cognitoIdentityProviderRepository{
+		cognitoidentityprovider.New(session),
+		c,
+	}
+}
+
+func (r *cognitoIdentityProviderRepository) ListAllUserPools(ctx context.Context) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
+	cacheKey := "cognitoIdentityProviderListAllUserPools"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*cognitoidentityprovider.UserPoolDescriptionType), nil
+	}
+
+	var userPools []*cognitoidentityprovider.UserPoolDescriptionType
+	input := cognitoidentityprovider.ListUserPoolsInput{
+		MaxResults: aws.Int64(cognitoIdentityProviderMaxResults),
+	}
+	err := r.client.ListUserPoolsPagesWithContext(ctx, &input,
+		func(resp *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+			userPools = append(userPools, resp.UserPools...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, userPools)
+	return userPools, nil
+}
+
+func (r *cognitoIdentityProviderRepository) ListAllUserPoolClients(ctx context.Context, userPoolId string) ([]*cognitoidentityprovider.UserPoolClientDescription, error) {
+	cacheKey := fmt.Sprintf("cognitoIdentityProviderListAllUserPoolClients_%s", userPoolId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*cognitoidentityprovider.UserPoolClientDescription), nil
+	}
+
+	var clients []*cognitoidentityprovider.UserPoolClientDescription
+	input := cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: aws.String(userPoolId),
+		MaxResults: aws.Int64(cognitoIdentityProviderMaxResults),
+	}
+	err := r.client.ListUserPoolClientsPagesWithContext(ctx, &input,
+		func(resp *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool {
+			clients = append(clients, resp.UserPoolClients...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, clients)
+	return clients, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_cognitoIdentityProviderRepository_ListAllUserPools(t *testing.T) {
+	items := []*cognitoidentityprovider.UserPoolDescriptionType{
+		{Id: aws.String("eu-west-3_AbCdEf123"), Name: aws.String("test-app-pool")},
+		{Id: aws.String("eu-west-3_GhIjKl456"), Name: aws.String("shadow-pool")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache)
+		want    []*cognitoidentityprovider.UserPoolDescriptionType
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				client.On("ListUserPoolsPagesWithContext", mock.Anything,
+					&cognitoidentityprovider.ListUserPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.MatchedBy(func(callback func(res *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool) bool {
+						callback(&cognitoidentityprovider.ListUserPoolsOutput{UserPools: items[:1]}, false)
+						callback(&cognitoidentityprovider.ListUserPoolsOutput{UserPools: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("GetAndLock", "cognitoIdentityProviderListAllUserPools").Return(nil).Times(1)
+				store.On("Unlock", "cognitoIdentityProviderListAllUserPools").Times(1)
+				store.On("Put", "cognitoIdentityProviderListAllUserPools", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				store.On("GetAndLock", "cognitoIdentityProviderListAllUserPools").Return(items).Times(1)
+				store.On("Unlock", "cognitoIdentityProviderListAllUserPools").Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				client.On("ListUserPoolsPagesWithContext", mock.Anything,
+					&cognitoidentityprovider.ListUserPoolsInput{
+						MaxResults: aws.Int64(60),
+					},
+					mock.AnythingOfType("func(*cognitoidentityprovider.ListUserPoolsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("GetAndLock", "cognitoIdentityProviderListAllUserPools").Return(nil).Times(1)
+				store.On("Unlock", "cognitoIdentityProviderListAllUserPools").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCognitoIdentityProvider{}
+			tt.mocks(client, store)
+			r := &cognitoIdentityProviderRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllUserPools(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_cognitoIdentityProviderRepository_ListAllUserPoolClients(t *testing.T) {
+	items := []*cognitoidentityprovider.UserPoolClientDescription{
+		{ClientId: aws.String("1example23456789"), ClientName: aws.String("web"), UserPoolId: aws.String("eu-west-3_AbCdEf123")},
+		{ClientId: aws.String("2example98765432"), ClientName: aws.String("mobile"), UserPoolId: aws.String("eu-west-3_AbCdEf123")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache)
+		want    []*cognitoidentityprovider.UserPoolClientDescription
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				client.On("ListUserPoolClientsPagesWithContext", mock.Anything,
+					&cognitoidentityprovider.ListUserPoolClientsInput{
+						UserPoolId: aws.String("eu-west-3_AbCdEf123"),
+						MaxResults: aws.Int64(60),
+					},
+					mock.MatchedBy(func(callback func(res *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool) bool {
+						callback(&cognitoidentityprovider.ListUserPoolClientsOutput{UserPoolClients: items[:1]}, false)
+						callback(&cognitoidentityprovider.ListUserPoolClientsOutput{UserPoolClients: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "cognitoIdentityProviderListAllUserPoolClients_eu-west-3_AbCdEf123").Return(nil).Times(1)
+				store.On("Put", "cognitoIdentityProviderListAllUserPoolClients_eu-west-3_AbCdEf123", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				store.On("Get", "cognitoIdentityProviderListAllUserPoolClients_eu-west-3_AbCdEf123").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeCognitoIdentityProvider, store *cache.MockCache) {
+				client.On("ListUserPoolClientsPagesWithContext", mock.Anything,
+					&cognitoidentityprovider.ListUserPoolClientsInput{
+						UserPoolId: aws.String("eu-west-3_AbCdEf123"),
+						MaxResults: aws.Int64(60),
+					},
+					mock.AnythingOfType("func(*cognitoidentityprovider.ListUserPoolClientsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "cognitoIdentityProviderListAllUserPoolClients_eu-west-3_AbCdEf123").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeCognitoIdentityProvider{}
+			tt.mocks(client, store)
+			r := &cognitoIdentityProviderRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllUserPoolClients(context.TODO(), "eu-west-3_AbCdEf123")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
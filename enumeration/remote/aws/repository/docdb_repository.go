@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type DocDBRepository interface {
+	ListAllDBClusters(ctx context.Context) ([]*docdb.DBCluster, error)
+	ListAllDBClusterParameterGroups(ctx context.Context) ([]*docdb.DBClusterParameterGroup, error)
+}
+
+type docDBRepository struct {
+	client docdbiface.DocDBAPI
+	cache  cache.Cache
+}
+
+func NewDocDBRepository(session *session.Session, c cache.Cache) *docDBRepository {
+	return &docDBRepository{
+		docdb.New(session),
+		c,
+	}
+}
+
+func (r *docDBRepository) ListAllDBClusters(ctx context.Context) ([]*docdb.DBCluster, error) {
+	if v := r.cache.Get("docdbListAllDBClusters"); v != nil {
+		return v.([]*docdb.DBCluster), nil
+	}
+
+	var clusters []*docdb.DBCluster
+	// DocumentDB shares its API with RDS, so we need to filter out other engines
+	input := docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String("docdb")},
+			},
+		},
+	}
+	err := r.client.DescribeDBClustersPagesWithContext(ctx, &input,
+		func(resp *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+			clusters = append(clusters, resp.DBClusters...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("docdbListAllDBClusters", clusters)
+	return clusters, nil
+}
+
+func (r *docDBRepository) ListAllDBClusterParameterGroups(ctx context.Context) ([]*docdb.DBClusterParameterGroup, error) {
+	if v := r.cache.Get("docdbListAllDBClusterParameterGroups"); v != nil {
+		return v.([]*docdb.DBClusterParameterGroup), nil
+	}
+
+	var parameterGroups []*docdb.DBClusterParameterGroup
+	input := docdb.DescribeDBClusterParameterGroupsInput{}
+	err := r.client.DescribeDBClusterParameterGroupsPagesWithContext(ctx, &input,
+		func(resp *docdb.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool {
+			parameterGroups = append(parameterGroups, resp.DBClusterParameterGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("docdbListAllDBClusterParameterGroups", parameterGroups)
+	return parameterGroups, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_docDBRepository_ListAllDBClusters(t *testing.T) {
+	items := []*docdb.DBCluster{
+		{DBClusterIdentifier: aws.String("docdb-cluster-1")},
+		{DBClusterIdentifier: aws.String("docdb-cluster-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeDocDB, store *cache.MockCache)
+		want    []*docdb.DBCluster
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				client.On("DescribeDBClustersPagesWithContext", mock.Anything,
+					&docdb.DescribeDBClustersInput{
+						Filters: []*docdb.Filter{
+							{
+								Name:   aws.String("engine"),
+								Values: []*string{aws.String("docdb")},
+							},
+						},
+					},
+					mock.MatchedBy(func(callback func(res *docdb.DescribeDBClustersOutput, lastPage bool) bool) bool {
+						callback(&docdb.DescribeDBClustersOutput{DBClusters: items[:1]}, false)
+						callback(&docdb.DescribeDBClustersOutput{DBClusters: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "docdbListAllDBClusters").Return(nil).Times(1)
+				store.On("Put", "docdbListAllDBClusters", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				store.On("Get", "docdbListAllDBClusters").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				client.On("DescribeDBClustersPagesWithContext", mock.Anything,
+					&docdb.DescribeDBClustersInput{
+						Filters: []*docdb.Filter{
+							{
+								Name:   aws.String("engine"),
+								Values: []*string{aws.String("docdb")},
+							},
+						},
+					},
+					mock.AnythingOfType("func(*docdb.DescribeDBClustersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "docdbListAllDBClusters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeDocDB{}
+			tt.mocks(client, store)
+			r := &docDBRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDBClusters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_docDBRepository_ListAllDBClusterParameterGroups(t *testing.T) {
+	items := []*docdb.DBClusterParameterGroup{
+		{DBClusterParameterGroupName: aws.String("parameter-group-1")},
+		{DBClusterParameterGroupName: aws.String("parameter-group-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeDocDB, store *cache.MockCache)
+		want    []*docdb.DBClusterParameterGroup
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				client.On("DescribeDBClusterParameterGroupsPagesWithContext", mock.Anything,
+					&docdb.DescribeDBClusterParameterGroupsInput{},
+					mock.MatchedBy(func(callback func(res *docdb.DescribeDBClusterParameterGroupsOutput, lastPage bool) bool) bool {
+						callback(&docdb.DescribeDBClusterParameterGroupsOutput{DBClusterParameterGroups: items[:1]}, false)
+						callback(&docdb.DescribeDBClusterParameterGroupsOutput{DBClusterParameterGroups: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "docdbListAllDBClusterParameterGroups").Return(nil).Times(1)
+				store.On("Put", "docdbListAllDBClusterParameterGroups", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				store.On("Get", "docdbListAllDBClusterParameterGroups").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeDocDB, store *cache.MockCache) {
+				client.On("DescribeDBClusterParameterGroupsPagesWithContext", mock.Anything,
+					&docdb.DescribeDBClusterParameterGroupsInput{},
+					mock.AnythingOfType("func(*docdb.DescribeDBClusterParameterGroupsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "docdbListAllDBClusterParameterGroups").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeDocDB{}
+			tt.mocks(client, store)
+			r := &docDBRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDBClusterParameterGroups(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
@@ -8,7 +10,7 @@ import (
 )
 
 type DynamoDBRepository interface {
-	ListAllTables() ([]*string, error)
+	ListAllTables(ctx context.Context) ([]*string, error)
 }
 
 type dynamoDBRepository struct {
@@ -23,14 +25,14 @@ func NewDynamoDBRepository(session *session.Session, c cache.Cache) *dynamoDBRep
 	}
 }
 
-func (r *dynamoDBRepository) ListAllTables() ([]*string, error) {
+func (r *dynamoDBRepository) ListAllTables(ctx context.Context) ([]*string, error) {
 	if v := r.cache.Get("dynamodbListAllTables"); v != nil {
 		return v.([]*string), nil
 	}
 
 	var tables []*string
 	input := &dynamodb.ListTablesInput{}
-	err := r.client.ListTablesPages(input, func(res *dynamodb.ListTablesOutput, lastPage bool) bool {
+	err := r.client.ListTablesPagesWithContext(ctx, input, func(res *dynamodb.ListTablesOutput, lastPage bool) bool {
 		tables = append(tables, res.TableNames...)
 		return !lastPage
 	})
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -26,7 +28,7 @@ func Test_dynamoDBRepository_ListAllTopics(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeDynamoDB) {
-				client.On("ListTablesPages",
+				client.On("ListTablesPagesWithContext", mock.Anything,
 					&dynamodb.ListTablesInput{},
 					mock.MatchedBy(func(callback func(res *dynamodb.ListTablesOutput, lastPage bool) bool) bool {
 						callback(&dynamodb.ListTablesOutput{
@@ -65,12 +67,12 @@ func Test_dynamoDBRepository_ListAllTopics(t *testing.T) {
 				client: &client,
 				cache:  store,
 			}
-			got, err := r.ListAllTables()
+			got, err := r.ListAllTables(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllTables()
+				cachedData, err := r.ListAllTables(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*string{}, store.Get("dynamodbListAllTables"))
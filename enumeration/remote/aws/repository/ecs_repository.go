@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// DescribeClusters and DescribeServices accept a limited number of ARNs per call
+const (
+	ecsDescribeClustersBatchSize = 100
+	ecsDescribeServicesBatchSize = 10
+)
+
+type ECSRepository interface {
+	ListAllClusters(ctx context.Context) ([]*ecs.Cluster, error)
+	ListAllServices(ctx context.Context, clusterArn string) ([]*ecs.Service, error)
+	ListAllTaskDefinitions(ctx context.Context) ([]*string, error)
+	ListAllCapacityProviders(ctx context.Context) ([]*ecs.CapacityProvider, error)
+}
+
+type ecsRepository struct {
+	client ecsiface.ECSAPI
+	cache  cache.Cache
+}
+
+func NewECSRepository(session *session.Session, c cache.Cache) *ecsRepository {
+	return &ecsRepository{
+		ecs.New(session),
+		c,
+	}
+}
+
+func (r *ecsRepository) ListAllClusters(ctx context.Context) ([]*ecs.Cluster, error) {
+	if v := r.cache.Get("ecsListAllClusters"); v != nil {
+		return v.([]*ecs.Cluster), nil
+	}
+
+	var arns []*string
+	err := r.client.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{}, func(res *ecs.ListClustersOutput, lastPage bool) bool {
+		arns = append(arns, res.ClusterArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*ecs.Cluster, 0, len(arns))
+	for start := 0; start < len(arns); start += ecsDescribeClustersBatchSize {
+		end := start + ecsDescribeClustersBatchSize
+		if end > len(arns) {
+			end = len(arns)
+		}
+		output, err := r.client.DescribeClustersWithContext(ctx, &ecs.DescribeClustersInput{Clusters: arns[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, output.Clusters...)
+	}
+
+	r.cache.Put("ecsListAllClusters", clusters)
+	return clusters, nil
+}
+
+func (r *ecsRepository) ListAllServices(ctx context.Context, clusterArn string) ([]*ecs.Service, error) {
+	cacheKey := fmt.Sprintf("ecsListAllServices_%s", clusterArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*ecs.Service), nil
+	}
+
+	var arns []*string
+	input := &ecs.ListServicesInput{Cluster: aws.String(clusterArn)}
+	err := r.client.ListServicesPagesWithContext(ctx, input, func(res *ecs.ListServicesOutput, lastPage bool) bool {
+		arns = append(arns, res.ServiceArns...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*ecs.Service, 0, len(arns))
+	for start := 0; start < len(arns); start += ecsDescribeServicesBatchSize {
+		end := start + ecsDescribeServicesBatchSize
+		if end > len(arns) {
+			end = len(arns)
+		}
+		output, err := r.client.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterArn),
+			Services: arns[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, output.Services...)
+	}
+
+	r.cache.Put(cacheKey, services)
+	return services, nil
+}
+
+// ListAllTaskDefinitions returns the ARN of the latest active revision of each task definition family
+func (r *ecsRepository) ListAllTaskDefinitions(ctx context.Context) ([]*string, error) {
+	if v := r.cache.Get("ecsListAllTaskDefinitions"); v != nil {
+		return v.([]*string), nil
+	}
+
+	latest := map[string]*string{}
+	revisions := map[string]int{}
+	input := &ecs.ListTaskDefinitionsInput{Status: aws.String(ecs.TaskDefinitionStatusActive)}
+	err := r.client.ListTaskDefinitionsPagesWithContext(ctx, input, func(res *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		for _, arn := range res.TaskDefinitionArns {
+			family, revision := parseTaskDefinitionArn(*arn)
+			if current, exist := revisions[family]; !exist || revision > current {
+				revisions[family] = revision
+				latest[family] = arn
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	families := make([]string, 0, len(latest))
+	for family := range latest {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	arns := make([]*string, 0, len(families))
+	for _, family := range families {
+		arns = append(arns, latest[family])
+	}
+
+	r.cache.Put("ecsListAllTaskDefinitions", arns)
+	return arns, nil
+}
+
+func (r *ecsRepository) ListAllCapacityProviders(ctx context.Context) ([]*ecs.CapacityProvider, error) {
+	if v := r.cache.Get("ecsListAllCapacityProviders"); v != nil {
+		return v.([]*ecs.CapacityProvider), nil
+	}
+
+	var providers []*ecs.CapacityProvider
+	input := &ecs.DescribeCapacityProvidersInput{}
+	for {
+		output, err := r.client.DescribeCapacityProvidersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, output.CapacityProviders...)
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	r.cache.Put("ecsListAllCapacityProviders", providers)
+	return providers, nil
+}
+
+// parseTaskDefinitionArn extracts the family and revision from arn:aws:ecs:<region>:<account>:task-definition/<family>:<revision>
+func parseTaskDefinitionArn(arn string) (string, int) {
+	familyAndRevision := arn[strings.LastIndex(arn, "/")+1:]
+	separator := strings.LastIndex(familyAndRevision, ":")
+	if separator == -1 {
+		return familyAndRevision, 0
+	}
+	revision, _ := strconv.Atoi(familyAndRevision[separator+1:])
+	return familyAndRevision[:separator], revision
+}
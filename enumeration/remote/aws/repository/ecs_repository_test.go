@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ecsRepository_ListAllClusters(t *testing.T) {
+	arns := make([]*string, 0, 150)
+	clusters := make([]*ecs.Cluster, 0, 150)
+	for i := 0; i < 150; i++ {
+		arn := fmt.Sprintf("arn:aws:ecs:us-east-1:123456789012:cluster/cluster-%d", i)
+		arns = append(arns, aws.String(arn))
+		clusters = append(clusters, &ecs.Cluster{ClusterArn: aws.String(arn), ClusterName: aws.String(fmt.Sprintf("cluster-%d", i))})
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS, store *cache.MockCache)
+		want    []*ecs.Cluster
+		wantErr error
+	}{
+		{
+			name: "list and describe clusters by batch",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&ecs.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *ecs.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListClustersOutput{ClusterArns: arns[:120]}, false)
+						callback(&ecs.ListClustersOutput{ClusterArns: arns[120:]}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeClustersWithContext", mock.Anything, &ecs.DescribeClustersInput{Clusters: arns[:100]}).
+					Return(&ecs.DescribeClustersOutput{Clusters: clusters[:100]}, nil).Once()
+				client.On("DescribeClustersWithContext", mock.Anything, &ecs.DescribeClustersInput{Clusters: arns[100:]}).
+					Return(&ecs.DescribeClustersOutput{Clusters: clusters[100:]}, nil).Once()
+				store.On("Get", "ecsListAllClusters").Return(nil).Times(1)
+				store.On("Put", "ecsListAllClusters", clusters).Return(false).Times(1)
+			},
+			want: clusters,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				store.On("Get", "ecsListAllClusters").Return(clusters).Times(1)
+			},
+			want: clusters,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&ecs.ListClustersInput{},
+					mock.AnythingOfType("func(*ecs.ListClustersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "ecsListAllClusters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeECS{}
+			tt.mocks(client, store)
+			r := &ecsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllServices(t *testing.T) {
+	clusterArn := "arn:aws:ecs:us-east-1:123456789012:cluster/production"
+	arns := make([]*string, 0, 12)
+	services := make([]*ecs.Service, 0, 12)
+	for i := 0; i < 12; i++ {
+		arn := fmt.Sprintf("arn:aws:ecs:us-east-1:123456789012:service/production/service-%d", i)
+		arns = append(arns, aws.String(arn))
+		services = append(services, &ecs.Service{ServiceArn: aws.String(arn), ClusterArn: aws.String(clusterArn)})
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS, store *cache.MockCache)
+		want    []*ecs.Service
+		wantErr error
+	}{
+		{
+			name: "list and describe services by batch",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListServicesPagesWithContext", mock.Anything,
+					&ecs.ListServicesInput{Cluster: aws.String(clusterArn)},
+					mock.MatchedBy(func(callback func(res *ecs.ListServicesOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListServicesOutput{ServiceArns: arns}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeServicesWithContext", mock.Anything, &ecs.DescribeServicesInput{Cluster: aws.String(clusterArn), Services: arns[:10]}).
+					Return(&ecs.DescribeServicesOutput{Services: services[:10]}, nil).Once()
+				client.On("DescribeServicesWithContext", mock.Anything, &ecs.DescribeServicesInput{Cluster: aws.String(clusterArn), Services: arns[10:]}).
+					Return(&ecs.DescribeServicesOutput{Services: services[10:]}, nil).Once()
+				store.On("Get", "ecsListAllServices_"+clusterArn).Return(nil).Times(1)
+				store.On("Put", "ecsListAllServices_"+clusterArn, services).Return(false).Times(1)
+			},
+			want: services,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				store.On("Get", "ecsListAllServices_"+clusterArn).Return(services).Times(1)
+			},
+			want: services,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListServicesPagesWithContext", mock.Anything,
+					&ecs.ListServicesInput{Cluster: aws.String(clusterArn)},
+					mock.AnythingOfType("func(*ecs.ListServicesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "ecsListAllServices_"+clusterArn).Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeECS{}
+			tt.mocks(client, store)
+			r := &ecsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllServices(context.TODO(), clusterArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllTaskDefinitions(t *testing.T) {
+	latest := []*string{
+		aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:12"),
+		aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/worker:3"),
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "keep the latest active revision of each family",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListTaskDefinitionsPagesWithContext", mock.Anything,
+					&ecs.ListTaskDefinitionsInput{Status: aws.String(ecs.TaskDefinitionStatusActive)},
+					mock.MatchedBy(func(callback func(res *ecs.ListTaskDefinitionsOutput, lastPage bool) bool) bool {
+						callback(&ecs.ListTaskDefinitionsOutput{TaskDefinitionArns: []*string{
+							aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/worker:1"),
+							aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:9"),
+							aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:12"),
+						}}, false)
+						callback(&ecs.ListTaskDefinitionsOutput{TaskDefinitionArns: []*string{
+							aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/worker:3"),
+							aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:10"),
+						}}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "ecsListAllTaskDefinitions").Return(nil).Times(1)
+				store.On("Put", "ecsListAllTaskDefinitions", latest).Return(false).Times(1)
+			},
+			want: latest,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				store.On("Get", "ecsListAllTaskDefinitions").Return(latest).Times(1)
+			},
+			want: latest,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("ListTaskDefinitionsPagesWithContext", mock.Anything,
+					&ecs.ListTaskDefinitionsInput{Status: aws.String(ecs.TaskDefinitionStatusActive)},
+					mock.AnythingOfType("func(*ecs.ListTaskDefinitionsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "ecsListAllTaskDefinitions").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeECS{}
+			tt.mocks(client, store)
+			r := &ecsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTaskDefinitions(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ecsRepository_ListAllCapacityProviders(t *testing.T) {
+	providers := []*ecs.CapacityProvider{
+		{Name: aws.String("FARGATE")},
+		{Name: aws.String("FARGATE_SPOT")},
+		{Name: aws.String("spot"), CapacityProviderArn: aws.String("arn:aws:ecs:us-east-1:123456789012:capacity-provider/spot")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeECS, store *cache.MockCache)
+		want    []*ecs.CapacityProvider
+		wantErr error
+	}{
+		{
+			name: "list capacity providers with pagination",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("DescribeCapacityProvidersWithContext", mock.Anything, &ecs.DescribeCapacityProvidersInput{}).
+					Return(&ecs.DescribeCapacityProvidersOutput{CapacityProviders: providers[:2], NextToken: aws.String("next")}, nil).Once()
+				client.On("DescribeCapacityProvidersWithContext", mock.Anything, &ecs.DescribeCapacityProvidersInput{NextToken: aws.String("next")}).
+					Return(&ecs.DescribeCapacityProvidersOutput{CapacityProviders: providers[2:]}, nil).Once()
+				store.On("Get", "ecsListAllCapacityProviders").Return(nil).Times(1)
+				store.On("Put", "ecsListAllCapacityProviders", providers).Return(false).Times(1)
+			},
+			want: providers,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				store.On("Get", "ecsListAllCapacityProviders").Return(providers).Times(1)
+			},
+			want: providers,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeECS, store *cache.MockCache) {
+				client.On("DescribeCapacityProvidersWithContext", mock.Anything, &ecs.DescribeCapacityProvidersInput{}).Return(nil, remoteError).Once()
+				store.On("Get", "ecsListAllCapacityProviders").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeECS{}
+			tt.mocks(client, store)
+			r := &ecsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllCapacityProviders(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func assertNoDiff(t *testing.T, got, want interface{}) {
+	changelog, err := diff.Diff(got, want)
+	assert.Nil(t, err)
+	if len(changelog) > 0 {
+		for _, change := range changelog {
+			t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+		}
+		t.Fail()
+	}
+}
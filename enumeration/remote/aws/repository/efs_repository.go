@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type EFSRepository interface {
+	ListAllFileSystems(ctx context.Context) ([]*efs.FileSystemDescription, error)
+	ListAllMountTargets(ctx context.Context, fileSystemId string) ([]*efs.MountTargetDescription, error)
+}
+
+type efsRepository struct {
+	client efsiface.EFSAPI
+	cache  cache.Cache
+}
+
+func NewEFSRepository(session *session.Session, c cache.Cache) *efsRepository {
+	return &efsRepository{
+		efs.New(session),
+		c,
+	}
+}
+
+func (r *efsRepository) ListAllFileSystems(ctx context.Context) ([]*efs.FileSystemDescription, error) {
+	cacheKey := "efsListAllFileSystems"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*efs.FileSystemDescription), nil
+	}
+
+	var fileSystems []*efs.FileSystemDescription
+	input := efs.DescribeFileSystemsInput{}
+	err := r.client.DescribeFileSystemsPagesWithContext(ctx, &input,
+		func(resp *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+			fileSystems = append(fileSystems, resp.FileSystems...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, fileSystems)
+	return fileSystems, nil
+}
+
+func (r *efsRepository) ListAllMountTargets(ctx context.Context, fileSystemId string) ([]*efs.MountTargetDescription, error) {
+	cacheKey := fmt.Sprintf("efsListAllMountTargets_%s", fileSystemId)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*efs.MountTargetDescription), nil
+	}
+
+	var mountTargets []*efs.MountTargetDescription
+	input := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fileSystemId),
+	}
+	for {
+		resp, err := r.client.DescribeMountTargetsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		mountTargets = append(mountTargets, resp.MountTargets...)
+		if resp.NextMarker == nil {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	r.cache.Put(cacheKey, mountTargets)
+	return mountTargets, nil
+}
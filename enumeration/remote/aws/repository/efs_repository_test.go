@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_efsRepository_ListAllFileSystems(t *testing.T) {
+	items := []*efs.FileSystemDescription{
+		{FileSystemId: aws.String("fs-0123456789")},
+		{FileSystemId: aws.String("fs-9876543210")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEFS, store *cache.MockCache)
+		want    []*efs.FileSystemDescription
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				client.On("DescribeFileSystemsPagesWithContext", mock.Anything,
+					&efs.DescribeFileSystemsInput{},
+					mock.MatchedBy(func(callback func(res *efs.DescribeFileSystemsOutput, lastPage bool) bool) bool {
+						callback(&efs.DescribeFileSystemsOutput{FileSystems: items[:1]}, false)
+						callback(&efs.DescribeFileSystemsOutput{FileSystems: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("GetAndLock", "efsListAllFileSystems").Return(nil).Times(1)
+				store.On("Unlock", "efsListAllFileSystems").Times(1)
+				store.On("Put", "efsListAllFileSystems", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				store.On("GetAndLock", "efsListAllFileSystems").Return(items).Times(1)
+				store.On("Unlock", "efsListAllFileSystems").Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				client.On("DescribeFileSystemsPagesWithContext", mock.Anything,
+					&efs.DescribeFileSystemsInput{},
+					mock.AnythingOfType("func(*efs.DescribeFileSystemsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("GetAndLock", "efsListAllFileSystems").Return(nil).Times(1)
+				store.On("Unlock", "efsListAllFileSystems").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeEFS{}
+			tt.mocks(client, store)
+			r := &efsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllFileSystems(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_efsRepository_ListAllMountTargets(t *testing.T) {
+	items := []*efs.MountTargetDescription{
+		{MountTargetId: aws.String("fsmt-0123456789")},
+		{MountTargetId: aws.String("fsmt-9876543210")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEFS, store *cache.MockCache)
+		want    []*efs.MountTargetDescription
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				client.On("DescribeMountTargetsWithContext", mock.Anything, &efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-0123456789"),
+				}).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: items[:1],
+					NextMarker:   aws.String("next"),
+				}, nil).Once()
+				client.On("DescribeMountTargetsWithContext", mock.Anything, &efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-0123456789"),
+					Marker:       aws.String("next"),
+				}).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: items[1:],
+				}, nil).Once()
+				store.On("Get", "efsListAllMountTargets_fs-0123456789").Return(nil).Times(1)
+				store.On("Put", "efsListAllMountTargets_fs-0123456789", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				store.On("Get", "efsListAllMountTargets_fs-0123456789").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEFS, store *cache.MockCache) {
+				client.On("DescribeMountTargetsWithContext", mock.Anything, mock.Anything).Return(nil, remoteError).Once()
+				store.On("Get", "efsListAllMountTargets_fs-0123456789").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeEFS{}
+			tt.mocks(client, store)
+			r := &efsRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllMountTargets(context.TODO(), "fs-0123456789")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type EKSRepository interface {
+	ListAllClusters(ctx context.Context) ([]*string, error)
+	ListAllNodeGroups(ctx context.Context, clusterName string) ([]*string, error)
+	ListAllAddons(ctx context.Context, clusterName string) ([]*string, error)
+	ListAllFargateProfiles(ctx context.Context, clusterName string) ([]*string, error)
+	ListAllIdentityProviderConfigs(ctx context.Context, clusterName string) ([]*eks.IdentityProviderConfig, error)
+}
+
+type eksRepository struct {
+	client eksiface.EKSAPI
+	cache  cache.Cache
+}
+
+func NewEKSRepository(session *session.Session, c cache.Cache) *eksRepository {
+	return &eksRepository{
+		eks.New(session),
+		c,
+	}
+}
+
+func (r *eksRepository) ListAllClusters(ctx context.Context) ([]*string, error) {
+	if v := r.cache.Get("eksListAllClusters"); v != nil {
+		return v.([]*string), nil
+	}
+
+	var clusters []*string
+	err := r.client.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{}, func(res *eks.ListClustersOutput, lastPage bool) bool {
+		clusters = append(clusters, res.Clusters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("eksListAllClusters", clusters)
+	return clusters, nil
+}
+
+func (r *eksRepository) ListAllNodeGroups(ctx context.Context, clusterName string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("eksListAllNodeGroups_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var nodeGroups []*string
+	input := &eks.ListNodegroupsInput{ClusterName: aws.String(clusterName)}
+	err := r.client.ListNodegroupsPagesWithContext(ctx, input, func(res *eks.ListNodegroupsOutput, lastPage bool) bool {
+		nodeGroups = append(nodeGroups, res.Nodegroups...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, nodeGroups)
+	return nodeGroups, nil
+}
+
+func (r *eksRepository) ListAllAddons(ctx context.Context, clusterName string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("eksListAllAddons_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var addons []*string
+	input := &eks.ListAddonsInput{ClusterName: aws.String(clusterName)}
+	err := r.client.ListAddonsPagesWithContext(ctx, input, func(res *eks.ListAddonsOutput, lastPage bool) bool {
+		addons = append(addons, res.Addons...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, addons)
+	return addons, nil
+}
+
+func (r *eksRepository) ListAllFargateProfiles(ctx context.Context, clusterName string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("eksListAllFargateProfiles_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var profiles []*string
+	input := &eks.ListFargateProfilesInput{ClusterName: aws.String(clusterName)}
+	err := r.client.ListFargateProfilesPagesWithContext(ctx, input, func(res *eks.ListFargateProfilesOutput, lastPage bool) bool {
+		profiles = append(profiles, res.FargateProfileNames...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, profiles)
+	return profiles, nil
+}
+
+func (r *eksRepository) ListAllIdentityProviderConfigs(ctx context.Context, clusterName string) ([]*eks.IdentityProviderConfig, error) {
+	cacheKey := fmt.Sprintf("eksListAllIdentityProviderConfigs_%s", clusterName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*eks.IdentityProviderConfig), nil
+	}
+
+	var configs []*eks.IdentityProviderConfig
+	input := &eks.ListIdentityProviderConfigsInput{ClusterName: aws.String(clusterName)}
+	err := r.client.ListIdentityProviderConfigsPagesWithContext(ctx, input, func(res *eks.ListIdentityProviderConfigsOutput, lastPage bool) bool {
+		configs = append(configs, res.IdentityProviderConfigs...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, configs)
+	return configs, nil
+}
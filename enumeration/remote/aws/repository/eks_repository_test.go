@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_eksRepository_ListAllClusters(t *testing.T) {
+	clusters := []*string{aws.String("production"), aws.String("staging"), aws.String("sandbox")}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "list clusters",
+			mocks: func(client *awstest.MockFakeEKS, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&eks.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *eks.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&eks.ListClustersOutput{Clusters: clusters[:2]}, false)
+						callback(&eks.ListClustersOutput{Clusters: clusters[2:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "eksListAllClusters").Return(nil).Times(1)
+				store.On("Put", "eksListAllClusters", clusters).Return(false).Times(1)
+			},
+			want: clusters,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeEKS, store *cache.MockCache) {
+				store.On("Get", "eksListAllClusters").Return(clusters).Times(1)
+			},
+			want: clusters,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEKS, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&eks.ListClustersInput{},
+					mock.AnythingOfType("func(*eks.ListClustersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "eksListAllClusters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeEKS{}
+			tt.mocks(client, store)
+			r := &eksRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+// Test_eksRepository_ListAllClusterChildren covers the repository methods listing the names of the children of a cluster
+func Test_eksRepository_ListAllClusterChildren(t *testing.T) {
+	names := []*string{aws.String("first"), aws.String("second")}
+
+	tests := []struct {
+		name     string
+		cacheKey string
+		mocks    func(client *awstest.MockFakeEKS)
+		list     func(r *eksRepository) ([]*string, error)
+	}{
+		{
+			name:     "node groups",
+			cacheKey: "eksListAllNodeGroups_production",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListNodegroupsPagesWithContext", mock.Anything,
+					&eks.ListNodegroupsInput{ClusterName: aws.String("production")},
+					mock.MatchedBy(func(callback func(res *eks.ListNodegroupsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListNodegroupsOutput{Nodegroups: names[:1]}, false)
+						callback(&eks.ListNodegroupsOutput{Nodegroups: names[1:]}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			list: func(r *eksRepository) ([]*string, error) {
+				return r.ListAllNodeGroups(context.TODO(), "production")
+			},
+		},
+		{
+			name:     "addons",
+			cacheKey: "eksListAllAddons_production",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListAddonsPagesWithContext", mock.Anything,
+					&eks.ListAddonsInput{ClusterName: aws.String("production")},
+					mock.MatchedBy(func(callback func(res *eks.ListAddonsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListAddonsOutput{Addons: names}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			list: func(r *eksRepository) ([]*string, error) {
+				return r.ListAllAddons(context.TODO(), "production")
+			},
+		},
+		{
+			name:     "fargate profiles",
+			cacheKey: "eksListAllFargateProfiles_production",
+			mocks: func(client *awstest.MockFakeEKS) {
+				client.On("ListFargateProfilesPagesWithContext", mock.Anything,
+					&eks.ListFargateProfilesInput{ClusterName: aws.String("production")},
+					mock.MatchedBy(func(callback func(res *eks.ListFargateProfilesOutput, lastPage bool) bool) bool {
+						callback(&eks.ListFargateProfilesOutput{FargateProfileNames: names}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			list: func(r *eksRepository) ([]*string, error) {
+				return r.ListAllFargateProfiles(context.TODO(), "production")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeEKS{}
+			tt.mocks(client)
+			store.On("Get", tt.cacheKey).Return(nil).Once()
+			store.On("Put", tt.cacheKey, names).Return(false).Once()
+			r := &eksRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := tt.list(r)
+			assert.NoError(t, err)
+			assertNoDiff(t, got, names)
+
+			// The second call is served by the cache
+			store.On("Get", tt.cacheKey).Return(names).Once()
+			got, err = tt.list(r)
+			assert.NoError(t, err)
+			assertNoDiff(t, got, names)
+
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_eksRepository_ListAllIdentityProviderConfigs(t *testing.T) {
+	configs := []*eks.IdentityProviderConfig{
+		{Name: aws.String("okta"), Type: aws.String("oidc")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeEKS, store *cache.MockCache)
+		want    []*eks.IdentityProviderConfig
+		wantErr error
+	}{
+		{
+			name: "list identity provider configs",
+			mocks: func(client *awstest.MockFakeEKS, store *cache.MockCache) {
+				client.On("ListIdentityProviderConfigsPagesWithContext", mock.Anything,
+					&eks.ListIdentityProviderConfigsInput{ClusterName: aws.String("production")},
+					mock.MatchedBy(func(callback func(res *eks.ListIdentityProviderConfigsOutput, lastPage bool) bool) bool {
+						callback(&eks.ListIdentityProviderConfigsOutput{IdentityProviderConfigs: configs}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "eksListAllIdentityProviderConfigs_production").Return(nil).Times(1)
+				store.On("Put", "eksListAllIdentityProviderConfigs_production", configs).Return(false).Times(1)
+			},
+			want: configs,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeEKS, store *cache.MockCache) {
+				client.On("ListIdentityProviderConfigsPagesWithContext", mock.Anything,
+					&eks.ListIdentityProviderConfigsInput{ClusterName: aws.String("production")},
+					mock.AnythingOfType("func(*eks.ListIdentityProviderConfigsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "eksListAllIdentityProviderConfigs_production").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeEKS{}
+			tt.mocks(client, store)
+			r := &eksRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllIdentityProviderConfigs(context.TODO(), "production")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
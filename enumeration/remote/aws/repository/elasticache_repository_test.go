@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -35,7 +37,7 @@ func Test_elasticacheRepository_ListAllCacheClusters(t *testing.T) {
 		{
 			name: "List cache clusters",
 			mocks: func(client *awstest.MockFakeElastiCache, store *cache.MockCache) {
-				client.On("DescribeCacheClustersPages",
+				client.On("DescribeCacheClustersPagesWithContext", mock.Anything,
 					&elasticache.DescribeCacheClustersInput{},
 					mock.MatchedBy(func(callback func(res *elasticache.DescribeCacheClustersOutput, lastPage bool) bool) bool {
 						callback(&elasticache.DescribeCacheClustersOutput{
@@ -61,7 +63,7 @@ func Test_elasticacheRepository_ListAllCacheClusters(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *awstest.MockFakeElastiCache, store *cache.MockCache) {
-				client.On("DescribeCacheClustersPages",
+				client.On("DescribeCacheClustersPagesWithContext", mock.Anything,
 					&elasticache.DescribeCacheClustersInput{},
 					mock.AnythingOfType("func(*elasticache.DescribeCacheClustersOutput, bool) bool")).Return(remoteError).Once()
 				store.On("Get", "elasticacheListAllCacheClusters").Return(nil).Times(1)
@@ -78,7 +80,7 @@ func Test_elasticacheRepository_ListAllCacheClusters(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllCacheClusters()
+			got, err := r.ListAllCacheClusters(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
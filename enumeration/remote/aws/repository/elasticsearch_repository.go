@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// Maximum number of domains that can be described at once
+const elasticsearchDescribeDomainsBatchSize = 5
+
+type ElasticsearchRepository interface {
+	ListAllDomains(ctx context.Context) ([]*elasticsearchservice.ElasticsearchDomainStatus, error)
+}
+
+type elasticsearchRepository struct {
+	client elasticsearchserviceiface.ElasticsearchServiceAPI
+	cache  cache.Cache
+}
+
+func NewElasticsearchRepository(session *session.Session, c cache.Cache) *elasticsearchRepository {
+	return &elasticsearchRepository{
+		elasticsearchservice.New(session),
+		c,
+	}
+}
+
+// ListAllDomains returns both Elasticsearch and OpenSearch domains, they are managed through the same API
+func (r *elasticsearchRepository) ListAllDomains(ctx context.Context) ([]*elasticsearchservice.ElasticsearchDomainStatus, error) {
+	cacheKey := "elasticsearchListAllDomains"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*elasticsearchservice.ElasticsearchDomainStatus), nil
+	}
+
+	names, err := r.client.ListDomainNamesWithContext(ctx, &elasticsearchservice.ListDomainNamesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames := make([]*string, 0, len(names.DomainNames))
+	for _, domain := range names.DomainNames {
+		domainNames = append(domainNames, domain.DomainName)
+	}
+
+	var domains []*elasticsearchservice.ElasticsearchDomainStatus
+	for start := 0; start < len(domainNames); start += elasticsearchDescribeDomainsBatchSize {
+		end := start + elasticsearchDescribeDomainsBatchSize
+		if end > len(domainNames) {
+			end = len(domainNames)
+		}
+		resp, err := r.client.DescribeElasticsearchDomainsWithContext(ctx, &elasticsearchservice.DescribeElasticsearchDomainsInput{
+			DomainNames: domainNames[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, resp.DomainStatusList...)
+	}
+
+	r.cache.Put(cacheKey, domains)
+	return domains, nil
+}
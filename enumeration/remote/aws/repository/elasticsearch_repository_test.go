@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_elasticsearchRepository_ListAllDomains(t *testing.T) {
+	names := []*elasticsearchservice.DomainInfo{
+		{DomainName: aws.String("domain-1")},
+		{DomainName: aws.String("domain-2")},
+		{DomainName: aws.String("domain-3")},
+		{DomainName: aws.String("domain-4")},
+		{DomainName: aws.String("domain-5")},
+		{DomainName: aws.String("domain-6")},
+	}
+
+	domains := []*elasticsearchservice.ElasticsearchDomainStatus{
+		{DomainName: aws.String("domain-1")},
+		{DomainName: aws.String("domain-2")},
+		{DomainName: aws.String("domain-3")},
+		{DomainName: aws.String("domain-4")},
+		{DomainName: aws.String("domain-5")},
+		{DomainName: aws.String("domain-6")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeElasticsearch, store *cache.MockCache)
+		want    []*elasticsearchservice.ElasticsearchDomainStatus
+		wantErr error
+	}{
+		{
+			name: "list in batches",
+			mocks: func(client *awstest.MockFakeElasticsearch, store *cache.MockCache) {
+				client.On("ListDomainNamesWithContext", mock.Anything, &elasticsearchservice.ListDomainNamesInput{}).
+					Return(&elasticsearchservice.ListDomainNamesOutput{DomainNames: names}, nil).Once()
+				client.On("DescribeElasticsearchDomainsWithContext", mock.Anything, &elasticsearchservice.DescribeElasticsearchDomainsInput{
+					DomainNames: []*string{
+						aws.String("domain-1"),
+						aws.String("domain-2"),
+						aws.String("domain-3"),
+						aws.String("domain-4"),
+						aws.String("domain-5"),
+					},
+				}).Return(&elasticsearchservice.DescribeElasticsearchDomainsOutput{DomainStatusList: domains[:5]}, nil).Once()
+				client.On("DescribeElasticsearchDomainsWithContext", mock.Anything, &elasticsearchservice.DescribeElasticsearchDomainsInput{
+					DomainNames: []*string{
+						aws.String("domain-6"),
+					},
+				}).Return(&elasticsearchservice.DescribeElasticsearchDomainsOutput{DomainStatusList: domains[5:]}, nil).Once()
+				store.On("GetAndLock", "elasticsearchListAllDomains").Return(nil).Times(1)
+				store.On("Unlock", "elasticsearchListAllDomains").Times(1)
+				store.On("Put", "elasticsearchListAllDomains", domains).Return(false).Times(1)
+			},
+			want: domains,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeElasticsearch, store *cache.MockCache) {
+				store.On("GetAndLock", "elasticsearchListAllDomains").Return(domains).Times(1)
+				store.On("Unlock", "elasticsearchListAllDomains").Times(1)
+			},
+			want: domains,
+		},
+		{
+			name: "should return remote error when listing names",
+			mocks: func(client *awstest.MockFakeElasticsearch, store *cache.MockCache) {
+				client.On("ListDomainNamesWithContext", mock.Anything, &elasticsearchservice.ListDomainNamesInput{}).
+					Return(nil, remoteError).Once()
+				store.On("GetAndLock", "elasticsearchListAllDomains").Return(nil).Times(1)
+				store.On("Unlock", "elasticsearchListAllDomains").Times(1)
+			},
+			wantErr: remoteError,
+		},
+		{
+			name: "should return remote error when describing domains",
+			mocks: func(client *awstest.MockFakeElasticsearch, store *cache.MockCache) {
+				client.On("ListDomainNamesWithContext", mock.Anything, &elasticsearchservice.ListDomainNamesInput{}).
+					Return(&elasticsearchservice.ListDomainNamesOutput{DomainNames: names[:1]}, nil).Once()
+				client.On("DescribeElasticsearchDomainsWithContext", mock.Anything, mock.Anything).
+					Return(nil, remoteError).Once()
+				store.On("GetAndLock", "elasticsearchListAllDomains").Return(nil).Times(1)
+				store.On("Unlock", "elasticsearchListAllDomains").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeElasticsearch{}
+			tt.mocks(client, store)
+			r := &elasticsearchRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDomains(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
@@ -8,7 +10,7 @@ import (
 )
 
 type ELBRepository interface {
-	ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error)
+	ListAllLoadBalancers(ctx context.Context) ([]*elb.LoadBalancerDescription, error)
 }
 
 type elbRepository struct {
@@ -23,14 +25,14 @@ func NewELBRepository(session *session.Session, c cache.Cache) *elbRepository {
 	}
 }
 
-func (r *elbRepository) ListAllLoadBalancers() ([]*elb.LoadBalancerDescription, error) {
+func (r *elbRepository) ListAllLoadBalancers(ctx context.Context) ([]*elb.LoadBalancerDescription, error) {
 	if v := r.cache.Get("elbListAllLoadBalancers"); v != nil {
 		return v.([]*elb.LoadBalancerDescription), nil
 	}
 
 	results := make([]*elb.LoadBalancerDescription, 0)
 	input := elb.DescribeLoadBalancersInput{}
-	err := r.client.DescribeLoadBalancersPages(&input, func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+	err := r.client.DescribeLoadBalancersPagesWithContext(ctx, &input, func(res *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
 		results = append(results, res.LoadBalancerDescriptions...)
 		return !lastPage
 	})
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -42,7 +43,7 @@ func Test_ELBV2Repository_ListAllLoadBalancers(t *testing.T) {
 				store.On("GetAndLock", "elbv2ListAllLoadBalancers").Return(nil).Once()
 				store.On("Unlock", "elbv2ListAllLoadBalancers").Return().Once()
 
-				client.On("DescribeLoadBalancersPages",
+				client.On("DescribeLoadBalancersPagesWithContext", mock.Anything,
 					&elbv2.DescribeLoadBalancersInput{},
 					mock.MatchedBy(func(callback func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
 						callback(&elbv2.DescribeLoadBalancersOutput{LoadBalancers: []*elbv2.LoadBalancer{
@@ -95,7 +96,7 @@ func Test_ELBV2Repository_ListAllLoadBalancers(t *testing.T) {
 				store.On("GetAndLock", "elbv2ListAllLoadBalancers").Return(nil).Once()
 				store.On("Unlock", "elbv2ListAllLoadBalancers").Return().Once()
 
-				client.On("DescribeLoadBalancersPages",
+				client.On("DescribeLoadBalancersPagesWithContext", mock.Anything,
 					&elbv2.DescribeLoadBalancersInput{},
 					mock.MatchedBy(func(callback func(res *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool) bool {
 						callback(&elbv2.DescribeLoadBalancersOutput{LoadBalancers: []*elbv2.LoadBalancer{}}, true)
@@ -114,7 +115,7 @@ func Test_ELBV2Repository_ListAllLoadBalancers(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLoadBalancers()
+			got, err := r.ListAllLoadBalancers(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			changelog, err := diff.Diff(got, tt.want)
@@ -164,7 +165,7 @@ func Test_ELBV2Repository_ListAllLoadBalancerListeners(t *testing.T) {
 
 						store.On("Get", "elbv2ListAllLoadBalancerListeners_test-lb").Return(nil).Once()
 
-						client.On("DescribeListenersPages",
+						client.On("DescribeListenersPagesWithContext", mock.Anything,
 							&elbv2.DescribeListenersInput{LoadBalancerArn: aws.String("test-lb")},
 							mock.MatchedBy(func(callback func(res *elbv2.DescribeListenersOutput, lastPage bool) bool) bool {
 								callback(&elbv2.DescribeListenersOutput{Listeners: []*elbv2.Listener{
@@ -272,7 +273,7 @@ func Test_ELBV2Repository_ListAllLoadBalancerListeners(t *testing.T) {
 					mocks: func(client *awstest.MockFakeELBV2, store *cache.MockCache) {
 						store.On("Get", "elbv2ListAllLoadBalancerListeners_test-lb").Return(nil).Once()
 
-						client.On("DescribeListenersPages",
+						client.On("DescribeListenersPagesWithContext", mock.Anything,
 							&elbv2.DescribeListenersInput{LoadBalancerArn: aws.String("test-lb")},
 							mock.MatchedBy(func(callback func(res *elbv2.DescribeListenersOutput, lastPage bool) bool) bool {
 								callback(&elbv2.DescribeListenersOutput{Listeners: []*elbv2.Listener{}}, true)
@@ -295,7 +296,7 @@ func Test_ELBV2Repository_ListAllLoadBalancerListeners(t *testing.T) {
 					client: client,
 					cache:  store,
 				}
-				got, err := r.ListAllLoadBalancerListeners(call.loadBalancerArn)
+				got, err := r.ListAllLoadBalancerListeners(context.TODO(), call.loadBalancerArn)
 				assert.Equal(t, call.wantErr, err)
 
 				changelog, err := diff.Diff(got, call.want)
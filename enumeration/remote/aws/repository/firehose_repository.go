@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type FirehoseRepository interface {
+	ListAllDeliveryStreams(ctx context.Context) ([]*firehose.DeliveryStreamDescription, error)
+}
+
+type firehoseRepository struct {
+	client firehoseiface.FirehoseAPI
+	cache  cache.Cache
+}
+
+func NewFirehoseRepository(session *session.Session, c cache.Cache) *firehoseRepository {
+	return &firehoseRepository{
+		firehose.New(session),
+		c,
+	}
+}
+
+// ListAllDeliveryStreams describes every delivery stream since listing them only returns their names
+func (r *firehoseRepository) ListAllDeliveryStreams(ctx context.Context) ([]*firehose.DeliveryStreamDescription, error) {
+	if v := r.cache.Get("firehoseListAllDeliveryStreams"); v != nil {
+		return v.([]*firehose.DeliveryStreamDescription), nil
+	}
+
+	var names []*string
+	input := &firehose.ListDeliveryStreamsInput{}
+	for {
+		resp, err := r.client.ListDeliveryStreamsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, resp.DeliveryStreamNames...)
+		if !aws.BoolValue(resp.HasMoreDeliveryStreams) || len(resp.DeliveryStreamNames) == 0 {
+			break
+		}
+		input.ExclusiveStartDeliveryStreamName = resp.DeliveryStreamNames[len(resp.DeliveryStreamNames)-1]
+	}
+
+	deliveryStreams := make([]*firehose.DeliveryStreamDescription, 0, len(names))
+	for _, name := range names {
+		resp, err := r.client.DescribeDeliveryStreamWithContext(ctx, &firehose.DescribeDeliveryStreamInput{
+			DeliveryStreamName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		deliveryStreams = append(deliveryStreams, resp.DeliveryStreamDescription)
+	}
+
+	r.cache.Put("firehoseListAllDeliveryStreams", deliveryStreams)
+	return deliveryStreams, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_firehoseRepository_ListAllDeliveryStreams(t *testing.T) {
+	deliveryStreams := []*firehose.DeliveryStreamDescription{
+		{DeliveryStreamName: aws.String("clickstream-archive")},
+		{DeliveryStreamName: aws.String("orders-archive")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeFirehose, store *cache.MockCache)
+		want    []*firehose.DeliveryStreamDescription
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeFirehose, store *cache.MockCache) {
+				client.On("ListDeliveryStreamsWithContext", mock.Anything, &firehose.ListDeliveryStreamsInput{}).
+					Return(&firehose.ListDeliveryStreamsOutput{
+						DeliveryStreamNames:    []*string{aws.String("clickstream-archive")},
+						HasMoreDeliveryStreams: aws.Bool(true),
+					}, nil).Once()
+				client.On("ListDeliveryStreamsWithContext", mock.Anything, &firehose.ListDeliveryStreamsInput{
+					ExclusiveStartDeliveryStreamName: aws.String("clickstream-archive"),
+				}).Return(&firehose.ListDeliveryStreamsOutput{
+					DeliveryStreamNames:    []*string{aws.String("orders-archive")},
+					HasMoreDeliveryStreams: aws.Bool(false),
+				}, nil).Once()
+				client.On("DescribeDeliveryStreamWithContext", mock.Anything, &firehose.DescribeDeliveryStreamInput{
+					DeliveryStreamName: aws.String("clickstream-archive"),
+				}).Return(&firehose.DescribeDeliveryStreamOutput{DeliveryStreamDescription: deliveryStreams[0]}, nil).Once()
+				client.On("DescribeDeliveryStreamWithContext", mock.Anything, &firehose.DescribeDeliveryStreamInput{
+					DeliveryStreamName: aws.String("orders-archive"),
+				}).Return(&firehose.DescribeDeliveryStreamOutput{DeliveryStreamDescription: deliveryStreams[1]}, nil).Once()
+				store.On("Get", "firehoseListAllDeliveryStreams").Return(nil).Times(1)
+				store.On("Put", "firehoseListAllDeliveryStreams", deliveryStreams).Return(false).Times(1)
+			},
+			want: deliveryStreams,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeFirehose, store *cache.MockCache) {
+				store.On("Get", "firehoseListAllDeliveryStreams").Return(deliveryStreams).Times(1)
+			},
+			want: deliveryStreams,
+		},
+		{
+			name: "should return remote error when listing delivery streams",
+			mocks: func(client *awstest.MockFakeFirehose, store *cache.MockCache) {
+				client.On("ListDeliveryStreamsWithContext", mock.Anything, &firehose.ListDeliveryStreamsInput{}).
+					Return(nil, remoteError).Once()
+				store.On("Get", "firehoseListAllDeliveryStreams").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+		{
+			name: "should return remote error when describing delivery streams",
+			mocks: func(client *awstest.MockFakeFirehose, store *cache.MockCache) {
+				client.On("ListDeliveryStreamsWithContext", mock.Anything, &firehose.ListDeliveryStreamsInput{}).
+					Return(&firehose.ListDeliveryStreamsOutput{
+						DeliveryStreamNames:    []*string{aws.String("clickstream-archive")},
+						HasMoreDeliveryStreams: aws.Bool(false),
+					}, nil).Once()
+				client.On("DescribeDeliveryStreamWithContext", mock.Anything, mock.Anything).Return(nil, remoteError).Once()
+				store.On("Get", "firehoseListAllDeliveryStreams").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeFirehose{}
+			tt.mocks(client, store)
+			r := &firehoseRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDeliveryStreams(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
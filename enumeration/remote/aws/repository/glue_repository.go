@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/glue/glueiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type GlueRepository interface {
+	ListAllDatabases(ctx context.Context) ([]*glue.Database, error)
+	ListAllTables(ctx context.Context, databaseName string) ([]*glue.TableData, error)
+	ListAllJobs(ctx context.Context) ([]*glue.Job, error)
+	ListAllCrawlers(ctx context.Context) ([]*glue.Crawler, error)
+}
+
+type glueRepository struct {
+	client glueiface.GlueAPI
+	cache  cache.Cache
+}
+
+func NewGlueRepository(session *session.Session, c cache.Cache) *glueRepository {
+	return &glueRepository{
+		glue.New(session),
+		c,
+	}
+}
+
+func (r *glueRepository) ListAllDatabases(ctx context.Context) ([]*glue.Database, error) {
+	cacheKey := "glueListAllDatabases"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*glue.Database), nil
+	}
+
+	var databases []*glue.Database
+	input := glue.GetDatabasesInput{}
+	err := r.client.GetDatabasesPagesWithContext(ctx, &input,
+		func(resp *glue.GetDatabasesOutput, lastPage bool) bool {
+			databases = append(databases, resp.DatabaseList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, databases)
+	return databases, nil
+}
+
+func (r *glueRepository) ListAllTables(ctx context.Context, databaseName string) ([]*glue.TableData, error) {
+	cacheKey := fmt.Sprintf("glueListAllTables_%s", databaseName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*glue.TableData), nil
+	}
+
+	var tables []*glue.TableData
+	input := glue.GetTablesInput{
+		DatabaseName: aws.String(databaseName),
+	}
+	err := r.client.GetTablesPagesWithContext(ctx, &input,
+		func(resp *glue.GetTablesOutput, lastPage bool) bool {
+			tables = append(tables, resp.TableList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, tables)
+	return tables, nil
+}
+
+func (r *glueRepository) ListAllJobs(ctx context.Context) ([]*glue.Job, error) {
+	if v := r.cache.Get("glueListAllJobs"); v != nil {
+		return v.([]*glue.Job), nil
+	}
+
+	var jobs []*glue.Job
+	input := glue.GetJobsInput{}
+	err := r.client.GetJobsPagesWithContext(ctx, &input,
+		func(resp *glue.GetJobsOutput, lastPage bool) bool {
+			jobs = append(jobs, resp.Jobs...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("glueListAllJobs", jobs)
+	return jobs, nil
+}
+
+func (r *glueRepository) ListAllCrawlers(ctx context.Context) ([]*glue.Crawler, error) {
+	if v := r.cache.Get("glueListAllCrawlers"); v != nil {
+		return v.([]*glue.Crawler), nil
+	}
+
+	var crawlers []*glue.Crawler
+	input := glue.GetCrawlersInput{}
+	err := r.client.GetCrawlersPagesWithContext(ctx, &input,
+		func(resp *glue.GetCrawlersOutput, lastPage bool) bool {
+			crawlers = append(crawlers, resp.Crawlers...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("glueListAllCrawlers", crawlers)
+	return crawlers, nil
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_glueRepository_ListAllDatabases(t *testing.T) {
+	items := []*glue.Database{
+		{Name: aws.String("analytics")},
+		{Name: aws.String("raw")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue, store *cache.MockCache)
+		want    []*glue.Database
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetDatabasesPagesWithContext", mock.Anything,
+					&glue.GetDatabasesInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetDatabasesOutput, lastPage bool) bool) bool {
+						callback(&glue.GetDatabasesOutput{DatabaseList: items[:1]}, false)
+						callback(&glue.GetDatabasesOutput{DatabaseList: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("GetAndLock", "glueListAllDatabases").Return(nil).Times(1)
+				store.On("Unlock", "glueListAllDatabases").Times(1)
+				store.On("Put", "glueListAllDatabases", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				store.On("GetAndLock", "glueListAllDatabases").Return(items).Times(1)
+				store.On("Unlock", "glueListAllDatabases").Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetDatabasesPagesWithContext", mock.Anything,
+					&glue.GetDatabasesInput{},
+					mock.AnythingOfType("func(*glue.GetDatabasesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("GetAndLock", "glueListAllDatabases").Return(nil).Times(1)
+				store.On("Unlock", "glueListAllDatabases").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeGlue{}
+			tt.mocks(client, store)
+			r := &glueRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDatabases(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_glueRepository_ListAllTables(t *testing.T) {
+	items := []*glue.TableData{
+		{Name: aws.String("orders")},
+		{Name: aws.String("customers")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue, store *cache.MockCache)
+		want    []*glue.TableData
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetTablesPagesWithContext", mock.Anything,
+					&glue.GetTablesInput{DatabaseName: aws.String("analytics")},
+					mock.MatchedBy(func(callback func(res *glue.GetTablesOutput, lastPage bool) bool) bool {
+						callback(&glue.GetTablesOutput{TableList: items[:1]}, false)
+						callback(&glue.GetTablesOutput{TableList: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "glueListAllTables_analytics").Return(nil).Times(1)
+				store.On("Put", "glueListAllTables_analytics", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				store.On("Get", "glueListAllTables_analytics").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetTablesPagesWithContext", mock.Anything,
+					&glue.GetTablesInput{DatabaseName: aws.String("analytics")},
+					mock.AnythingOfType("func(*glue.GetTablesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "glueListAllTables_analytics").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeGlue{}
+			tt.mocks(client, store)
+			r := &glueRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllTables(context.TODO(), "analytics")
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_glueRepository_ListAllJobs(t *testing.T) {
+	items := []*glue.Job{
+		{Name: aws.String("orders-etl")},
+		{Name: aws.String("customers-etl")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue, store *cache.MockCache)
+		want    []*glue.Job
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetJobsPagesWithContext", mock.Anything,
+					&glue.GetJobsInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetJobsOutput, lastPage bool) bool) bool {
+						callback(&glue.GetJobsOutput{Jobs: items[:1]}, false)
+						callback(&glue.GetJobsOutput{Jobs: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "glueListAllJobs").Return(nil).Times(1)
+				store.On("Put", "glueListAllJobs", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				store.On("Get", "glueListAllJobs").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetJobsPagesWithContext", mock.Anything,
+					&glue.GetJobsInput{},
+					mock.AnythingOfType("func(*glue.GetJobsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "glueListAllJobs").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeGlue{}
+			tt.mocks(client, store)
+			r := &glueRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllJobs(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_glueRepository_ListAllCrawlers(t *testing.T) {
+	items := []*glue.Crawler{
+		{Name: aws.String("orders-crawler")},
+		{Name: aws.String("customers-crawler")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeGlue, store *cache.MockCache)
+		want    []*glue.Crawler
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetCrawlersPagesWithContext", mock.Anything,
+					&glue.GetCrawlersInput{},
+					mock.MatchedBy(func(callback func(res *glue.GetCrawlersOutput, lastPage bool) bool) bool {
+						callback(&glue.GetCrawlersOutput{Crawlers: items[:1]}, false)
+						callback(&glue.GetCrawlersOutput{Crawlers: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "glueListAllCrawlers").Return(nil).Times(1)
+				store.On("Put", "glueListAllCrawlers", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				store.On("Get", "glueListAllCrawlers").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeGlue, store *cache.MockCache) {
+				client.On("GetCrawlersPagesWithContext", mock.Anything,
+					&glue.GetCrawlersInput{},
+					mock.AnythingOfType("func(*glue.GetCrawlersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "glueListAllCrawlers").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeGlue{}
+			tt.mocks(client, store)
+			r := &glueRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllCrawlers(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
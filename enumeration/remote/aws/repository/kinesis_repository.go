@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type KinesisRepository interface {
+	ListAllStreams(ctx context.Context) ([]*kinesis.StreamDescriptionSummary, error)
+}
+
+type kinesisRepository struct {
+	client kinesisiface.KinesisAPI
+	cache  cache.Cache
+}
+
+func NewKinesisRepository(session *session.Session, c cache.Cache) *kinesisRepository {
+	return &kinesisRepository{
+		kinesis.New(session),
+		c,
+	}
+}
+
+// ListAllStreams describes every stream since listing them only returns their names
+func (r *kinesisRepository) ListAllStreams(ctx context.Context) ([]*kinesis.StreamDescriptionSummary, error) {
+	if v := r.cache.Get("kinesisListAllStreams"); v != nil {
+		return v.([]*kinesis.StreamDescriptionSummary), nil
+	}
+
+	var names []*string
+	input := kinesis.ListStreamsInput{}
+	err := r.client.ListStreamsPagesWithContext(ctx, &input,
+		func(resp *kinesis.ListStreamsOutput, lastPage bool) bool {
+			names = append(names, resp.StreamNames...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]*kinesis.StreamDescriptionSummary, 0, len(names))
+	for _, name := range names {
+		resp, err := r.client.DescribeStreamSummaryWithContext(ctx, &kinesis.DescribeStreamSummaryInput{
+			StreamName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, resp.StreamDescriptionSummary)
+	}
+
+	r.cache.Put("kinesisListAllStreams", streams)
+	return streams, nil
+}
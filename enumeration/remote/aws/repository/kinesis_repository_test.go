@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_kinesisRepository_ListAllStreams(t *testing.T) {
+	streams := []*kinesis.StreamDescriptionSummary{
+		{StreamName: aws.String("clickstream")},
+		{StreamName: aws.String("orders")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeKinesis, store *cache.MockCache)
+		want    []*kinesis.StreamDescriptionSummary
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeKinesis, store *cache.MockCache) {
+				client.On("ListStreamsPagesWithContext", mock.Anything,
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{StreamNames: []*string{aws.String("clickstream")}}, false)
+						callback(&kinesis.ListStreamsOutput{StreamNames: []*string{aws.String("orders")}}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeStreamSummaryWithContext", mock.Anything, &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("clickstream"),
+				}).Return(&kinesis.DescribeStreamSummaryOutput{StreamDescriptionSummary: streams[0]}, nil).Once()
+				client.On("DescribeStreamSummaryWithContext", mock.Anything, &kinesis.DescribeStreamSummaryInput{
+					StreamName: aws.String("orders"),
+				}).Return(&kinesis.DescribeStreamSummaryOutput{StreamDescriptionSummary: streams[1]}, nil).Once()
+				store.On("Get", "kinesisListAllStreams").Return(nil).Times(1)
+				store.On("Put", "kinesisListAllStreams", streams).Return(false).Times(1)
+			},
+			want: streams,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeKinesis, store *cache.MockCache) {
+				store.On("Get", "kinesisListAllStreams").Return(streams).Times(1)
+			},
+			want: streams,
+		},
+		{
+			name: "should return remote error when listing streams",
+			mocks: func(client *awstest.MockFakeKinesis, store *cache.MockCache) {
+				client.On("ListStreamsPagesWithContext", mock.Anything,
+					&kinesis.ListStreamsInput{},
+					mock.AnythingOfType("func(*kinesis.ListStreamsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "kinesisListAllStreams").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+		{
+			name: "should return remote error when describing streams",
+			mocks: func(client *awstest.MockFakeKinesis, store *cache.MockCache) {
+				client.On("ListStreamsPagesWithContext", mock.Anything,
+					&kinesis.ListStreamsInput{},
+					mock.MatchedBy(func(callback func(res *kinesis.ListStreamsOutput, lastPage bool) bool) bool {
+						callback(&kinesis.ListStreamsOutput{StreamNames: []*string{aws.String("clickstream")}}, true)
+						return true
+					})).Return(nil).Once()
+				client.On("DescribeStreamSummaryWithContext", mock.Anything, mock.Anything).Return(nil, remoteError).Once()
+				store.On("Get", "kinesisListAllStreams").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeKinesis{}
+			tt.mocks(client, store)
+			r := &kinesisRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllStreams(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
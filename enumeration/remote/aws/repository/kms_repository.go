@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
@@ -14,8 +15,8 @@ import (
 )
 
 type KMSRepository interface {
-	ListAllKeys() ([]*kms.KeyListEntry, error)
-	ListAllAliases() ([]*kms.AliasListEntry, error)
+	ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error)
+	ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error)
 }
 
 type kmsRepository struct {
@@ -32,14 +33,14 @@ func NewKMSRepository(session *session.Session, c cache.Cache) *kmsRepository {
 	}
 }
 
-func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
+func (r *kmsRepository) ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error) {
 	if v := r.cache.Get("kmsListAllKeys"); v != nil {
 		return v.([]*kms.KeyListEntry), nil
 	}
 
 	var keys []*kms.KeyListEntry
 	input := kms.ListKeysInput{}
-	err := r.client.ListKeysPages(&input,
+	err := r.client.ListKeysPagesWithContext(ctx, &input,
 		func(resp *kms.ListKeysOutput, lastPage bool) bool {
 			keys = append(keys, resp.Keys...)
 			return !lastPage
@@ -57,14 +58,14 @@ func (r *kmsRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	return customerKeys, nil
 }
 
-func (r *kmsRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
+func (r *kmsRepository) ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error) {
 	if v := r.cache.Get("kmsListAllAliases"); v != nil {
 		return v.([]*kms.AliasListEntry), nil
 	}
 
 	var aliases []*kms.AliasListEntry
 	input := kms.ListAliasesInput{}
-	err := r.client.ListAliasesPages(&input,
+	err := r.client.ListAliasesPagesWithContext(ctx, &input,
 		func(resp *kms.ListAliasesOutput, lastPage bool) bool {
 			aliases = append(aliases, resp.Aliases...)
 			return !lastPage
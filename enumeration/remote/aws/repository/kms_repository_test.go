@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"sync"
@@ -25,7 +27,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 		{
 			name: "List only enabled keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListKeysPages",
+				client.On("ListKeysPagesWithContext", mock.Anything,
 					&kms.ListKeysInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListKeysOutput, lastPage bool) bool) bool {
 						callback(&kms.ListKeysOutput{
@@ -64,7 +66,7 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 		{
 			name: "List only customer keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListKeysPages",
+				client.On("ListKeysPagesWithContext", mock.Anything,
 					&kms.ListKeysInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListKeysOutput, lastPage bool) bool) bool {
 						callback(&kms.ListKeysOutput{
@@ -122,12 +124,12 @@ func Test_KMSRepository_ListAllKeys(t *testing.T) {
 				cache:           store,
 				describeKeyLock: &sync.Mutex{},
 			}
-			got, err := r.ListAllKeys()
+			got, err := r.ListAllKeys(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllKeys()
+				cachedData, err := r.ListAllKeys(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.KeyListEntry{}, store.Get("kmsListAllKeys"))
@@ -155,7 +157,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		{
 			name: "List only aliases for enabled keys",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListAliasesPages",
+				client.On("ListAliasesPagesWithContext", mock.Anything,
 					&kms.ListAliasesInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListAliasesOutput, lastPage bool) bool) bool {
 						callback(&kms.ListAliasesOutput{
@@ -184,7 +186,7 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 		{
 			name: "List only customer aliases",
 			mocks: func(client *awstest.MockFakeKMS) {
-				client.On("ListAliasesPages",
+				client.On("ListAliasesPagesWithContext", mock.Anything,
 					&kms.ListAliasesInput{},
 					mock.MatchedBy(func(callback func(res *kms.ListAliasesOutput, lastPage bool) bool) bool {
 						callback(&kms.ListAliasesOutput{
@@ -225,12 +227,12 @@ func Test_KMSRepository_ListAllAliases(t *testing.T) {
 				cache:           store,
 				describeKeyLock: &sync.Mutex{},
 			}
-			got, err := r.ListAllAliases()
+			got, err := r.ListAllAliases(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllAliases()
+				cachedData, err := r.ListAllAliases(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*kms.AliasListEntry{}, store.Get("kmsListAllAliases"))
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
@@ -8,8 +11,10 @@ import (
 )
 
 type LambdaRepository interface {
-	ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error)
-	ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error)
+	ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error)
+	ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error)
+	ListAllLambdaAliases(ctx context.Context, functionName string) ([]*lambda.AliasConfiguration, error)
+	GetLambdaFunctionPolicy(ctx context.Context, functionName string) (*string, error)
 }
 
 type lambdaRepository struct {
@@ -24,14 +29,17 @@ func NewLambdaRepository(session *session.Session, c cache.Cache) *lambdaReposit
 	}
 }
 
-func (r *lambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error) {
-	if v := r.cache.Get("lambdaListAllLambdaFunctions"); v != nil {
+func (r *lambdaRepository) ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error) {
+	cacheKey := "lambdaListAllLambdaFunctions"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
 		return v.([]*lambda.FunctionConfiguration), nil
 	}
 
 	var functions []*lambda.FunctionConfiguration
 	input := &lambda.ListFunctionsInput{}
-	err := r.client.ListFunctionsPages(input, func(res *lambda.ListFunctionsOutput, lastPage bool) bool {
+	err := r.client.ListFunctionsPagesWithContext(ctx, input, func(res *lambda.ListFunctionsOutput, lastPage bool) bool {
 		functions = append(functions, res.Functions...)
 		return !lastPage
 	})
@@ -39,18 +47,18 @@ func (r *lambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfigura
 		return nil, err
 	}
 
-	r.cache.Put("lambdaListAllLambdaFunctions", functions)
+	r.cache.Put(cacheKey, functions)
 	return functions, nil
 }
 
-func (r *lambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error) {
+func (r *lambdaRepository) ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error) {
 	if v := r.cache.Get("lambdaListAllLambdaEventSourceMappings"); v != nil {
 		return v.([]*lambda.EventSourceMappingConfiguration), nil
 	}
 
 	var eventSourceMappingConfigurations []*lambda.EventSourceMappingConfiguration
 	input := &lambda.ListEventSourceMappingsInput{}
-	err := r.client.ListEventSourceMappingsPages(input, func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
+	err := r.client.ListEventSourceMappingsPagesWithContext(ctx, input, func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool {
 		eventSourceMappingConfigurations = append(eventSourceMappingConfigurations, res.EventSourceMappings...)
 		return !lastPage
 	})
@@ -61,3 +69,44 @@ func (r *lambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSo
 	r.cache.Put("lambdaListAllLambdaEventSourceMappings", eventSourceMappingConfigurations)
 	return eventSourceMappingConfigurations, nil
 }
+
+func (r *lambdaRepository) ListAllLambdaAliases(ctx context.Context, functionName string) ([]*lambda.AliasConfiguration, error) {
+	cacheKey := fmt.Sprintf("lambdaListAllLambdaAliases_%s", functionName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*lambda.AliasConfiguration), nil
+	}
+
+	var aliases []*lambda.AliasConfiguration
+	input := &lambda.ListAliasesInput{
+		FunctionName: &functionName,
+	}
+	err := r.client.ListAliasesPagesWithContext(ctx, input, func(res *lambda.ListAliasesOutput, lastPage bool) bool {
+		aliases = append(aliases, res.Aliases...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, aliases)
+	return aliases, nil
+}
+
+// GetLambdaFunctionPolicy returns the resource based policy of the unqualified function,
+// AWS returns a ResourceNotFoundException when the function has no policy
+func (r *lambdaRepository) GetLambdaFunctionPolicy(ctx context.Context, functionName string) (*string, error) {
+	cacheKey := fmt.Sprintf("lambdaGetLambdaFunctionPolicy_%s", functionName)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.(*string), nil
+	}
+
+	output, err := r.client.GetPolicyWithContext(ctx, &lambda.GetPolicyInput{
+		FunctionName: &functionName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, output.Policy)
+	return output.Policy, nil
+}
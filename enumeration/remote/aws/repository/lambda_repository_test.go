@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"strings"
 	"testing"
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
 	"github.com/r3labs/diff/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,7 +27,7 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeLambda) {
-				client.On("ListFunctionsPages",
+				client.On("ListFunctionsPagesWithContext", mock.Anything,
 					&lambda.ListFunctionsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListFunctionsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListFunctionsOutput{
@@ -68,12 +71,12 @@ func Test_lambdaRepository_ListAllLambdaFunctions(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaFunctions()
+			got, err := r.ListAllLambdaFunctions(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaFunctions()
+				cachedData, err := r.ListAllLambdaFunctions(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.FunctionConfiguration{}, store.Get("lambdaListAllLambdaFunctions"))
@@ -101,7 +104,7 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 		{
 			name: "List with 2 pages",
 			mocks: func(client *awstest.MockFakeLambda) {
-				client.On("ListEventSourceMappingsPages",
+				client.On("ListEventSourceMappingsPagesWithContext", mock.Anything,
 					&lambda.ListEventSourceMappingsInput{},
 					mock.MatchedBy(func(callback func(res *lambda.ListEventSourceMappingsOutput, lastPage bool) bool) bool {
 						callback(&lambda.ListEventSourceMappingsOutput{
@@ -145,12 +148,12 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 				client: client,
 				cache:  store,
 			}
-			got, err := r.ListAllLambdaEventSourceMappings()
+			got, err := r.ListAllLambdaEventSourceMappings(context.TODO())
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllLambdaEventSourceMappings()
+				cachedData, err := r.ListAllLambdaEventSourceMappings(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*lambda.EventSourceMappingConfiguration{}, store.Get("lambdaListAllLambdaEventSourceMappings"))
@@ -167,3 +170,132 @@ func Test_lambdaRepository_ListAllLambdaEventSourceMappings(t *testing.T) {
 		})
 	}
 }
+
+func Test_lambdaRepository_ListAllLambdaAliases(t *testing.T) {
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeLambda)
+		want    []*lambda.AliasConfiguration
+		wantErr error
+	}{
+		{
+			name: "List with 2 pages",
+			mocks: func(client *awstest.MockFakeLambda) {
+				client.On("ListAliasesPagesWithContext", mock.Anything,
+					&lambda.ListAliasesInput{FunctionName: aws.String("foo")},
+					mock.MatchedBy(func(callback func(res *lambda.ListAliasesOutput, lastPage bool) bool) bool {
+						callback(&lambda.ListAliasesOutput{
+							Aliases: []*lambda.AliasConfiguration{
+								{Name: aws.String("live")},
+							},
+						}, false)
+						callback(&lambda.ListAliasesOutput{
+							Aliases: []*lambda.AliasConfiguration{
+								{Name: aws.String("canary")},
+							},
+						}, true)
+						return true
+					})).Return(nil).Once()
+			},
+			want: []*lambda.AliasConfiguration{
+				{Name: aws.String("live")},
+				{Name: aws.String("canary")},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeLambda) {
+				client.On("ListAliasesPagesWithContext", mock.Anything,
+					&lambda.ListAliasesInput{FunctionName: aws.String("foo")},
+					mock.AnythingOfType("func(*lambda.ListAliasesOutput, bool) bool")).Return(remoteError).Once()
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeLambda{}
+			tt.mocks(client)
+			r := &lambdaRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllLambdaAliases(context.TODO(), "foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.ListAllLambdaAliases(context.TODO(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+				assert.IsType(t, []*lambda.AliasConfiguration{}, store.Get("lambdaListAllLambdaAliases_foo"))
+			}
+
+			changelog, err := diff.Diff(got, tt.want)
+			assert.Nil(t, err)
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s: %s -> %s", strings.Join(change.Path, "."), change.From, change.To)
+				}
+				t.Fail()
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_lambdaRepository_GetLambdaFunctionPolicy(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Id":"default","Statement":[{"Sid":"AllowExecutionFromSNS","Effect":"Allow"}]}`
+	notFound := &lambda.ResourceNotFoundException{Message_: aws.String("The resource you requested does not exist.")}
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeLambda)
+		want    *string
+		wantErr error
+	}{
+		{
+			name: "get function policy",
+			mocks: func(client *awstest.MockFakeLambda) {
+				client.On("GetPolicyWithContext", mock.Anything, &lambda.GetPolicyInput{FunctionName: aws.String("foo")}).
+					Return(&lambda.GetPolicyOutput{Policy: aws.String(policy)}, nil).Once()
+			},
+			want: aws.String(policy),
+		},
+		{
+			name: "function without policy",
+			mocks: func(client *awstest.MockFakeLambda) {
+				client.On("GetPolicyWithContext", mock.Anything, &lambda.GetPolicyInput{FunctionName: aws.String("foo")}).
+					Return(nil, notFound).Once()
+			},
+			wantErr: notFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := cache.New(1)
+			client := &awstest.MockFakeLambda{}
+			tt.mocks(client)
+			r := &lambdaRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.GetLambdaFunctionPolicy(context.TODO(), "foo")
+			assert.Equal(t, tt.wantErr, err)
+
+			if err == nil {
+				// Check that results were cached
+				cachedData, err := r.GetLambdaFunctionPolicy(context.TODO(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, got, cachedData)
+			}
+
+			assert.Equal(t, tt.want, got)
+			client.AssertExpectations(t)
+		})
+	}
+}
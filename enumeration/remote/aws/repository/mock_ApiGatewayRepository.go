@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	apigateway "github.com/aws/aws-sdk-go/service/apigateway"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockApiGatewayRepository struct {
 	mock.Mock
 }
 
-// GetAccount provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) GetAccount() (*apigateway.Account, error) {
-	ret := _m.Called()
+// GetAccount provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) GetAccount(ctx context.Context) (*apigateway.Account, error) {
+	ret := _m.Called(ctx)
 
 	var r0 *apigateway.Account
-	if rf, ok := ret.Get(0).(func() *apigateway.Account); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) *apigateway.Account); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*apigateway.Account)
@@ -26,8 +28,8 @@ func (_m *MockApiGatewayRepository) GetAccount() (*apigateway.Account, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockApiGatewayRepository) GetAccount() (*apigateway.Account, error) {
 	return r0, r1
 }
 
-// ListAllApiKeys provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, error) {
-	ret := _m.Called()
+// ListAllApiKeys provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllApiKeys(ctx context.Context) ([]*apigateway.ApiKey, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.ApiKey
-	if rf, ok := ret.Get(0).(func() []*apigateway.ApiKey); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.ApiKey); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.ApiKey)
@@ -49,8 +51,8 @@ func (_m *MockApiGatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, erro
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockApiGatewayRepository) ListAllApiKeys() ([]*apigateway.ApiKey, erro
 	return r0, r1
 }
 
-// ListAllDomainNameBasePathMappings provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 string) ([]*apigateway.BasePathMapping, error) {
-	ret := _m.Called(_a0)
+// ListAllDomainNameBasePathMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 context.Context, _a1 string) ([]*apigateway.BasePathMapping, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.BasePathMapping
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.BasePathMapping); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.BasePathMapping); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.BasePathMapping)
@@ -72,8 +74,8 @@ func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockApiGatewayRepository) ListAllDomainNameBasePathMappings(_a0 string
 	return r0, r1
 }
 
-// ListAllDomainNames provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllDomainNames() ([]*apigateway.DomainName, error) {
-	ret := _m.Called()
+// ListAllDomainNames provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllDomainNames(ctx context.Context) ([]*apigateway.DomainName, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.DomainName
-	if rf, ok := ret.Get(0).(func() []*apigateway.DomainName); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.DomainName); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.DomainName)
@@ -95,8 +97,8 @@ func (_m *MockApiGatewayRepository) ListAllDomainNames() ([]*apigateway.DomainNa
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockApiGatewayRepository) ListAllDomainNames() ([]*apigateway.DomainNa
 	return r0, r1
 }
 
-// ListAllRestApiAuthorizers provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 string) ([]*apigateway.Authorizer, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiAuthorizers provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 context.Context, _a1 string) ([]*apigateway.Authorizer, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.Authorizer
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Authorizer); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Authorizer); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Authorizer)
@@ -118,8 +120,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 string) ([]*ap
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiAuthorizers(_a0 string) ([]*ap
 	return r0, r1
 }
 
-// ListAllRestApiModels provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 string) ([]*apigateway.Model, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiModels provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 context.Context, _a1 string) ([]*apigateway.Model, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.Model
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Model); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Model); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Model)
@@ -141,8 +143,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 string) ([]*apigate
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiModels(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllRestApiGatewayResponses provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiGatewayResponses provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 context.Context, _a1 string) ([]*apigateway.UpdateGatewayResponseOutput, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.UpdateGatewayResponseOutput
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.UpdateGatewayResponseOutput); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.UpdateGatewayResponseOutput); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateGatewayResponseOutput)
@@ -164,8 +166,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 string) (
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiGatewayResponses(_a0 string) (
 	return r0, r1
 }
 
-// ListAllRestApiRequestValidators provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiRequestValidators provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 context.Context, _a1 string) ([]*apigateway.UpdateRequestValidatorOutput, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.UpdateRequestValidatorOutput
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.UpdateRequestValidatorOutput); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.UpdateRequestValidatorOutput); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateRequestValidatorOutput)
@@ -187,8 +189,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,13 +198,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiRequestValidators(_a0 string)
 	return r0, r1
 }
 
-// ListAllRestApiResources provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 string) ([]*apigateway.Resource, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiResources provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 context.Context, _a1 string) ([]*apigateway.Resource, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.Resource
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Resource); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Resource); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Resource)
@@ -210,8 +212,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 string) ([]*apig
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,13 +221,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiResources(_a0 string) ([]*apig
 	return r0, r1
 }
 
-// ListAllRestApiStages provides a mock function with given fields: _a0
-func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 string) ([]*apigateway.Stage, error) {
-	ret := _m.Called(_a0)
+// ListAllRestApiStages provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 context.Context, _a1 string) ([]*apigateway.Stage, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigateway.Stage
-	if rf, ok := ret.Get(0).(func(string) []*apigateway.Stage); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigateway.Stage); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.Stage)
@@ -233,8 +235,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 string) ([]*apigate
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,13 +244,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApiStages(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllRestApis provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, error) {
-	ret := _m.Called()
+// ListAllRestApis provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllRestApis(ctx context.Context) ([]*apigateway.RestApi, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.RestApi
-	if rf, ok := ret.Get(0).(func() []*apigateway.RestApi); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.RestApi); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.RestApi)
@@ -256,8 +258,8 @@ func (_m *MockApiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, er
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -265,13 +267,13 @@ func (_m *MockApiGatewayRepository) ListAllRestApis() ([]*apigateway.RestApi, er
 	return r0, r1
 }
 
-// ListAllVpcLinks provides a mock function with given fields:
-func (_m *MockApiGatewayRepository) ListAllVpcLinks() ([]*apigateway.UpdateVpcLinkOutput, error) {
-	ret := _m.Called()
+// ListAllVpcLinks provides a mock function with given fields: ctx
+func (_m *MockApiGatewayRepository) ListAllVpcLinks(ctx context.Context) ([]*apigateway.UpdateVpcLinkOutput, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigateway.UpdateVpcLinkOutput
-	if rf, ok := ret.Get(0).(func() []*apigateway.UpdateVpcLinkOutput); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigateway.UpdateVpcLinkOutput); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigateway.UpdateVpcLinkOutput)
@@ -279,8 +281,8 @@ func (_m *MockApiGatewayRepository) ListAllVpcLinks() ([]*apigateway.UpdateVpcLi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
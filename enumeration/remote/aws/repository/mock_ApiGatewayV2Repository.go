@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	apigatewayv2 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockApiGatewayV2Repository struct {
 	mock.Mock
 }
 
-// ListAllApiAuthorizers provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 string) ([]*apigatewayv2.Authorizer, error) {
-	ret := _m.Called(_a0)
+// ListAllApiAuthorizers provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 context.Context, _a1 string) ([]*apigatewayv2.Authorizer, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigatewayv2.Authorizer
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Authorizer); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Authorizer); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Authorizer)
@@ -26,8 +28,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 string) ([]*apig
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiAuthorizers(_a0 string) ([]*apig
 	return r0, r1
 }
 
-// ListAllApiDeployments provides a mock function with given fields: apiId
-func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(apiId *string) ([]*apigatewayv2.Deployment, error) {
-	ret := _m.Called(apiId)
+// ListAllApiDeployments provides a mock function with given fields: ctx, apiId
+func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(ctx context.Context, apiId *string) ([]*apigatewayv2.Deployment, error) {
+	ret := _m.Called(ctx, apiId)
 
 	var r0 []*apigatewayv2.Deployment
-	if rf, ok := ret.Get(0).(func(*string) []*apigatewayv2.Deployment); ok {
-		r0 = rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*apigatewayv2.Deployment); ok {
+		r0 = rf(ctx, apiId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Deployment)
@@ -49,8 +51,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(apiId *string) ([]*a
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*string) error); ok {
-		r1 = rf(apiId)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, apiId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiDeployments(apiId *string) ([]*a
 	return r0, r1
 }
 
-// ListAllApiIntegrationResponses provides a mock function with given fields: _a0, _a1
-func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 string, _a1 string) ([]*apigatewayv2.IntegrationResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// ListAllApiIntegrationResponses provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 context.Context, _a1 string, _a2 string) ([]*apigatewayv2.IntegrationResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*apigatewayv2.IntegrationResponse
-	if rf, ok := ret.Get(0).(func(string, string) []*apigatewayv2.IntegrationResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*apigatewayv2.IntegrationResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.IntegrationResponse)
@@ -72,8 +74,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 string,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrationResponses(_a0 string,
 	return r0, r1
 }
 
-// ListAllApiIntegrations provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 string) ([]*apigatewayv2.Integration, error) {
-	ret := _m.Called(_a0)
+// ListAllApiIntegrations provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 context.Context, _a1 string) ([]*apigatewayv2.Integration, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigatewayv2.Integration
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Integration); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Integration); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Integration)
@@ -95,8 +97,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 string) ([]*api
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiIntegrations(_a0 string) ([]*api
 	return r0, r1
 }
 
-// ListAllApiMappings provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 string) ([]*apigatewayv2.ApiMapping, error) {
-	ret := _m.Called(_a0)
+// ListAllApiMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 context.Context, _a1 string) ([]*apigatewayv2.ApiMapping, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigatewayv2.ApiMapping
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.ApiMapping); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.ApiMapping); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.ApiMapping)
@@ -118,8 +120,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 string) ([]*apigate
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiMappings(_a0 string) ([]*apigate
 	return r0, r1
 }
 
-// ListAllApiModels provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 string) ([]*apigatewayv2.Model, error) {
-	ret := _m.Called(_a0)
+// ListAllApiModels provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 context.Context, _a1 string) ([]*apigatewayv2.Model, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigatewayv2.Model
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Model); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Model); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Model)
@@ -141,8 +143,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 string) ([]*apigatewa
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiModels(_a0 string) ([]*apigatewa
 	return r0, r1
 }
 
-// ListAllApiRouteResponses provides a mock function with given fields: _a0, _a1
-func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 string, _a1 string) ([]*apigatewayv2.RouteResponse, error) {
-	ret := _m.Called(_a0, _a1)
+// ListAllApiRouteResponses provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 context.Context, _a1 string, _a2 string) ([]*apigatewayv2.RouteResponse, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*apigatewayv2.RouteResponse
-	if rf, ok := ret.Get(0).(func(string, string) []*apigatewayv2.RouteResponse); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*apigatewayv2.RouteResponse); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.RouteResponse)
@@ -164,8 +166,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 string, _a1 s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRouteResponses(_a0 string, _a1 s
 	return r0, r1
 }
 
-// ListAllApiRoutes provides a mock function with given fields: apiId
-func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(apiId *string) ([]*apigatewayv2.Route, error) {
-	ret := _m.Called(apiId)
+// ListAllApiRoutes provides a mock function with given fields: ctx, apiId
+func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(ctx context.Context, apiId *string) ([]*apigatewayv2.Route, error) {
+	ret := _m.Called(ctx, apiId)
 
 	var r0 []*apigatewayv2.Route
-	if rf, ok := ret.Get(0).(func(*string) []*apigatewayv2.Route); ok {
-		r0 = rf(apiId)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*apigatewayv2.Route); ok {
+		r0 = rf(ctx, apiId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Route)
@@ -187,8 +189,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(apiId *string) ([]*apigat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*string) error); ok {
-		r1 = rf(apiId)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, apiId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,13 +198,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiRoutes(apiId *string) ([]*apigat
 	return r0, r1
 }
 
-// ListAllApiStages provides a mock function with given fields: _a0
-func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 string) ([]*apigatewayv2.Stage, error) {
-	ret := _m.Called(_a0)
+// ListAllApiStages provides a mock function with given fields: _a0, _a1
+func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 context.Context, _a1 string) ([]*apigatewayv2.Stage, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*apigatewayv2.Stage
-	if rf, ok := ret.Get(0).(func(string) []*apigatewayv2.Stage); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*apigatewayv2.Stage); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Stage)
@@ -210,8 +212,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 string) ([]*apigatewa
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,13 +221,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApiStages(_a0 string) ([]*apigatewa
 	return r0, r1
 }
 
-// ListAllApis provides a mock function with given fields:
-func (_m *MockApiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error) {
-	ret := _m.Called()
+// ListAllApis provides a mock function with given fields: ctx
+func (_m *MockApiGatewayV2Repository) ListAllApis(ctx context.Context) ([]*apigatewayv2.Api, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigatewayv2.Api
-	if rf, ok := ret.Get(0).(func() []*apigatewayv2.Api); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigatewayv2.Api); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.Api)
@@ -233,8 +235,8 @@ func (_m *MockApiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,13 +244,13 @@ func (_m *MockApiGatewayV2Repository) ListAllApis() ([]*apigatewayv2.Api, error)
 	return r0, r1
 }
 
-// ListAllVpcLinks provides a mock function with given fields:
-func (_m *MockApiGatewayV2Repository) ListAllVpcLinks() ([]*apigatewayv2.VpcLink, error) {
-	ret := _m.Called()
+// ListAllVpcLinks provides a mock function with given fields: ctx
+func (_m *MockApiGatewayV2Repository) ListAllVpcLinks(ctx context.Context) ([]*apigatewayv2.VpcLink, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*apigatewayv2.VpcLink
-	if rf, ok := ret.Get(0).(func() []*apigatewayv2.VpcLink); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*apigatewayv2.VpcLink); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*apigatewayv2.VpcLink)
@@ -256,8 +258,8 @@ func (_m *MockApiGatewayV2Repository) ListAllVpcLinks() ([]*apigatewayv2.VpcLink
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
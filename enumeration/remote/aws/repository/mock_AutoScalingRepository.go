@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	autoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockAutoScalingRepository struct {
 	mock.Mock
 }
 
-// DescribeLaunchConfigurations provides a mock function with given fields:
-func (_m *MockAutoScalingRepository) DescribeLaunchConfigurations() ([]*autoscaling.LaunchConfiguration, error) {
-	ret := _m.Called()
+// DescribeLaunchConfigurations provides a mock function with given fields: ctx
+func (_m *MockAutoScalingRepository) DescribeLaunchConfigurations(ctx context.Context) ([]*autoscaling.LaunchConfiguration, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*autoscaling.LaunchConfiguration
-	if rf, ok := ret.Get(0).(func() []*autoscaling.LaunchConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*autoscaling.LaunchConfiguration); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*autoscaling.LaunchConfiguration)
@@ -26,8 +28,8 @@ func (_m *MockAutoScalingRepository) DescribeLaunchConfigurations() ([]*autoscal
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockCloudfrontRepository struct {
 	mock.Mock
 }
 
-// ListAllDistributions provides a mock function with given fields:
-func (_m *MockCloudfrontRepository) ListAllDistributions() ([]*cloudfront.DistributionSummary, error) {
-	ret := _m.Called()
+// ListAllDistributions provides a mock function with given fields: ctx
+func (_m *MockCloudfrontRepository) ListAllDistributions(ctx context.Context) ([]*cloudfront.DistributionSummary, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*cloudfront.DistributionSummary
-	if rf, ok := ret.Get(0).(func() []*cloudfront.DistributionSummary); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*cloudfront.DistributionSummary); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*cloudfront.DistributionSummary)
@@ -26,8 +28,8 @@ func (_m *MockCloudfrontRepository) ListAllDistributions() ([]*cloudfront.Distri
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
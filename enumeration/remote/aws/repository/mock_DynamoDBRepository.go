@@ -2,20 +2,23 @@
 
 package repository
 
-import "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	"github.com/stretchr/testify/mock"
+)
 
 // MockDynamoDBRepository is an autogenerated mock type for the MockDynamoDBRepository type
 type MockDynamoDBRepository struct {
 	mock.Mock
 }
 
-// ListAllTables provides a mock function with given fields:
-func (_m *MockDynamoDBRepository) ListAllTables() ([]*string, error) {
-	ret := _m.Called()
+// ListAllTables provides a mock function with given fields: ctx
+func (_m *MockDynamoDBRepository) ListAllTables(ctx context.Context) ([]*string, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*string
-	if rf, ok := ret.Get(0).(func() []*string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*string); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*string)
@@ -23,8 +26,8 @@ func (_m *MockDynamoDBRepository) ListAllTables() ([]*string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
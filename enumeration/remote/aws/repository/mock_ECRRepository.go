@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	ecr "github.com/aws/aws-sdk-go/service/ecr"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockECRRepository struct {
 	mock.Mock
 }
 
-// GetRepositoryPolicy provides a mock function with given fields: _a0
-func (_m *MockECRRepository) GetRepositoryPolicy(_a0 *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
-	ret := _m.Called(_a0)
+// GetRepositoryPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockECRRepository) GetRepositoryPolicy(_a0 context.Context, _a1 *ecr.Repository) (*ecr.GetRepositoryPolicyOutput, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *ecr.GetRepositoryPolicyOutput
-	if rf, ok := ret.Get(0).(func(*ecr.Repository) *ecr.GetRepositoryPolicyOutput); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *ecr.Repository) *ecr.GetRepositoryPolicyOutput); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ecr.GetRepositoryPolicyOutput)
@@ -26,8 +28,8 @@ func (_m *MockECRRepository) GetRepositoryPolicy(_a0 *ecr.Repository) (*ecr.GetR
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ecr.Repository) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *ecr.Repository) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockECRRepository) GetRepositoryPolicy(_a0 *ecr.Repository) (*ecr.GetR
 	return r0, r1
 }
 
-// ListAllRepositories provides a mock function with given fields:
-func (_m *MockECRRepository) ListAllRepositories() ([]*ecr.Repository, error) {
-	ret := _m.Called()
+// ListAllRepositories provides a mock function with given fields: ctx
+func (_m *MockECRRepository) ListAllRepositories(ctx context.Context) ([]*ecr.Repository, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*ecr.Repository
-	if rf, ok := ret.Get(0).(func() []*ecr.Repository); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*ecr.Repository); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*ecr.Repository)
@@ -49,8 +51,8 @@ func (_m *MockECRRepository) ListAllRepositories() ([]*ecr.Repository, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
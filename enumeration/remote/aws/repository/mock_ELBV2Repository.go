@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockELBV2Repository struct {
 	mock.Mock
 }
 
-// ListAllLoadBalancers provides a mock function with given fields:
-func (_m *MockELBV2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, error) {
-	ret := _m.Called()
+// ListAllLoadBalancers provides a mock function with given fields: ctx
+func (_m *MockELBV2Repository) ListAllLoadBalancers(ctx context.Context) ([]*elbv2.LoadBalancer, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*elbv2.LoadBalancer
-	if rf, ok := ret.Get(0).(func() []*elbv2.LoadBalancer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*elbv2.LoadBalancer); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elbv2.LoadBalancer)
@@ -26,8 +28,8 @@ func (_m *MockELBV2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, er
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockELBV2Repository) ListAllLoadBalancers() ([]*elbv2.LoadBalancer, er
 	return r0, r1
 }
 
-// ListLoadBalancerListeners provides a mock function with given fields: _a0
-func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 string) ([]*elbv2.Listener, error) {
-	ret := _m.Called(_a0)
+// ListLoadBalancerListeners provides a mock function with given fields: _a0, _a1
+func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 context.Context, _a1 string) ([]*elbv2.Listener, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*elbv2.Listener
-	if rf, ok := ret.Get(0).(func(string) []*elbv2.Listener); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*elbv2.Listener); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elbv2.Listener)
@@ -49,8 +51,8 @@ func (_m *MockELBV2Repository) ListAllLoadBalancerListeners(_a0 string) ([]*elbv
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
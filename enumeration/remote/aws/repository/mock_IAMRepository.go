@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	iam "github.com/aws/aws-sdk-go/service/iam"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockIAMRepository struct {
 	mock.Mock
 }
 
-// ListAllAccessKeys provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
-	ret := _m.Called(_a0)
+// ListAllAccessKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllAccessKeys(_a0 context.Context, _a1 []*iam.User) ([]*iam.AccessKeyMetadata, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*iam.AccessKeyMetadata
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*iam.AccessKeyMetadata); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*iam.AccessKeyMetadata); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.AccessKeyMetadata)
@@ -26,8 +28,8 @@ func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockIAMRepository) ListAllAccessKeys(_a0 []*iam.User) ([]*iam.AccessKe
 	return r0, r1
 }
 
-// ListAllGroupPolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 []*iam.Group) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllGroupPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 context.Context, _a1 []*iam.Group) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func([]*iam.Group) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) []string); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -49,8 +51,8 @@ func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 []*iam.Group) ([]string, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Group) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Group) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockIAMRepository) ListAllGroupPolicies(_a0 []*iam.Group) ([]string, e
 	return r0, r1
 }
 
-// ListAllGroupPolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 []*iam.Group) ([]*AttachedGroupPolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllGroupPolicyAttachments provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 context.Context, _a1 []*iam.Group) ([]*AttachedGroupPolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*AttachedGroupPolicy
-	if rf, ok := ret.Get(0).(func([]*iam.Group) []*AttachedGroupPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Group) []*AttachedGroupPolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedGroupPolicy)
@@ -72,8 +74,8 @@ func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 []*iam.Group) ([]
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Group) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Group) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockIAMRepository) ListAllGroupPolicyAttachments(_a0 []*iam.Group) ([]
 	return r0, r1
 }
 
-// ListAllGroups provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllGroups() ([]*iam.Group, error) {
-	ret := _m.Called()
+// ListAllGroups provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllGroups(ctx context.Context) ([]*iam.Group, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Group
-	if rf, ok := ret.Get(0).(func() []*iam.Group); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Group); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Group)
@@ -95,8 +97,8 @@ func (_m *MockIAMRepository) ListAllGroups() ([]*iam.Group, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockIAMRepository) ListAllGroups() ([]*iam.Group, error) {
 	return r0, r1
 }
 
-// ListAllPolicies provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
-	ret := _m.Called()
+// ListAllPolicies provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllPolicies(ctx context.Context) ([]*iam.Policy, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Policy
-	if rf, ok := ret.Get(0).(func() []*iam.Policy); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Policy); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Policy)
@@ -118,8 +120,8 @@ func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockIAMRepository) ListAllPolicies() ([]*iam.Policy, error) {
 	return r0, r1
 }
 
-// ListAllRolePolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]RolePolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllRolePolicies(_a0 context.Context, _a1 []*iam.Role) ([]RolePolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []RolePolicy
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []RolePolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []RolePolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]RolePolicy)
@@ -141,8 +143,8 @@ func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]RolePolicy,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockIAMRepository) ListAllRolePolicies(_a0 []*iam.Role) ([]RolePolicy,
 	return r0, r1
 }
 
-// ListAllRolePolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*AttachedRolePolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllRolePolicyAttachments provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 context.Context, _a1 []*iam.Role) ([]*AttachedRolePolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*AttachedRolePolicy
-	if rf, ok := ret.Get(0).(func([]*iam.Role) []*AttachedRolePolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.Role) []*AttachedRolePolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedRolePolicy)
@@ -164,8 +166,8 @@ func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*A
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.Role) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.Role) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockIAMRepository) ListAllRolePolicyAttachments(_a0 []*iam.Role) ([]*A
 	return r0, r1
 }
 
-// ListAllRoles provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
-	ret := _m.Called()
+// ListAllRoles provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllRoles(ctx context.Context) ([]*iam.Role, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.Role
-	if rf, ok := ret.Get(0).(func() []*iam.Role); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.Role); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.Role)
@@ -187,8 +189,8 @@ func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,13 +198,13 @@ func (_m *MockIAMRepository) ListAllRoles() ([]*iam.Role, error) {
 	return r0, r1
 }
 
-// ListAllUserPolicies provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllUserPolicies(_a0 context.Context, _a1 []*iam.User) ([]string, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func([]*iam.User) []string); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []string); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -210,8 +212,8 @@ func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,13 +221,13 @@ func (_m *MockIAMRepository) ListAllUserPolicies(_a0 []*iam.User) ([]string, err
 	return r0, r1
 }
 
-// ListAllUserPolicyAttachments provides a mock function with given fields: _a0
-func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*AttachedUserPolicy, error) {
-	ret := _m.Called(_a0)
+// ListAllUserPolicyAttachments provides a mock function with given fields: _a0, _a1
+func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 context.Context, _a1 []*iam.User) ([]*AttachedUserPolicy, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*AttachedUserPolicy
-	if rf, ok := ret.Get(0).(func([]*iam.User) []*AttachedUserPolicy); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, []*iam.User) []*AttachedUserPolicy); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*AttachedUserPolicy)
@@ -233,8 +235,8 @@ func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*A
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]*iam.User) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, []*iam.User) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,13 +244,13 @@ func (_m *MockIAMRepository) ListAllUserPolicyAttachments(_a0 []*iam.User) ([]*A
 	return r0, r1
 }
 
-// ListAllUsers provides a mock function with given fields:
-func (_m *MockIAMRepository) ListAllUsers() ([]*iam.User, error) {
-	ret := _m.Called()
+// ListAllUsers provides a mock function with given fields: ctx
+func (_m *MockIAMRepository) ListAllUsers(ctx context.Context) ([]*iam.User, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*iam.User
-	if rf, ok := ret.Get(0).(func() []*iam.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*iam.User); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*iam.User)
@@ -256,8 +258,8 @@ func (_m *MockIAMRepository) ListAllUsers() ([]*iam.User, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
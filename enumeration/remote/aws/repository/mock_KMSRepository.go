@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	kms "github.com/aws/aws-sdk-go/service/kms"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockKMSRepository struct {
 	mock.Mock
 }
 
-// ListAllAliases provides a mock function with given fields:
-func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
-	ret := _m.Called()
+// ListAllAliases provides a mock function with given fields: ctx
+func (_m *MockKMSRepository) ListAllAliases(ctx context.Context) ([]*kms.AliasListEntry, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*kms.AliasListEntry
-	if rf, ok := ret.Get(0).(func() []*kms.AliasListEntry); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*kms.AliasListEntry); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*kms.AliasListEntry)
@@ -26,8 +28,8 @@ func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockKMSRepository) ListAllAliases() ([]*kms.AliasListEntry, error) {
 	return r0, r1
 }
 
-// ListAllKeys provides a mock function with given fields:
-func (_m *MockKMSRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
-	ret := _m.Called()
+// ListAllKeys provides a mock function with given fields: ctx
+func (_m *MockKMSRepository) ListAllKeys(ctx context.Context) ([]*kms.KeyListEntry, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*kms.KeyListEntry
-	if rf, ok := ret.Get(0).(func() []*kms.KeyListEntry); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*kms.KeyListEntry); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*kms.KeyListEntry)
@@ -49,8 +51,8 @@ func (_m *MockKMSRepository) ListAllKeys() ([]*kms.KeyListEntry, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	lambda "github.com/aws/aws-sdk-go/service/lambda"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,59 @@ type MockLambdaRepository struct {
 	mock.Mock
 }
 
-// ListAllLambdaEventSourceMappings provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.EventSourceMappingConfiguration, error) {
-	ret := _m.Called()
+// GetLambdaFunctionPolicy provides a mock function with given fields: ctx, functionName
+func (_m *MockLambdaRepository) GetLambdaFunctionPolicy(ctx context.Context, functionName string) (*string, error) {
+	ret := _m.Called(ctx, functionName)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
+		r0 = rf(ctx, functionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, functionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllLambdaAliases provides a mock function with given fields: ctx, functionName
+func (_m *MockLambdaRepository) ListAllLambdaAliases(ctx context.Context, functionName string) ([]*lambda.AliasConfiguration, error) {
+	ret := _m.Called(ctx, functionName)
+
+	var r0 []*lambda.AliasConfiguration
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*lambda.AliasConfiguration); ok {
+		r0 = rf(ctx, functionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*lambda.AliasConfiguration)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, functionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllLambdaEventSourceMappings provides a mock function with given fields: ctx
+func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings(ctx context.Context) ([]*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*lambda.EventSourceMappingConfiguration
-	if rf, ok := ret.Get(0).(func() []*lambda.EventSourceMappingConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.EventSourceMappingConfiguration); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.EventSourceMappingConfiguration)
@@ -26,8 +74,8 @@ func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.Ev
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +83,13 @@ func (_m *MockLambdaRepository) ListAllLambdaEventSourceMappings() ([]*lambda.Ev
 	return r0, r1
 }
 
-// ListAllLambdaFunctions provides a mock function with given fields:
-func (_m *MockLambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConfiguration, error) {
-	ret := _m.Called()
+// ListAllLambdaFunctions provides a mock function with given fields: ctx
+func (_m *MockLambdaRepository) ListAllLambdaFunctions(ctx context.Context) ([]*lambda.FunctionConfiguration, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*lambda.FunctionConfiguration
-	if rf, ok := ret.Get(0).(func() []*lambda.FunctionConfiguration); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*lambda.FunctionConfiguration); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lambda.FunctionConfiguration)
@@ -49,8 +97,8 @@ func (_m *MockLambdaRepository) ListAllLambdaFunctions() ([]*lambda.FunctionConf
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	sqs "github.com/aws/aws-sdk-go/service/sqs"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockSQSRepository struct {
 	mock.Mock
 }
 
-// GetQueueAttributes provides a mock function with given fields: url
-func (_m *MockSQSRepository) GetQueueAttributes(url string) (*sqs.GetQueueAttributesOutput, error) {
-	ret := _m.Called(url)
+// GetQueueAttributes provides a mock function with given fields: ctx, url
+func (_m *MockSQSRepository) GetQueueAttributes(ctx context.Context, url string) (*sqs.GetQueueAttributesOutput, error) {
+	ret := _m.Called(ctx, url)
 
 	var r0 *sqs.GetQueueAttributesOutput
-	if rf, ok := ret.Get(0).(func(string) *sqs.GetQueueAttributesOutput); ok {
-		r0 = rf(url)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *sqs.GetQueueAttributesOutput); ok {
+		r0 = rf(ctx, url)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*sqs.GetQueueAttributesOutput)
@@ -26,8 +28,8 @@ func (_m *MockSQSRepository) GetQueueAttributes(url string) (*sqs.GetQueueAttrib
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(url)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, url)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockSQSRepository) GetQueueAttributes(url string) (*sqs.GetQueueAttrib
 	return r0, r1
 }
 
-// ListAllQueues provides a mock function with given fields:
-func (_m *MockSQSRepository) ListAllQueues() ([]*string, error) {
-	ret := _m.Called()
+// ListAllQueues provides a mock function with given fields: ctx
+func (_m *MockSQSRepository) ListAllQueues(ctx context.Context) ([]*string, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*string
-	if rf, ok := ret.Get(0).(func() []*string); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*string); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*string)
@@ -49,8 +51,8 @@ func (_m *MockSQSRepository) ListAllQueues() ([]*string, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type MSKRepository interface {
+	ListAllClusters(ctx context.Context) ([]*kafka.ClusterInfo, error)
+}
+
+type mskRepository struct {
+	client kafkaiface.KafkaAPI
+	cache  cache.Cache
+}
+
+func NewMSKRepository(session *session.Session, c cache.Cache) *mskRepository {
+	return &mskRepository{
+		kafka.New(session),
+		c,
+	}
+}
+
+func (r *mskRepository) ListAllClusters(ctx context.Context) ([]*kafka.ClusterInfo, error) {
+	if v := r.cache.Get("mskListAllClusters"); v != nil {
+		return v.([]*kafka.ClusterInfo), nil
+	}
+
+	var clusters []*kafka.ClusterInfo
+	input := kafka.ListClustersInput{}
+	err := r.client.ListClustersPagesWithContext(ctx, &input,
+		func(resp *kafka.ListClustersOutput, lastPage bool) bool {
+			clusters = append(clusters, resp.ClusterInfoList...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("mskListAllClusters", clusters)
+	return clusters, nil
+}
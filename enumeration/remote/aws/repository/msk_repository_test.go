@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_mskRepository_ListAllClusters(t *testing.T) {
+	items := []*kafka.ClusterInfo{
+		{ClusterName: aws.String("cluster-1")},
+		{ClusterName: aws.String("cluster-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeKafka, store *cache.MockCache)
+		want    []*kafka.ClusterInfo
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeKafka, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&kafka.ListClustersInput{},
+					mock.MatchedBy(func(callback func(res *kafka.ListClustersOutput, lastPage bool) bool) bool {
+						callback(&kafka.ListClustersOutput{ClusterInfoList: items[:1]}, false)
+						callback(&kafka.ListClustersOutput{ClusterInfoList: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "mskListAllClusters").Return(nil).Times(1)
+				store.On("Put", "mskListAllClusters", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeKafka, store *cache.MockCache) {
+				store.On("Get", "mskListAllClusters").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeKafka, store *cache.MockCache) {
+				client.On("ListClustersPagesWithContext", mock.Anything,
+					&kafka.ListClustersInput{},
+					mock.AnythingOfType("func(*kafka.ListClustersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "mskListAllClusters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeKafka{}
+			tt.mocks(client, store)
+			r := &mskRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type RedshiftRepository interface {
+	ListAllClusters(ctx context.Context) ([]*redshift.Cluster, error)
+	ListAllSubnetGroups(ctx context.Context) ([]*redshift.ClusterSubnetGroup, error)
+	ListAllParameterGroups(ctx context.Context) ([]*redshift.ClusterParameterGroup, error)
+}
+
+type redshiftRepository struct {
+	client redshiftiface.RedshiftAPI
+	cache  cache.Cache
+}
+
+func NewRedshiftRepository(session *session.Session, c cache.Cache) *redshiftRepository {
+	return &redshiftRepository{
+		redshift.New(session),
+		c,
+	}
+}
+
+func (r *redshiftRepository) ListAllClusters(ctx context.Context) ([]*redshift.Cluster, error) {
+	if v := r.cache.Get("redshiftListAllClusters"); v != nil {
+		return v.([]*redshift.Cluster), nil
+	}
+
+	var clusters []*redshift.Cluster
+	input := redshift.DescribeClustersInput{}
+	err := r.client.DescribeClustersPagesWithContext(ctx, &input,
+		func(resp *redshift.DescribeClustersOutput, lastPage bool) bool {
+			clusters = append(clusters, resp.Clusters...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("redshiftListAllClusters", clusters)
+	return clusters, nil
+}
+
+func (r *redshiftRepository) ListAllSubnetGroups(ctx context.Context) ([]*redshift.ClusterSubnetGroup, error) {
+	if v := r.cache.Get("redshiftListAllSubnetGroups"); v != nil {
+		return v.([]*redshift.ClusterSubnetGroup), nil
+	}
+
+	var subnetGroups []*redshift.ClusterSubnetGroup
+	input := redshift.DescribeClusterSubnetGroupsInput{}
+	err := r.client.DescribeClusterSubnetGroupsPagesWithContext(ctx, &input,
+		func(resp *redshift.DescribeClusterSubnetGroupsOutput, lastPage bool) bool {
+			subnetGroups = append(subnetGroups, resp.ClusterSubnetGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("redshiftListAllSubnetGroups", subnetGroups)
+	return subnetGroups, nil
+}
+
+func (r *redshiftRepository) ListAllParameterGroups(ctx context.Context) ([]*redshift.ClusterParameterGroup, error) {
+	if v := r.cache.Get("redshiftListAllParameterGroups"); v != nil {
+		return v.([]*redshift.ClusterParameterGroup), nil
+	}
+
+	var parameterGroups []*redshift.ClusterParameterGroup
+	input := redshift.DescribeClusterParameterGroupsInput{}
+	err := r.client.DescribeClusterParameterGroupsPagesWithContext(ctx, &input,
+		func(resp *redshift.DescribeClusterParameterGroupsOutput, lastPage bool) bool {
+			parameterGroups = append(parameterGroups, resp.ParameterGroups...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("redshiftListAllParameterGroups", parameterGroups)
+	return parameterGroups, nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_redshiftRepository_ListAllClusters(t *testing.T) {
+	items := []*redshift.Cluster{
+		{ClusterIdentifier: aws.String("redshift-cluster-1")},
+		{ClusterIdentifier: aws.String("redshift-cluster-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeRedshift, store *cache.MockCache)
+		want    []*redshift.Cluster
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClustersPagesWithContext", mock.Anything,
+					&redshift.DescribeClustersInput{},
+					mock.MatchedBy(func(callback func(res *redshift.DescribeClustersOutput, lastPage bool) bool) bool {
+						callback(&redshift.DescribeClustersOutput{Clusters: items[:1]}, false)
+						callback(&redshift.DescribeClustersOutput{Clusters: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "redshiftListAllClusters").Return(nil).Times(1)
+				store.On("Put", "redshiftListAllClusters", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				store.On("Get", "redshiftListAllClusters").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClustersPagesWithContext", mock.Anything,
+					&redshift.DescribeClustersInput{},
+					mock.AnythingOfType("func(*redshift.DescribeClustersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "redshiftListAllClusters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeRedshift{}
+			tt.mocks(client, store)
+			r := &redshiftRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllClusters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_redshiftRepository_ListAllSubnetGroups(t *testing.T) {
+	items := []*redshift.ClusterSubnetGroup{
+		{ClusterSubnetGroupName: aws.String("subnet-group-1")},
+		{ClusterSubnetGroupName: aws.String("subnet-group-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeRedshift, store *cache.MockCache)
+		want    []*redshift.ClusterSubnetGroup
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClusterSubnetGroupsPagesWithContext", mock.Anything,
+					&redshift.DescribeClusterSubnetGroupsInput{},
+					mock.MatchedBy(func(callback func(res *redshift.DescribeClusterSubnetGroupsOutput, lastPage bool) bool) bool {
+						callback(&redshift.DescribeClusterSubnetGroupsOutput{ClusterSubnetGroups: items[:1]}, false)
+						callback(&redshift.DescribeClusterSubnetGroupsOutput{ClusterSubnetGroups: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "redshiftListAllSubnetGroups").Return(nil).Times(1)
+				store.On("Put", "redshiftListAllSubnetGroups", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				store.On("Get", "redshiftListAllSubnetGroups").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClusterSubnetGroupsPagesWithContext", mock.Anything,
+					&redshift.DescribeClusterSubnetGroupsInput{},
+					mock.AnythingOfType("func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "redshiftListAllSubnetGroups").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeRedshift{}
+			tt.mocks(client, store)
+			r := &redshiftRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllSubnetGroups(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_redshiftRepository_ListAllParameterGroups(t *testing.T) {
+	items := []*redshift.ClusterParameterGroup{
+		{ParameterGroupName: aws.String("parameter-group-1")},
+		{ParameterGroupName: aws.String("parameter-group-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeRedshift, store *cache.MockCache)
+		want    []*redshift.ClusterParameterGroup
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClusterParameterGroupsPagesWithContext", mock.Anything,
+					&redshift.DescribeClusterParameterGroupsInput{},
+					mock.MatchedBy(func(callback func(res *redshift.DescribeClusterParameterGroupsOutput, lastPage bool) bool) bool {
+						callback(&redshift.DescribeClusterParameterGroupsOutput{ParameterGroups: items[:1]}, false)
+						callback(&redshift.DescribeClusterParameterGroupsOutput{ParameterGroups: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "redshiftListAllParameterGroups").Return(nil).Times(1)
+				store.On("Put", "redshiftListAllParameterGroups", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				store.On("Get", "redshiftListAllParameterGroups").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeRedshift, store *cache.MockCache) {
+				client.On("DescribeClusterParameterGroupsPagesWithContext", mock.Anything,
+					&redshift.DescribeClusterParameterGroupsInput{},
+					mock.AnythingOfType("func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "redshiftListAllParameterGroups").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeRedshift{}
+			tt.mocks(client, store)
+			r := &redshiftRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllParameterGroups(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"github.com/snyk/driftctl/enumeration/remote/aws/client"
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -15,6 +16,7 @@ import (
 	"github.com/r3labs/diff/v2"
 	awstest "github.com/snyk/driftctl/test/aws"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_s3Repository_ListAllBuckets(t *testing.T) {
@@ -28,7 +30,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "List buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					&s3.ListBucketsOutput{
 						Buckets: []*s3.Bucket{
 							{Name: aws.String("bucket1")},
@@ -48,7 +50,7 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 		{
 			name: "Error listing buckets",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("ListBuckets", &s3.ListBucketsInput{}).Return(
+				client.On("ListBucketsWithContext", mock.Anything, &s3.ListBucketsInput{}).Return(
 					nil,
 					awserr.NewRequestFailure(nil, 403, ""),
 				).Once()
@@ -65,13 +67,13 @@ func Test_s3Repository_ListAllBuckets(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListAllBuckets()
+			got, err := r.ListAllBuckets(context.TODO())
 			factory.AssertExpectations(t)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListAllBuckets()
+				cachedData, err := r.ListAllBuckets(context.TODO())
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.Bucket{}, store.Get("s3ListAllBuckets"))
@@ -103,7 +105,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{},
@@ -117,7 +119,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -143,7 +145,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -169,7 +171,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.NotificationConfiguration{
@@ -195,7 +197,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketNotificationConfiguration", &s3.GetBucketNotificationConfigurationRequest{
+				client.On("GetBucketNotificationConfigurationWithContext", mock.Anything, &s3.GetBucketNotificationConfigurationRequest{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -213,7 +215,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: &tt.region}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketNotification(tt.bucketName, tt.region)
+			got, err := r.GetBucketNotification(context.TODO(), tt.bucketName, tt.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -224,7 +226,7 @@ func Test_s3Repository_GetBucketNotification(t *testing.T) {
 
 			if err == nil && tt.want != nil {
 				// Check that results were cached
-				cachedData, err := r.GetBucketNotification(tt.bucketName, tt.region)
+				cachedData, err := r.GetBucketNotification(context.TODO(), tt.bucketName, tt.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, &s3.NotificationConfiguration{}, store.Get(fmt.Sprintf("s3GetBucketNotification_%s_%s", tt.bucketName, tt.region)))
@@ -256,7 +258,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{},
@@ -270,7 +272,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{
@@ -286,7 +288,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketPolicyOutput{
@@ -302,7 +304,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -316,7 +318,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			bucketName: "test-bucket",
 			region:     "us-east-1",
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketPolicy", &s3.GetBucketPolicyInput{
+				client.On("GetBucketPolicyWithContext", mock.Anything, &s3.GetBucketPolicyInput{
 					Bucket: aws.String("test-bucket"),
 				}).Return(
 					nil,
@@ -334,7 +336,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: &tt.region}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketPolicy(tt.bucketName, tt.region)
+			got, err := r.GetBucketPolicy(context.TODO(), tt.bucketName, tt.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -345,7 +347,7 @@ func Test_s3Repository_GetBucketPolicy(t *testing.T) {
 
 			if err == nil && tt.want != nil {
 				// Check that results were cached
-				cachedData, err := r.GetBucketPolicy(tt.bucketName, tt.region)
+				cachedData, err := r.GetBucketPolicy(context.TODO(), tt.bucketName, tt.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, awssdk.String(""), store.Get(fmt.Sprintf("s3GetBucketPolicy_%s_%s", tt.bucketName, tt.region)))
@@ -387,7 +389,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -405,7 +407,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -444,7 +446,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketInventoryConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -465,7 +467,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketInventoryConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -476,7 +478,7 @@ func Test_s3Repository_ListBucketInventoryConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketInventoryConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketInventoryConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.InventoryConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketInventoryConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -518,7 +520,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -536,7 +538,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -575,7 +577,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketMetricsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -596,7 +598,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketMetricsConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -607,7 +609,7 @@ func Test_s3Repository_ListBucketMetricsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketMetricsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketMetricsConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.MetricsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketMetricsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -649,7 +651,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: nil,
@@ -667,7 +669,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 					nil,
 				).Once()
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket:            awssdk.String("test-bucket"),
 						ContinuationToken: awssdk.String("nexttoken"),
@@ -706,7 +708,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			},
 			mocks: func(client *awstest.MockFakeS3) {
 				client.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurationsWithContext", mock.Anything,
 					&s3.ListBucketAnalyticsConfigurationsInput{
 						Bucket: awssdk.String("test-bucket"),
 					},
@@ -727,7 +729,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", &aws.Config{Region: awssdk.String(tt.input.region)}).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+			got, err := r.ListBucketAnalyticsConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -738,7 +740,7 @@ func Test_s3Repository_ListBucketAnalyticsConfigurations(t *testing.T) {
 
 			if err == nil {
 				// Check that results were cached
-				cachedData, err := r.ListBucketAnalyticsConfigurations(&tt.input.bucket, tt.input.region)
+				cachedData, err := r.ListBucketAnalyticsConfigurations(context.TODO(), &tt.input.bucket, tt.input.region)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, []*s3.AnalyticsConfiguration{}, store.Get(fmt.Sprintf("s3ListBucketAnalyticsConfigurations_%s_%s", *tt.input.bucket.Name, tt.input.region)))
@@ -771,7 +773,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{
@@ -788,7 +790,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					&s3.GetBucketLocationOutput{},
@@ -803,7 +805,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -818,7 +820,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 				Name: awssdk.String("test-bucket"),
 			},
 			mocks: func(client *awstest.MockFakeS3) {
-				client.On("GetBucketLocation", &s3.GetBucketLocationInput{
+				client.On("GetBucketLocationWithContext", mock.Anything, &s3.GetBucketLocationInput{
 					Bucket: awssdk.String("test-bucket"),
 				}).Return(
 					nil,
@@ -836,7 +838,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 			factory := client.MockAwsClientFactoryInterface{}
 			factory.On("GetS3Client", (*aws.Config)(nil)).Return(mockedClient).Once()
 			r := NewS3Repository(&factory, store)
-			got, err := r.GetBucketLocation(*tt.bucket.Name)
+			got, err := r.GetBucketLocation(context.TODO(), *tt.bucket.Name)
 			factory.AssertExpectations(t)
 			if err != nil && tt.wantErr == "" {
 				t.Fatalf("Unexpected error %+v", err)
@@ -847,7 +849,7 @@ func Test_s3Repository_GetBucketLocation(t *testing.T) {
 
 			if err == nil && tt.want != "" {
 				// Check that results were cached
-				cachedData, err := r.GetBucketLocation(*tt.bucket.Name)
+				cachedData, err := r.GetBucketLocation(context.TODO(), *tt.bucket.Name)
 				assert.NoError(t, err)
 				assert.Equal(t, got, cachedData)
 				assert.IsType(t, "", store.Get(fmt.Sprintf("s3GetBucketLocation_%s", *tt.bucket.Name)))
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -9,7 +11,7 @@ import (
 )
 
 type S3ControlRepository interface {
-	DescribeAccountPublicAccessBlock(accountID string) (*s3control.PublicAccessBlockConfiguration, error)
+	DescribeAccountPublicAccessBlock(ctx context.Context, accountID string) (*s3control.PublicAccessBlockConfiguration, error)
 }
 
 type s3ControlRepository struct {
@@ -24,12 +26,12 @@ func NewS3ControlRepository(factory client.AwsClientFactoryInterface, c cache.Ca
 	}
 }
 
-func (s *s3ControlRepository) DescribeAccountPublicAccessBlock(accountID string) (*s3control.PublicAccessBlockConfiguration, error) {
+func (s *s3ControlRepository) DescribeAccountPublicAccessBlock(ctx context.Context, accountID string) (*s3control.PublicAccessBlockConfiguration, error) {
 	cacheKey := "S3DescribeAccountPublicAccessBlock"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.(*s3control.PublicAccessBlockConfiguration), nil
 	}
-	out, err := s.clientFactory.GetS3ControlClient(nil).GetPublicAccessBlock(&s3control.GetPublicAccessBlockInput{
+	out, err := s.clientFactory.GetS3ControlClient(nil).GetPublicAccessBlockWithContext(ctx, &s3control.GetPublicAccessBlockInput{
 		AccountId: aws.String(accountID),
 	})
 
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// SecretsManagerRepository only exposes secrets metadata and policies, secret values are never read
+type SecretsManagerRepository interface {
+	ListAllSecrets(ctx context.Context) ([]*secretsmanager.SecretListEntry, error)
+	GetSecretPolicy(ctx context.Context, secretArn string) (*string, error)
+}
+
+type secretsManagerRepository struct {
+	client secretsmanageriface.SecretsManagerAPI
+	cache  cache.Cache
+}
+
+func NewSecretsManagerRepository(session *session.Session, c cache.Cache) *secretsManagerRepository {
+	return &secretsManagerRepository{
+		secretsmanager.New(session),
+		c,
+	}
+}
+
+func (r *secretsManagerRepository) ListAllSecrets(ctx context.Context) ([]*secretsmanager.SecretListEntry, error) {
+	cacheKey := "secretsmanagerListAllSecrets"
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*secretsmanager.SecretListEntry), nil
+	}
+
+	var secrets []*secretsmanager.SecretListEntry
+	input := &secretsmanager.ListSecretsInput{}
+	err := r.client.ListSecretsPagesWithContext(ctx, input, func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+		secrets = append(secrets, res.SecretList...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, secrets)
+	return secrets, nil
+}
+
+// GetSecretPolicy returns the resource policy attached to a secret, or nil when the secret has no policy
+func (r *secretsManagerRepository) GetSecretPolicy(ctx context.Context, secretArn string) (*string, error) {
+	cacheKey := fmt.Sprintf("secretsmanagerGetSecretPolicy_%s", secretArn)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.(*string), nil
+	}
+
+	output, err := r.client.GetResourcePolicyWithContext(ctx, &secretsmanager.GetResourcePolicyInput{
+		SecretId: &secretArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, output.ResourcePolicy)
+	return output.ResourcePolicy, nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_secretsManagerRepository_ListAllSecrets(t *testing.T) {
+	secrets := []*secretsmanager.SecretListEntry{
+		{ARN: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:database-AbCdEf"), Name: aws.String("database")},
+		{ARN: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:api-key-GhIjKl"), Name: aws.String("api-key"), RotationEnabled: aws.Bool(true)},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager, store *cache.MockCache)
+		want    []*secretsmanager.SecretListEntry
+		wantErr error
+	}{
+		{
+			name: "list secrets",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				client.On("ListSecretsPagesWithContext", mock.Anything,
+					&secretsmanager.ListSecretsInput{},
+					mock.MatchedBy(func(callback func(res *secretsmanager.ListSecretsOutput, lastPage bool) bool) bool {
+						callback(&secretsmanager.ListSecretsOutput{SecretList: secrets[:1]}, false)
+						callback(&secretsmanager.ListSecretsOutput{SecretList: secrets[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("GetAndLock", "secretsmanagerListAllSecrets").Return(nil).Times(1)
+				store.On("Unlock", "secretsmanagerListAllSecrets").Times(1)
+				store.On("Put", "secretsmanagerListAllSecrets", secrets).Return(false).Times(1)
+			},
+			want: secrets,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				store.On("GetAndLock", "secretsmanagerListAllSecrets").Return(secrets).Times(1)
+				store.On("Unlock", "secretsmanagerListAllSecrets").Times(1)
+			},
+			want: secrets,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				client.On("ListSecretsPagesWithContext", mock.Anything,
+					&secretsmanager.ListSecretsInput{},
+					mock.AnythingOfType("func(*secretsmanager.ListSecretsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("GetAndLock", "secretsmanagerListAllSecrets").Return(nil).Times(1)
+				store.On("Unlock", "secretsmanagerListAllSecrets").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSecretsManager{}
+			tt.mocks(client, store)
+			r := &secretsManagerRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllSecrets(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_secretsManagerRepository_GetSecretPolicy(t *testing.T) {
+	secretArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:database-AbCdEf"
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"secretsmanager:DeleteSecret","Resource":"*"}]}`
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSecretsManager, store *cache.MockCache)
+		want    *string
+		wantErr error
+	}{
+		{
+			name: "get secret policy",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				client.On("GetResourcePolicyWithContext", mock.Anything, &secretsmanager.GetResourcePolicyInput{
+					SecretId: aws.String(secretArn),
+				}).Return(&secretsmanager.GetResourcePolicyOutput{
+					ARN:            aws.String(secretArn),
+					ResourcePolicy: aws.String(policy),
+				}, nil).Once()
+				store.On("Get", "secretsmanagerGetSecretPolicy_"+secretArn).Return(nil).Times(1)
+				store.On("Put", "secretsmanagerGetSecretPolicy_"+secretArn, aws.String(policy)).Return(false).Times(1)
+			},
+			want: aws.String(policy),
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				store.On("Get", "secretsmanagerGetSecretPolicy_"+secretArn).Return(aws.String(policy)).Times(1)
+			},
+			want: aws.String(policy),
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSecretsManager, store *cache.MockCache) {
+				client.On("GetResourcePolicyWithContext", mock.Anything, &secretsmanager.GetResourcePolicyInput{
+					SecretId: aws.String(secretArn),
+				}).Return(nil, remoteError).Once()
+				store.On("Get", "secretsmanagerGetSecretPolicy_"+secretArn).Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSecretsManager{}
+			tt.mocks(client, store)
+			r := &secretsManagerRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.GetSecretPolicy(context.TODO(), secretArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/ses/sesiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type SESRepository interface {
+	ListAllDomainIdentities(ctx context.Context) ([]*string, error)
+	ListAllEmailIdentities(ctx context.Context) ([]*string, error)
+}
+
+type sesRepository struct {
+	client sesiface.SESAPI
+	cache  cache.Cache
+}
+
+func NewSESRepository(session *session.Session, c cache.Cache) *sesRepository {
+	return &sesRepository{
+		ses.New(session),
+		c,
+	}
+}
+
+func (r *sesRepository) ListAllDomainIdentities(ctx context.Context) ([]*string, error) {
+	return r.listAllIdentities(ctx, ses.IdentityTypeDomain)
+}
+
+func (r *sesRepository) ListAllEmailIdentities(ctx context.Context) ([]*string, error) {
+	return r.listAllIdentities(ctx, ses.IdentityTypeEmailAddress)
+}
+
+func (r *sesRepository) listAllIdentities(ctx context.Context, identityType string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("sesListAllIdentities_%s", identityType)
+	if v := r.cache.Get(cacheKey); v != nil {
+		return v.([]*string), nil
+	}
+
+	var identities []*string
+	input := ses.ListIdentitiesInput{
+		IdentityType: aws.String(identityType),
+	}
+	err := r.client.ListIdentitiesPagesWithContext(ctx, &input,
+		func(resp *ses.ListIdentitiesOutput, lastPage bool) bool {
+			identities = append(identities, resp.Identities...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put(cacheKey, identities)
+	return identities, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_sesRepository_ListAllDomainIdentities(t *testing.T) {
+	items := []*string{
+		aws.String("example.com"),
+		aws.String("example.org"),
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSES, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				client.On("ListIdentitiesPagesWithContext", mock.Anything,
+					&ses.ListIdentitiesInput{
+						IdentityType: aws.String(ses.IdentityTypeDomain),
+					},
+					mock.MatchedBy(func(callback func(res *ses.ListIdentitiesOutput, lastPage bool) bool) bool {
+						callback(&ses.ListIdentitiesOutput{Identities: items[:1]}, false)
+						callback(&ses.ListIdentitiesOutput{Identities: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "sesListAllIdentities_Domain").Return(nil).Times(1)
+				store.On("Put", "sesListAllIdentities_Domain", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				store.On("Get", "sesListAllIdentities_Domain").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				client.On("ListIdentitiesPagesWithContext", mock.Anything,
+					&ses.ListIdentitiesInput{
+						IdentityType: aws.String(ses.IdentityTypeDomain),
+					},
+					mock.AnythingOfType("func(*ses.ListIdentitiesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "sesListAllIdentities_Domain").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSES{}
+			tt.mocks(client, store)
+			r := &sesRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDomainIdentities(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_sesRepository_ListAllEmailIdentities(t *testing.T) {
+	items := []*string{
+		aws.String("noreply@example.com"),
+		aws.String("support@example.com"),
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSES, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				client.On("ListIdentitiesPagesWithContext", mock.Anything,
+					&ses.ListIdentitiesInput{
+						IdentityType: aws.String(ses.IdentityTypeEmailAddress),
+					},
+					mock.MatchedBy(func(callback func(res *ses.ListIdentitiesOutput, lastPage bool) bool) bool {
+						callback(&ses.ListIdentitiesOutput{Identities: items[:1]}, false)
+						callback(&ses.ListIdentitiesOutput{Identities: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "sesListAllIdentities_EmailAddress").Return(nil).Times(1)
+				store.On("Put", "sesListAllIdentities_EmailAddress", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				store.On("Get", "sesListAllIdentities_EmailAddress").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSES, store *cache.MockCache) {
+				client.On("ListIdentitiesPagesWithContext", mock.Anything,
+					&ses.ListIdentitiesInput{
+						IdentityType: aws.String(ses.IdentityTypeEmailAddress),
+					},
+					mock.AnythingOfType("func(*ses.ListIdentitiesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "sesListAllIdentities_EmailAddress").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSES{}
+			tt.mocks(client, store)
+			r := &sesRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllEmailIdentities(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
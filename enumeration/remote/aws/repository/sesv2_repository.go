@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/aws/aws-sdk-go/service/sesv2/sesv2iface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type SESV2Repository interface {
+	ListAllConfigurationSets(ctx context.Context) ([]*string, error)
+}
+
+type sesv2Repository struct {
+	client sesv2iface.SESV2API
+	cache  cache.Cache
+}
+
+func NewSESV2Repository(session *session.Session, c cache.Cache) *sesv2Repository {
+	return &sesv2Repository{
+		sesv2.New(session),
+		c,
+	}
+}
+
+func (r *sesv2Repository) ListAllConfigurationSets(ctx context.Context) ([]*string, error) {
+	if v := r.cache.Get("sesv2ListAllConfigurationSets"); v != nil {
+		return v.([]*string), nil
+	}
+
+	var configurationSets []*string
+	input := sesv2.ListConfigurationSetsInput{}
+	err := r.client.ListConfigurationSetsPagesWithContext(ctx, &input,
+		func(resp *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
+			configurationSets = append(configurationSets, resp.ConfigurationSets...)
+			return !lastPage
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("sesv2ListAllConfigurationSets", configurationSets)
+	return configurationSets, nil
+}
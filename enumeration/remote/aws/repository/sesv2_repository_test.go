@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_sesv2Repository_ListAllConfigurationSets(t *testing.T) {
+	items := []*string{
+		aws.String("transactional"),
+		aws.String("marketing"),
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSESV2, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeSESV2, store *cache.MockCache) {
+				client.On("ListConfigurationSetsPagesWithContext", mock.Anything,
+					&sesv2.ListConfigurationSetsInput{},
+					mock.MatchedBy(func(callback func(res *sesv2.ListConfigurationSetsOutput, lastPage bool) bool) bool {
+						callback(&sesv2.ListConfigurationSetsOutput{ConfigurationSets: items[:1]}, false)
+						callback(&sesv2.ListConfigurationSetsOutput{ConfigurationSets: items[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "sesv2ListAllConfigurationSets").Return(nil).Times(1)
+				store.On("Put", "sesv2ListAllConfigurationSets", items).Return(false).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSESV2, store *cache.MockCache) {
+				store.On("Get", "sesv2ListAllConfigurationSets").Return(items).Times(1)
+			},
+			want: items,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSESV2, store *cache.MockCache) {
+				client.On("ListConfigurationSetsPagesWithContext", mock.Anything,
+					&sesv2.ListConfigurationSetsInput{},
+					mock.AnythingOfType("func(*sesv2.ListConfigurationSetsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "sesv2ListAllConfigurationSets").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSESV2{}
+			tt.mocks(client, store)
+			r := &sesv2Repository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllConfigurationSets(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type SFNRepository interface {
+	ListAllStateMachines(ctx context.Context) ([]*sfn.StateMachineListItem, error)
+	ListAllActivities(ctx context.Context) ([]*sfn.ActivityListItem, error)
+}
+
+type sfnRepository struct {
+	client sfniface.SFNAPI
+	cache  cache.Cache
+}
+
+func NewSFNRepository(session *session.Session, c cache.Cache) *sfnRepository {
+	return &sfnRepository{
+		sfn.New(session),
+		c,
+	}
+}
+
+func (r *sfnRepository) ListAllStateMachines(ctx context.Context) ([]*sfn.StateMachineListItem, error) {
+	if v := r.cache.Get("sfnListAllStateMachines"); v != nil {
+		return v.([]*sfn.StateMachineListItem), nil
+	}
+
+	var stateMachines []*sfn.StateMachineListItem
+	input := &sfn.ListStateMachinesInput{}
+	err := r.client.ListStateMachinesPagesWithContext(ctx, input, func(res *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		stateMachines = append(stateMachines, res.StateMachines...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("sfnListAllStateMachines", stateMachines)
+	return stateMachines, nil
+}
+
+func (r *sfnRepository) ListAllActivities(ctx context.Context) ([]*sfn.ActivityListItem, error) {
+	if v := r.cache.Get("sfnListAllActivities"); v != nil {
+		return v.([]*sfn.ActivityListItem), nil
+	}
+
+	var activities []*sfn.ActivityListItem
+	input := &sfn.ListActivitiesInput{}
+	err := r.client.ListActivitiesPagesWithContext(ctx, input, func(res *sfn.ListActivitiesOutput, lastPage bool) bool {
+		activities = append(activities, res.Activities...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("sfnListAllActivities", activities)
+	return activities, nil
+}
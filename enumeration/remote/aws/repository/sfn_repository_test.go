@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_sfnRepository_ListAllStateMachines(t *testing.T) {
+	stateMachines := []*sfn.StateMachineListItem{
+		{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:checkout"), Name: aws.String("checkout")},
+		{StateMachineArn: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:refund"), Name: aws.String("refund")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSFN, store *cache.MockCache)
+		want    []*sfn.StateMachineListItem
+		wantErr error
+	}{
+		{
+			name: "list state machines",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				client.On("ListStateMachinesPagesWithContext", mock.Anything,
+					&sfn.ListStateMachinesInput{},
+					mock.MatchedBy(func(callback func(res *sfn.ListStateMachinesOutput, lastPage bool) bool) bool {
+						callback(&sfn.ListStateMachinesOutput{StateMachines: stateMachines[:1]}, false)
+						callback(&sfn.ListStateMachinesOutput{StateMachines: stateMachines[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "sfnListAllStateMachines").Return(nil).Times(1)
+				store.On("Put", "sfnListAllStateMachines", stateMachines).Return(false).Times(1)
+			},
+			want: stateMachines,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				store.On("Get", "sfnListAllStateMachines").Return(stateMachines).Times(1)
+			},
+			want: stateMachines,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				client.On("ListStateMachinesPagesWithContext", mock.Anything,
+					&sfn.ListStateMachinesInput{},
+					mock.AnythingOfType("func(*sfn.ListStateMachinesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "sfnListAllStateMachines").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSFN{}
+			tt.mocks(client, store)
+			r := &sfnRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllStateMachines(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_sfnRepository_ListAllActivities(t *testing.T) {
+	activities := []*sfn.ActivityListItem{
+		{ActivityArn: aws.String("arn:aws:states:us-east-1:123456789012:activity:approve"), Name: aws.String("approve")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSFN, store *cache.MockCache)
+		want    []*sfn.ActivityListItem
+		wantErr error
+	}{
+		{
+			name: "list activities",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				client.On("ListActivitiesPagesWithContext", mock.Anything,
+					&sfn.ListActivitiesInput{},
+					mock.MatchedBy(func(callback func(res *sfn.ListActivitiesOutput, lastPage bool) bool) bool {
+						callback(&sfn.ListActivitiesOutput{Activities: activities}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "sfnListAllActivities").Return(nil).Times(1)
+				store.On("Put", "sfnListAllActivities", activities).Return(false).Times(1)
+			},
+			want: activities,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				store.On("Get", "sfnListAllActivities").Return(activities).Times(1)
+			},
+			want: activities,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSFN, store *cache.MockCache) {
+				client.On("ListActivitiesPagesWithContext", mock.Anything,
+					&sfn.ListActivitiesInput{},
+					mock.AnythingOfType("func(*sfn.ListActivitiesOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "sfnListAllActivities").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSFN{}
+			tt.mocks(client, store)
+			r := &sfnRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllActivities(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/snyk/driftctl/enumeration/remote/aws/client"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+type ShieldRepository interface {
+	ListAllProtections(ctx context.Context) ([]*shield.Protection, error)
+}
+
+type shieldRepository struct {
+	clientFactory client.AwsClientFactoryInterface
+	cache         cache.Cache
+}
+
+func NewShieldRepository(factory client.AwsClientFactoryInterface, c cache.Cache) *shieldRepository {
+	return &shieldRepository{
+		factory,
+		c,
+	}
+}
+
+func (r *shieldRepository) ListAllProtections(ctx context.Context) ([]*shield.Protection, error) {
+	if v := r.cache.Get("shieldListAllProtections"); v != nil {
+		return v.([]*shield.Protection), nil
+	}
+
+	var protections []*shield.Protection
+	input := shield.ListProtectionsInput{}
+	// Shield API is only served from the global region
+	err := r.clientFactory.GetShieldClient(&awssdk.Config{Region: awssdk.String(GlobalRegion)}).
+		ListProtectionsPagesWithContext(ctx, &input,
+			func(resp *shield.ListProtectionsOutput, lastPage bool) bool {
+				protections = append(protections, resp.Protections...)
+				return !lastPage
+			},
+		)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("shieldListAllProtections", protections)
+	return protections, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/aws/client"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_shieldRepository_ListAllProtections(t *testing.T) {
+	protections := []*shield.Protection{
+		{Id: aws.String("protection-1")},
+		{Id: aws.String("protection-2")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeShield, store *cache.MockCache)
+		want    []*shield.Protection
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeShield, store *cache.MockCache) {
+				client.On("ListProtectionsPagesWithContext", mock.Anything,
+					&shield.ListProtectionsInput{},
+					mock.MatchedBy(func(callback func(res *shield.ListProtectionsOutput, lastPage bool) bool) bool {
+						callback(&shield.ListProtectionsOutput{Protections: protections[:1]}, false)
+						callback(&shield.ListProtectionsOutput{Protections: protections[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "shieldListAllProtections").Return(nil).Times(1)
+				store.On("Put", "shieldListAllProtections", protections).Return(false).Times(1)
+			},
+			want: protections,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeShield, store *cache.MockCache) {
+				store.On("Get", "shieldListAllProtections").Return(protections).Times(1)
+			},
+			want: protections,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeShield, store *cache.MockCache) {
+				client.On("ListProtectionsPagesWithContext", mock.Anything,
+					&shield.ListProtectionsInput{},
+					mock.AnythingOfType("func(*shield.ListProtectionsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "shieldListAllProtections").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			mockedClient := &awstest.MockFakeShield{}
+			tt.mocks(mockedClient, store)
+			factory := client.MockAwsClientFactoryInterface{}
+			factory.On("GetShieldClient", &aws.Config{Region: aws.String("us-east-1")}).Return(mockedClient)
+			r := NewShieldRepository(&factory, store)
+			got, err := r.ListAllProtections(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
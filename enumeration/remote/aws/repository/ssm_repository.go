@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// SSMRepository only exposes parameters metadata, parameter values are never read
+type SSMRepository interface {
+	ListAllParameters(ctx context.Context) ([]*ssm.ParameterMetadata, error)
+	ListAllDocuments(ctx context.Context) ([]*ssm.DocumentIdentifier, error)
+}
+
+type ssmRepository struct {
+	client ssmiface.SSMAPI
+	cache  cache.Cache
+}
+
+func NewSSMRepository(session *session.Session, c cache.Cache) *ssmRepository {
+	return &ssmRepository{
+		ssm.New(session),
+		c,
+	}
+}
+
+func (r *ssmRepository) ListAllParameters(ctx context.Context) ([]*ssm.ParameterMetadata, error) {
+	if v := r.cache.Get("ssmListAllParameters"); v != nil {
+		return v.([]*ssm.ParameterMetadata), nil
+	}
+
+	var parameters []*ssm.ParameterMetadata
+	input := &ssm.DescribeParametersInput{}
+	err := r.client.DescribeParametersPagesWithContext(ctx, input, func(res *ssm.DescribeParametersOutput, lastPage bool) bool {
+		parameters = append(parameters, res.Parameters...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ssmListAllParameters", parameters)
+	return parameters, nil
+}
+
+// ListAllDocuments only returns documents owned by the account, public and AWS managed documents are skipped
+func (r *ssmRepository) ListAllDocuments(ctx context.Context) ([]*ssm.DocumentIdentifier, error) {
+	if v := r.cache.Get("ssmListAllDocuments"); v != nil {
+		return v.([]*ssm.DocumentIdentifier), nil
+	}
+
+	var documents []*ssm.DocumentIdentifier
+	input := &ssm.ListDocumentsInput{
+		Filters: []*ssm.DocumentKeyValuesFilter{
+			{
+				Key:    aws.String("Owner"),
+				Values: []*string{aws.String("Self")},
+			},
+		},
+	}
+	err := r.client.ListDocumentsPagesWithContext(ctx, input, func(res *ssm.ListDocumentsOutput, lastPage bool) bool {
+		documents = append(documents, res.DocumentIdentifiers...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Put("ssmListAllDocuments", documents)
+	return documents, nil
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ssmRepository_ListAllParameters(t *testing.T) {
+	parameters := []*ssm.ParameterMetadata{
+		{Name: aws.String("/app/database/host"), Type: aws.String("String")},
+		{Name: aws.String("/app/database/password"), Type: aws.String("SecureString")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSSM, store *cache.MockCache)
+		want    []*ssm.ParameterMetadata
+		wantErr error
+	}{
+		{
+			name: "list parameters",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				client.On("DescribeParametersPagesWithContext", mock.Anything,
+					&ssm.DescribeParametersInput{},
+					mock.MatchedBy(func(callback func(res *ssm.DescribeParametersOutput, lastPage bool) bool) bool {
+						callback(&ssm.DescribeParametersOutput{Parameters: parameters[:1]}, false)
+						callback(&ssm.DescribeParametersOutput{Parameters: parameters[1:]}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "ssmListAllParameters").Return(nil).Times(1)
+				store.On("Put", "ssmListAllParameters", parameters).Return(false).Times(1)
+			},
+			want: parameters,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				store.On("Get", "ssmListAllParameters").Return(parameters).Times(1)
+			},
+			want: parameters,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				client.On("DescribeParametersPagesWithContext", mock.Anything,
+					&ssm.DescribeParametersInput{},
+					mock.AnythingOfType("func(*ssm.DescribeParametersOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "ssmListAllParameters").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSSM{}
+			tt.mocks(client, store)
+			r := &ssmRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllParameters(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ssmRepository_ListAllDocuments(t *testing.T) {
+	documents := []*ssm.DocumentIdentifier{
+		{Name: aws.String("bootstrap"), Owner: aws.String("123456789012")},
+	}
+	input := &ssm.ListDocumentsInput{
+		Filters: []*ssm.DocumentKeyValuesFilter{
+			{
+				Key:    aws.String("Owner"),
+				Values: []*string{aws.String("Self")},
+			},
+		},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeSSM, store *cache.MockCache)
+		want    []*ssm.DocumentIdentifier
+		wantErr error
+	}{
+		{
+			name: "list documents owned by the account",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				client.On("ListDocumentsPagesWithContext", mock.Anything, input,
+					mock.MatchedBy(func(callback func(res *ssm.ListDocumentsOutput, lastPage bool) bool) bool {
+						callback(&ssm.ListDocumentsOutput{DocumentIdentifiers: documents}, true)
+						return true
+					})).Return(nil).Once()
+				store.On("Get", "ssmListAllDocuments").Return(nil).Times(1)
+				store.On("Put", "ssmListAllDocuments", documents).Return(false).Times(1)
+			},
+			want: documents,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				store.On("Get", "ssmListAllDocuments").Return(documents).Times(1)
+			},
+			want: documents,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeSSM, store *cache.MockCache) {
+				client.On("ListDocumentsPagesWithContext", mock.Anything, input,
+					mock.AnythingOfType("func(*ssm.ListDocumentsOutput, bool) bool")).Return(remoteError).Once()
+				store.On("Get", "ssmListAllDocuments").Return(nil).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			client := &awstest.MockFakeSSM{}
+			tt.mocks(client, store)
+			r := &ssmRepository{
+				client: client,
+				cache:  store,
+			}
+			got, err := r.ListAllDocuments(context.TODO())
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
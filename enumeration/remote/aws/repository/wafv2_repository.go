@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/snyk/driftctl/enumeration/remote/aws/client"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// GlobalRegion is the only region serving global resources, like CLOUDFRONT scoped WAF resources
+// or Shield protections, whatever region driftctl is configured with
+const GlobalRegion = "us-east-1"
+
+type WAFV2Repository interface {
+	ListAllWebACLs(ctx context.Context, scope string) ([]*wafv2.WebACLSummary, error)
+	ListAllIPSets(ctx context.Context, scope string) ([]*wafv2.IPSetSummary, error)
+	ListAllRuleGroups(ctx context.Context, scope string) ([]*wafv2.RuleGroupSummary, error)
+	ListAllResourcesForWebACL(ctx context.Context, webACLArn string) ([]*string, error)
+}
+
+type wafv2Repository struct {
+	clientFactory client.AwsClientFactoryInterface
+	cache         cache.Cache
+}
+
+func NewWAFV2Repository(factory client.AwsClientFactoryInterface, c cache.Cache) *wafv2Repository {
+	return &wafv2Repository{
+		factory,
+		c,
+	}
+}
+
+func (r *wafv2Repository) client(scope string) wafv2iface.WAFV2API {
+	if scope == wafv2.ScopeCloudfront {
+		return r.clientFactory.GetWAFV2Client(&awssdk.Config{Region: awssdk.String(GlobalRegion)})
+	}
+	return r.clientFactory.GetWAFV2Client(nil)
+}
+
+func (r *wafv2Repository) ListAllWebACLs(ctx context.Context, scope string) ([]*wafv2.WebACLSummary, error) {
+	cacheKey := fmt.Sprintf("wafv2ListAllWebACLs_%s", scope)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*wafv2.WebACLSummary), nil
+	}
+
+	var webACLs []*wafv2.WebACLSummary
+	input := &wafv2.ListWebACLsInput{
+		Scope: awssdk.String(scope),
+	}
+	for {
+		resp, err := r.client(scope).ListWebACLsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		webACLs = append(webACLs, resp.WebACLs...)
+		if resp.NextMarker == nil {
+			break
+		}
+		input.NextMarker = resp.NextMarker
+	}
+
+	r.cache.Put(cacheKey, webACLs)
+	return webACLs, nil
+}
+
+func (r *wafv2Repository) ListAllIPSets(ctx context.Context, scope string) ([]*wafv2.IPSetSummary, error) {
+	cacheKey := fmt.Sprintf("wafv2ListAllIPSets_%s", scope)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*wafv2.IPSetSummary), nil
+	}
+
+	var ipSets []*wafv2.IPSetSummary
+	input := &wafv2.ListIPSetsInput{
+		Scope: awssdk.String(scope),
+	}
+	for {
+		resp, err := r.client(scope).ListIPSetsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		ipSets = append(ipSets, resp.IPSets...)
+		if resp.NextMarker == nil {
+			break
+		}
+		input.NextMarker = resp.NextMarker
+	}
+
+	r.cache.Put(cacheKey, ipSets)
+	return ipSets, nil
+}
+
+func (r *wafv2Repository) ListAllRuleGroups(ctx context.Context, scope string) ([]*wafv2.RuleGroupSummary, error) {
+	cacheKey := fmt.Sprintf("wafv2ListAllRuleGroups_%s", scope)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*wafv2.RuleGroupSummary), nil
+	}
+
+	var ruleGroups []*wafv2.RuleGroupSummary
+	input := &wafv2.ListRuleGroupsInput{
+		Scope: awssdk.String(scope),
+	}
+	for {
+		resp, err := r.client(scope).ListRuleGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		ruleGroups = append(ruleGroups, resp.RuleGroups...)
+		if resp.NextMarker == nil {
+			break
+		}
+		input.NextMarker = resp.NextMarker
+	}
+
+	r.cache.Put(cacheKey, ruleGroups)
+	return ruleGroups, nil
+}
+
+// ListAllResourcesForWebACL returns the ARN of every regional resource (load balancers, API stages, AppSync APIs)
+// associated to the given web ACL. CloudFront distributions reference their web ACL themselves.
+func (r *wafv2Repository) ListAllResourcesForWebACL(ctx context.Context, webACLArn string) ([]*string, error) {
+	cacheKey := fmt.Sprintf("wafv2ListAllResourcesForWebACL_%s", webACLArn)
+	v := r.cache.GetAndLock(cacheKey)
+	defer r.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*string), nil
+	}
+
+	var resourceArns []*string
+	for _, resourceType := range wafv2.ResourceType_Values() {
+		resp, err := r.client(wafv2.ScopeRegional).ListResourcesForWebACLWithContext(ctx, &wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    awssdk.String(webACLArn),
+			ResourceType: awssdk.String(resourceType),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resourceArns = append(resourceArns, resp.ResourceArns...)
+	}
+
+	r.cache.Put(cacheKey, resourceArns)
+	return resourceArns, nil
+}
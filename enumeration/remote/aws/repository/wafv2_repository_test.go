@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/aws/client"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	awstest "github.com/snyk/driftctl/test/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_wafv2Repository_ListAllWebACLs(t *testing.T) {
+	webACLs := []*wafv2.WebACLSummary{
+		{Id: aws.String("acl-1"), Name: aws.String("first")},
+		{Id: aws.String("acl-2"), Name: aws.String("second")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		scope   string
+		config  *aws.Config
+		mocks   func(client *awstest.MockFakeWAFV2, store *cache.MockCache)
+		want    []*wafv2.WebACLSummary
+		wantErr error
+	}{
+		{
+			name:   "list regional web ACLs with multiple pages",
+			scope:  wafv2.ScopeRegional,
+			config: nil,
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListWebACLsWithContext", mock.Anything, &wafv2.ListWebACLsInput{
+					Scope: aws.String(wafv2.ScopeRegional),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs:    webACLs[:1],
+					NextMarker: aws.String("next"),
+				}, nil).Once()
+				client.On("ListWebACLsWithContext", mock.Anything, &wafv2.ListWebACLsInput{
+					Scope:      aws.String(wafv2.ScopeRegional),
+					NextMarker: aws.String("next"),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs: webACLs[1:],
+				}, nil).Once()
+				store.On("GetAndLock", "wafv2ListAllWebACLs_REGIONAL").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllWebACLs_REGIONAL").Times(1)
+				store.On("Put", "wafv2ListAllWebACLs_REGIONAL", webACLs).Return(false).Times(1)
+			},
+			want: webACLs,
+		},
+		{
+			name:   "list cloudfront web ACLs from the global region",
+			scope:  wafv2.ScopeCloudfront,
+			config: &aws.Config{Region: aws.String("us-east-1")},
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListWebACLsWithContext", mock.Anything, &wafv2.ListWebACLsInput{
+					Scope: aws.String(wafv2.ScopeCloudfront),
+				}).Return(&wafv2.ListWebACLsOutput{
+					WebACLs: webACLs,
+				}, nil).Once()
+				store.On("GetAndLock", "wafv2ListAllWebACLs_CLOUDFRONT").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllWebACLs_CLOUDFRONT").Times(1)
+				store.On("Put", "wafv2ListAllWebACLs_CLOUDFRONT", webACLs).Return(false).Times(1)
+			},
+			want: webACLs,
+		},
+		{
+			name:  "should hit cache",
+			scope: wafv2.ScopeRegional,
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				store.On("GetAndLock", "wafv2ListAllWebACLs_REGIONAL").Return(webACLs).Times(1)
+				store.On("Unlock", "wafv2ListAllWebACLs_REGIONAL").Times(1)
+			},
+			want: webACLs,
+		},
+		{
+			name:   "should return remote error",
+			scope:  wafv2.ScopeRegional,
+			config: nil,
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListWebACLsWithContext", mock.Anything, &wafv2.ListWebACLsInput{
+					Scope: aws.String(wafv2.ScopeRegional),
+				}).Return(nil, remoteError).Once()
+				store.On("GetAndLock", "wafv2ListAllWebACLs_REGIONAL").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllWebACLs_REGIONAL").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			mockedClient := &awstest.MockFakeWAFV2{}
+			tt.mocks(mockedClient, store)
+			factory := client.MockAwsClientFactoryInterface{}
+			factory.On("GetWAFV2Client", tt.config).Return(mockedClient)
+			r := NewWAFV2Repository(&factory, store)
+			got, err := r.ListAllWebACLs(context.TODO(), tt.scope)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_wafv2Repository_ListAllIPSets(t *testing.T) {
+	ipSets := []*wafv2.IPSetSummary{
+		{Id: aws.String("ipset-1"), Name: aws.String("first")},
+		{Id: aws.String("ipset-2"), Name: aws.String("second")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeWAFV2, store *cache.MockCache)
+		want    []*wafv2.IPSetSummary
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListIPSetsWithContext", mock.Anything, &wafv2.ListIPSetsInput{
+					Scope: aws.String(wafv2.ScopeCloudfront),
+				}).Return(&wafv2.ListIPSetsOutput{
+					IPSets: ipSets,
+				}, nil).Once()
+				store.On("GetAndLock", "wafv2ListAllIPSets_CLOUDFRONT").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllIPSets_CLOUDFRONT").Times(1)
+				store.On("Put", "wafv2ListAllIPSets_CLOUDFRONT", ipSets).Return(false).Times(1)
+			},
+			want: ipSets,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				store.On("GetAndLock", "wafv2ListAllIPSets_CLOUDFRONT").Return(ipSets).Times(1)
+				store.On("Unlock", "wafv2ListAllIPSets_CLOUDFRONT").Times(1)
+			},
+			want: ipSets,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListIPSetsWithContext", mock.Anything, &wafv2.ListIPSetsInput{
+					Scope: aws.String(wafv2.ScopeCloudfront),
+				}).Return(nil, remoteError).Once()
+				store.On("GetAndLock", "wafv2ListAllIPSets_CLOUDFRONT").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllIPSets_CLOUDFRONT").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			mockedClient := &awstest.MockFakeWAFV2{}
+			tt.mocks(mockedClient, store)
+			factory := client.MockAwsClientFactoryInterface{}
+			factory.On("GetWAFV2Client", &aws.Config{Region: aws.String("us-east-1")}).Return(mockedClient)
+			r := NewWAFV2Repository(&factory, store)
+			got, err := r.ListAllIPSets(context.TODO(), wafv2.ScopeCloudfront)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_wafv2Repository_ListAllRuleGroups(t *testing.T) {
+	ruleGroups := []*wafv2.RuleGroupSummary{
+		{Id: aws.String("group-1"), Name: aws.String("first")},
+		{Id: aws.String("group-2"), Name: aws.String("second")},
+	}
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeWAFV2, store *cache.MockCache)
+		want    []*wafv2.RuleGroupSummary
+		wantErr error
+	}{
+		{
+			name: "list",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListRuleGroupsWithContext", mock.Anything, &wafv2.ListRuleGroupsInput{
+					Scope: aws.String(wafv2.ScopeRegional),
+				}).Return(&wafv2.ListRuleGroupsOutput{
+					RuleGroups: ruleGroups,
+				}, nil).Once()
+				store.On("GetAndLock", "wafv2ListAllRuleGroups_REGIONAL").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllRuleGroups_REGIONAL").Times(1)
+				store.On("Put", "wafv2ListAllRuleGroups_REGIONAL", ruleGroups).Return(false).Times(1)
+			},
+			want: ruleGroups,
+		},
+		{
+			name: "should hit cache",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				store.On("GetAndLock", "wafv2ListAllRuleGroups_REGIONAL").Return(ruleGroups).Times(1)
+				store.On("Unlock", "wafv2ListAllRuleGroups_REGIONAL").Times(1)
+			},
+			want: ruleGroups,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListRuleGroupsWithContext", mock.Anything, &wafv2.ListRuleGroupsInput{
+					Scope: aws.String(wafv2.ScopeRegional),
+				}).Return(nil, remoteError).Once()
+				store.On("GetAndLock", "wafv2ListAllRuleGroups_REGIONAL").Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllRuleGroups_REGIONAL").Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			mockedClient := &awstest.MockFakeWAFV2{}
+			tt.mocks(mockedClient, store)
+			factory := client.MockAwsClientFactoryInterface{}
+			factory.On("GetWAFV2Client", (*aws.Config)(nil)).Return(mockedClient)
+			r := NewWAFV2Repository(&factory, store)
+			got, err := r.ListAllRuleGroups(context.TODO(), wafv2.ScopeRegional)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_wafv2Repository_ListAllResourcesForWebACL(t *testing.T) {
+	webACLArn := "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/first/acl-1"
+	loadBalancerArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/lb/1"
+	stageArn := "arn:aws:apigateway:us-east-1::/restapis/api/stages/prod"
+
+	remoteError := errors.New("remote error")
+
+	tests := []struct {
+		name    string
+		mocks   func(client *awstest.MockFakeWAFV2, store *cache.MockCache)
+		want    []*string
+		wantErr error
+	}{
+		{
+			name: "list resources of every type",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListResourcesForWebACLWithContext", mock.Anything, &wafv2.ListResourcesForWebACLInput{
+					WebACLArn:    aws.String(webACLArn),
+					ResourceType: aws.String(wafv2.ResourceTypeApplicationLoadBalancer),
+				}).Return(&wafv2.ListResourcesForWebACLOutput{
+					ResourceArns: []*string{aws.String(loadBalancerArn)},
+				}, nil).Once()
+				client.On("ListResourcesForWebACLWithContext", mock.Anything, &wafv2.ListResourcesForWebACLInput{
+					WebACLArn:    aws.String(webACLArn),
+					ResourceType: aws.String(wafv2.ResourceTypeApiGateway),
+				}).Return(&wafv2.ListResourcesForWebACLOutput{
+					ResourceArns: []*string{aws.String(stageArn)},
+				}, nil).Once()
+				client.On("ListResourcesForWebACLWithContext", mock.Anything, &wafv2.ListResourcesForWebACLInput{
+					WebACLArn:    aws.String(webACLArn),
+					ResourceType: aws.String(wafv2.ResourceTypeAppsync),
+				}).Return(&wafv2.ListResourcesForWebACLOutput{}, nil).Once()
+				store.On("GetAndLock", "wafv2ListAllResourcesForWebACL_"+webACLArn).Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllResourcesForWebACL_"+webACLArn).Times(1)
+				store.On("Put", "wafv2ListAllResourcesForWebACL_"+webACLArn, []*string{aws.String(loadBalancerArn), aws.String(stageArn)}).Return(false).Times(1)
+			},
+			want: []*string{aws.String(loadBalancerArn), aws.String(stageArn)},
+		},
+		{
+			name: "should return remote error",
+			mocks: func(client *awstest.MockFakeWAFV2, store *cache.MockCache) {
+				client.On("ListResourcesForWebACLWithContext", mock.Anything, mock.Anything).Return(nil, remoteError).Once()
+				store.On("GetAndLock", "wafv2ListAllResourcesForWebACL_"+webACLArn).Return(nil).Times(1)
+				store.On("Unlock", "wafv2ListAllResourcesForWebACL_"+webACLArn).Times(1)
+			},
+			wantErr: remoteError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &cache.MockCache{}
+			mockedClient := &awstest.MockFakeWAFV2{}
+			tt.mocks(mockedClient, store)
+			factory := client.MockAwsClientFactoryInterface{}
+			factory.On("GetWAFV2Client", (*aws.Config)(nil)).Return(mockedClient)
+			r := NewWAFV2Repository(&factory, store)
+			got, err := r.ListAllResourcesForWebACL(context.TODO(), webACLArn)
+			assert.Equal(t, tt.wantErr, err)
+
+			assertNoDiff(t, got, tt.want)
+			store.AssertExpectations(t)
+			mockedClient.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *Route53HealthCheckEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsRoute53HealthCheckResourceType
 }
 
-func (e *Route53HealthCheckEnumerator) Enumerate() ([]*resource.Resource, error) {
-	healthChecks, err := e.repository.ListAllHealthChecks()
+func (e *Route53HealthCheckEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	healthChecks, err := e.repository.ListAllHealthChecks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
@@ -32,8 +34,8 @@ func (e *S3BucketEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsS3BucketResourceType
 }
 
-func (e *S3BucketEnumerator) Enumerate() ([]*resource.Resource, error) {
-	buckets, err := e.repository.ListAllBuckets()
+func (e *S3BucketEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	buckets, err := e.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -41,7 +43,7 @@ func (e *S3BucketEnumerator) Enumerate() ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0, len(buckets))
 
 	for _, bucket := range buckets {
-		region, err := e.repository.GetBucketLocation(*bucket.Name)
+		region, err := e.repository.GetBucketLocation(ctx, *bucket.Name)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
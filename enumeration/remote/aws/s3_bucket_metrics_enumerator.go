@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
@@ -35,8 +36,8 @@ func (e *S3BucketMetricsEnumerator) SupportedType() resource.ResourceType {
 	return aws.AwsS3BucketMetricResourceType
 }
 
-func (e *S3BucketMetricsEnumerator) Enumerate() ([]*resource.Resource, error) {
-	buckets, err := e.repository.ListAllBuckets()
+func (e *S3BucketMetricsEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	buckets, err := e.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsS3BucketResourceType)
 	}
@@ -44,7 +45,7 @@ func (e *S3BucketMetricsEnumerator) Enumerate() ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0, len(buckets))
 
 	for _, bucket := range buckets {
-		region, err := e.repository.GetBucketLocation(*bucket.Name)
+		region, err := e.repository.GetBucketLocation(ctx, *bucket.Name)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
@@ -57,7 +58,7 @@ func (e *S3BucketMetricsEnumerator) Enumerate() ([]*resource.Resource, error) {
 			continue
 		}
 
-		metricsConfigurationList, err := e.repository.ListBucketMetricsConfigurations(bucket, region)
+		metricsConfigurationList, err := e.repository.ListBucketMetricsConfigurations(ctx, bucket, region)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
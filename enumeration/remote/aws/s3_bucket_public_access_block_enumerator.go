@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/alerter"
@@ -33,8 +35,8 @@ func (e *S3BucketPublicAccessBlockEnumerator) SupportedType() resource.ResourceT
 	return aws.AwsS3BucketPublicAccessBlockResourceType
 }
 
-func (e *S3BucketPublicAccessBlockEnumerator) Enumerate() ([]*resource.Resource, error) {
-	buckets, err := e.repository.ListAllBuckets()
+func (e *S3BucketPublicAccessBlockEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	buckets, err := e.repository.ListAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsS3BucketResourceType)
 	}
@@ -42,7 +44,7 @@ func (e *S3BucketPublicAccessBlockEnumerator) Enumerate() ([]*resource.Resource,
 	results := make([]*resource.Resource, 0, len(buckets))
 
 	for _, bucket := range buckets {
-		region, err := e.repository.GetBucketLocation(*bucket.Name)
+		region, err := e.repository.GetBucketLocation(ctx, *bucket.Name)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
@@ -55,7 +57,7 @@ func (e *S3BucketPublicAccessBlockEnumerator) Enumerate() ([]*resource.Resource,
 			continue
 		}
 
-		block, err := e.repository.GetBucketPublicAccessBlock(*bucket.Name, region)
+		block, err := e.repository.GetBucketPublicAccessBlock(ctx, *bucket.Name, region)
 		if err != nil {
 			alerts.SendEnumerationAlert(common.RemoteAWSTerraform, e.alerter, remoteerror.NewResourceScanningError(err, string(e.SupportedType()), *bucket.Name))
 			continue
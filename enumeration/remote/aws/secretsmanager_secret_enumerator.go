@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SecretsManagerSecretEnumerator struct {
+	repository repository.SecretsManagerRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSecretsManagerSecretEnumerator(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) *SecretsManagerSecretEnumerator {
+	return &SecretsManagerSecretEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SecretsManagerSecretEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSecretsManagerSecretResourceType
+}
+
+func (e *SecretsManagerSecretEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	secrets, err := e.repository.ListAllSecrets(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(secrets))
+
+	for _, secret := range secrets {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*secret.ARN,
+				map[string]interface{}{
+					"name": *secret.Name,
+				},
+			),
+		)
+	}
+
+	return results, err
+}
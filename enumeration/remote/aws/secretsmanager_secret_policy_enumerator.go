@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SecretsManagerSecretPolicyEnumerator struct {
+	repository repository.SecretsManagerRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSecretsManagerSecretPolicyEnumerator(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) *SecretsManagerSecretPolicyEnumerator {
+	return &SecretsManagerSecretPolicyEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SecretsManagerSecretPolicyEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSecretsManagerSecretPolicyResourceType
+}
+
+func (e *SecretsManagerSecretPolicyEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	secrets, err := e.repository.ListAllSecrets(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsSecretsManagerSecretResourceType)
+	}
+
+	results := make([]*resource.Resource, 0, len(secrets))
+
+	for _, secret := range secrets {
+		policy, err := e.repository.GetSecretPolicy(ctx, *secret.ARN)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+		if policy == nil {
+			continue
+		}
+
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*secret.ARN,
+				map[string]interface{}{
+					"secret_arn": *secret.ARN,
+				},
+			),
+		)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SESDomainIdentityEnumerator struct {
+	repository repository.SESRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSESDomainIdentityEnumerator(repo repository.SESRepository, factory resource.ResourceFactory) *SESDomainIdentityEnumerator {
+	return &SESDomainIdentityEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SESDomainIdentityEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSesDomainIdentityResourceType
+}
+
+func (e *SESDomainIdentityEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	identities, err := e.repository.ListAllDomainIdentities(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(identities))
+
+	for _, identity := range identities {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*identity,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SESEmailIdentityEnumerator struct {
+	repository repository.SESRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSESEmailIdentityEnumerator(repo repository.SESRepository, factory resource.ResourceFactory) *SESEmailIdentityEnumerator {
+	return &SESEmailIdentityEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SESEmailIdentityEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSesEmailIdentityResourceType
+}
+
+func (e *SESEmailIdentityEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	identities, err := e.repository.ListAllEmailIdentities(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(identities))
+
+	for _, identity := range identities {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*identity,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SESV2ConfigurationSetEnumerator struct {
+	repository repository.SESV2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewSESV2ConfigurationSetEnumerator(repo repository.SESV2Repository, factory resource.ResourceFactory) *SESV2ConfigurationSetEnumerator {
+	return &SESV2ConfigurationSetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SESV2ConfigurationSetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSesv2ConfigurationSetResourceType
+}
+
+func (e *SESV2ConfigurationSetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	configurationSets, err := e.repository.ListAllConfigurationSets(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(configurationSets))
+
+	for _, configurationSet := range configurationSets {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*configurationSet,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SFNActivityEnumerator struct {
+	repository repository.SFNRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSFNActivityEnumerator(repo repository.SFNRepository, factory resource.ResourceFactory) *SFNActivityEnumerator {
+	return &SFNActivityEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SFNActivityEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSfnActivityResourceType
+}
+
+func (e *SFNActivityEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	activities, err := e.repository.ListAllActivities(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(activities))
+
+	for _, activity := range activities {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*activity.ActivityArn,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SFNStateMachineEnumerator struct {
+	repository repository.SFNRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSFNStateMachineEnumerator(repo repository.SFNRepository, factory resource.ResourceFactory) *SFNStateMachineEnumerator {
+	return &SFNStateMachineEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SFNStateMachineEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSfnStateMachineResourceType
+}
+
+func (e *SFNStateMachineEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	stateMachines, err := e.repository.ListAllStateMachines(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(stateMachines))
+
+	for _, stateMachine := range stateMachines {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*stateMachine.StateMachineArn,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type ShieldProtectionEnumerator struct {
+	repository repository.ShieldRepository
+	factory    resource.ResourceFactory
+}
+
+func NewShieldProtectionEnumerator(repo repository.ShieldRepository, factory resource.ResourceFactory) *ShieldProtectionEnumerator {
+	return &ShieldProtectionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *ShieldProtectionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsShieldProtectionResourceType
+}
+
+func (e *ShieldProtectionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	protections, err := e.repository.ListAllProtections(ctx)
+	// Shield answers with a not found error when there is no protection at all, e.g. without Shield Advanced subscription
+	if _, ok := err.(*shield.ResourceNotFoundException); ok {
+		return []*resource.Resource{}, nil
+	}
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(protections))
+
+	for _, protection := range protections {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*protection.Id,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/terraform"
 	"strings"
@@ -22,8 +24,8 @@ func NewSQSQueueDetailsFetcher(provider terraform.ResourceReader, deserializer *
 	}
 }
 
-func (r *SQSQueueDetailsFetcher) ReadDetails(res *resource.Resource) (*resource.Resource, error) {
-	ctyVal, err := r.reader.ReadResource(terraform.ReadResourceArgs{
+func (r *SQSQueueDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	ctyVal, err := r.reader.ReadResource(ctx, terraform.ReadResourceArgs{
 		ID: res.ResourceId(),
 		Ty: aws.AwsSqsQueueResourceType,
 	})
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SSMDocumentEnumerator struct {
+	repository repository.SSMRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSSMDocumentEnumerator(repo repository.SSMRepository, factory resource.ResourceFactory) *SSMDocumentEnumerator {
+	return &SSMDocumentEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SSMDocumentEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSsmDocumentResourceType
+}
+
+func (e *SSMDocumentEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	documents, err := e.repository.ListAllDocuments(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(documents))
+
+	for _, document := range documents {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*document.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
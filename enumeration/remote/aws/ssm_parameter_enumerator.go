@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type SSMParameterEnumerator struct {
+	repository repository.SSMRepository
+	factory    resource.ResourceFactory
+}
+
+func NewSSMParameterEnumerator(repo repository.SSMRepository, factory resource.ResourceFactory) *SSMParameterEnumerator {
+	return &SSMParameterEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *SSMParameterEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsSsmParameterResourceType
+}
+
+func (e *SSMParameterEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	parameters, err := e.repository.ListAllParameters(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*parameter.Name,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCDhcpOptionsEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCDhcpOptionsEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCDhcpOptionsEnumerator {
+	return &VPCDhcpOptionsEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCDhcpOptionsEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcDhcpOptionsResourceType
+}
+
+func (e *VPCDhcpOptionsEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	_, defaultVPCs, err := e.repository.ListAllVPCs(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsDefaultVpcResourceType)
+	}
+
+	// Options set attached to the default VPC are created by AWS along with it
+	defaultOptions := make(map[string]struct{}, len(defaultVPCs))
+	for _, vpc := range defaultVPCs {
+		if vpc.DhcpOptionsId != nil {
+			defaultOptions[*vpc.DhcpOptionsId] = struct{}{}
+		}
+	}
+
+	dhcpOptions, err := e.repository.ListAllDhcpOptions(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(dhcpOptions))
+
+	for _, options := range dhcpOptions {
+		if _, exist := defaultOptions[*options.DhcpOptionsId]; exist {
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*options.DhcpOptionsId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCEndpointEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCEndpointEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCEndpointEnumerator {
+	return &VPCEndpointEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCEndpointEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcEndpointResourceType
+}
+
+func (e *VPCEndpointEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	endpoints, err := e.repository.ListAllVpcEndpoints(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		// Endpoints in those states are gone or never got created
+		switch awssdk.StringValue(endpoint.State) {
+		case "deleted", "rejected", "failed", "expired":
+			continue
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*endpoint.VpcEndpointId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
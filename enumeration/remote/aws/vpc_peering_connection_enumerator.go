@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type VPCPeeringConnectionEnumerator struct {
+	repository repository.EC2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewVPCPeeringConnectionEnumerator(repo repository.EC2Repository, factory resource.ResourceFactory) *VPCPeeringConnectionEnumerator {
+	return &VPCPeeringConnectionEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *VPCPeeringConnectionEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsVpcPeeringConnectionResourceType
+}
+
+func (e *VPCPeeringConnectionEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	connections, err := e.repository.ListAllVpcPeeringConnections(ctx)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+	}
+
+	results := make([]*resource.Resource, 0, len(connections))
+
+	for _, connection := range connections {
+		// Peering connections remain visible for a while once deleted, rejected or expired
+		if connection.Status != nil {
+			switch awssdk.StringValue(connection.Status.Code) {
+			case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+				ec2.VpcPeeringConnectionStateReasonCodeRejected,
+				ec2.VpcPeeringConnectionStateReasonCodeFailed,
+				ec2.VpcPeeringConnectionStateReasonCodeExpired:
+				continue
+			}
+		}
+		results = append(
+			results,
+			e.factory.CreateAbstractResource(
+				string(e.SupportedType()),
+				*connection.VpcPeeringConnectionId,
+				map[string]interface{}{},
+			),
+		)
+	}
+
+	return results, err
+}
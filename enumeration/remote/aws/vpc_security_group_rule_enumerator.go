@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -74,8 +76,8 @@ func (e *VPCSecurityGroupRuleEnumerator) SupportedType() resource.ResourceType {
 	return resourceaws.AwsSecurityGroupRuleResourceType
 }
 
-func (e *VPCSecurityGroupRuleEnumerator) Enumerate() ([]*resource.Resource, error) {
-	securityGroups, defaultSecurityGroups, err := e.repository.ListAllSecurityGroups()
+func (e *VPCSecurityGroupRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	securityGroups, defaultSecurityGroups, err := e.repository.ListAllSecurityGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), resourceaws.AwsSecurityGroupResourceType)
 	}
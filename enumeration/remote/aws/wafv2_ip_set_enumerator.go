@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type WAFV2IPSetEnumerator struct {
+	repository repository.WAFV2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewWAFV2IPSetEnumerator(repo repository.WAFV2Repository, factory resource.ResourceFactory) *WAFV2IPSetEnumerator {
+	return &WAFV2IPSetEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *WAFV2IPSetEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsWafv2IpSetResourceType
+}
+
+func (e *WAFV2IPSetEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	results := make([]*resource.Resource, 0)
+
+	for _, scope := range wafv2Scopes {
+		ipSets, err := e.repository.ListAllIPSets(ctx, scope)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, ipSet := range ipSets {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*ipSet.Id,
+					wafv2Attributes(ipSet.Name, scope),
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
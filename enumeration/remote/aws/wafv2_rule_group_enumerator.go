@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type WAFV2RuleGroupEnumerator struct {
+	repository repository.WAFV2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewWAFV2RuleGroupEnumerator(repo repository.WAFV2Repository, factory resource.ResourceFactory) *WAFV2RuleGroupEnumerator {
+	return &WAFV2RuleGroupEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *WAFV2RuleGroupEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsWafv2RuleGroupResourceType
+}
+
+func (e *WAFV2RuleGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	results := make([]*resource.Resource, 0)
+
+	for _, scope := range wafv2Scopes {
+		ruleGroups, err := e.repository.ListAllRuleGroups(ctx, scope)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, ruleGroup := range ruleGroups {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*ruleGroup.Id,
+					wafv2Attributes(ruleGroup.Name, scope),
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
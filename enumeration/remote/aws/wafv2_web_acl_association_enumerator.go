@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+type WAFV2WebACLAssociationEnumerator struct {
+	repository repository.WAFV2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewWAFV2WebACLAssociationEnumerator(repo repository.WAFV2Repository, factory resource.ResourceFactory) *WAFV2WebACLAssociationEnumerator {
+	return &WAFV2WebACLAssociationEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *WAFV2WebACLAssociationEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsWafv2WebAclAssociationResourceType
+}
+
+func (e *WAFV2WebACLAssociationEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	// CloudFront distributions are associated through their own web_acl_id, only regional ACLs can have associations
+	webACLs, err := e.repository.ListAllWebACLs(ctx, wafv2.ScopeRegional)
+	if err != nil {
+		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), aws.AwsWafv2WebAclResourceType)
+	}
+
+	results := make([]*resource.Resource, 0)
+
+	for _, webACL := range webACLs {
+		resourceArns, err := e.repository.ListAllResourcesForWebACL(ctx, *webACL.ARN)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, resourceArn := range resourceArns {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					fmt.Sprintf("%s,%s", *webACL.ARN, *resourceArn),
+					map[string]interface{}{
+						"web_acl_arn":  *webACL.ARN,
+						"resource_arn": *resourceArn,
+					},
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
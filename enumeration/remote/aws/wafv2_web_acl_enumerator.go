@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/aws"
+)
+
+// WAF resources are either regional or attached to CloudFront, the latter being always listed from the global region
+var wafv2Scopes = []string{wafv2.ScopeRegional, wafv2.ScopeCloudfront}
+
+func wafv2Attributes(name *string, scope string) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":  *name,
+		"scope": scope,
+	}
+	// Make sure details are read with a provider configured on the global region
+	if scope == wafv2.ScopeCloudfront {
+		attrs["alias"] = repository.GlobalRegion
+	}
+	return attrs
+}
+
+type WAFV2WebACLEnumerator struct {
+	repository repository.WAFV2Repository
+	factory    resource.ResourceFactory
+}
+
+func NewWAFV2WebACLEnumerator(repo repository.WAFV2Repository, factory resource.ResourceFactory) *WAFV2WebACLEnumerator {
+	return &WAFV2WebACLEnumerator{
+		repository: repo,
+		factory:    factory,
+	}
+}
+
+func (e *WAFV2WebACLEnumerator) SupportedType() resource.ResourceType {
+	return aws.AwsWafv2WebAclResourceType
+}
+
+func (e *WAFV2WebACLEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	results := make([]*resource.Resource, 0)
+
+	for _, scope := range wafv2Scopes {
+		webACLs, err := e.repository.ListAllWebACLs(ctx, scope)
+		if err != nil {
+			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
+		}
+
+		for _, webACL := range webACLs {
+			results = append(
+				results,
+				e.factory.CreateAbstractResource(
+					string(e.SupportedType()),
+					*webACL.Id,
+					wafv2Attributes(webACL.Name, scope),
+				),
+			)
+		}
+	}
+
+	return results, nil
+}
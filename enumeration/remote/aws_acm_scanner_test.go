@@ -0,0 +1,94 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestACM(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.ACMRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockACMRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "certificates",
+			enumerator: func(repo repository.ACMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewACMCertificateEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockACMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllCertificates", mock.Anything).Return([]*acm.CertificateSummary{
+					{CertificateArn: awssdk.String("arn:aws:acm:us-east-1:123456789012:certificate/1")},
+					{CertificateArn: awssdk.String("arn:aws:acm:us-east-1:123456789012:certificate/2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "arn:aws:acm:us-east-1:123456789012:certificate/1", got[0].ResourceId())
+				assert.Equal(t, "arn:aws:acm:us-east-1:123456789012:certificate/2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsAcmCertificateResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list certificates",
+			enumerator: func(repo repository.ACMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewACMCertificateEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockACMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllCertificates", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsAcmCertificateResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAcmCertificateResourceType, resourceaws.AwsAcmCertificateResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockACMRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -34,7 +35,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "no api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{}, nil)
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,7 +44,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "multiple api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("1jitcobwol")},
 				}, nil)
@@ -61,7 +62,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 		{
 			test: "cannot list api gateway rest apis",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return(nil, dummyError)
+				repository.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -87,7 +88,7 @@ func TestApiGatewayRestApi(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -114,7 +115,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "no api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, nil)
+				repository.On("GetAccount", mock.Anything).Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -123,7 +124,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "empty api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(&apigateway.Account{}, nil)
+				repository.On("GetAccount", mock.Anything).Return(&apigateway.Account{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -135,7 +136,7 @@ func TestApiGatewayAccount(t *testing.T) {
 		{
 			test: "cannot get api gateway account",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("GetAccount").Return(nil, dummyError)
+				repository.On("GetAccount", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAccountResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAccountResourceType, resourceaws.AwsApiGatewayAccountResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAccountResourceType),
@@ -161,7 +162,7 @@ func TestApiGatewayAccount(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -188,7 +189,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "no api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{}, nil)
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -197,7 +198,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "multiple api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return([]*apigateway.ApiKey{
+				repository.On("ListAllApiKeys", mock.Anything).Return([]*apigateway.ApiKey{
 					{Id: awssdk.String("fuwnl8lrva")},
 					{Id: awssdk.String("9ge737dd45")},
 				}, nil)
@@ -215,7 +216,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 		{
 			test: "cannot list api gateway api keys",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApiKeys").Return(nil, dummyError)
+				repository.On("ListAllApiKeys", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayApiKeyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType, resourceaws.AwsApiGatewayApiKeyResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayApiKeyResourceType),
@@ -241,7 +242,7 @@ func TestApiGatewayApiKey(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -272,9 +273,9 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "no api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{}, nil).Once()
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -283,11 +284,11 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "multiple api gateway authorizers",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("ypcpde")},
 				}, nil).Once()
-				repo.On("ListAllRestApiAuthorizers", *apis[1].Id).Return([]*apigateway.Authorizer{
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[1].Id).Return([]*apigateway.Authorizer{
 					{Id: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -304,7 +305,7 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -312,8 +313,8 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiAuthorizers", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiAuthorizers", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayAuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType, resourceaws.AwsApiGatewayAuthorizerResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayAuthorizerResourceType),
@@ -339,7 +340,7 @@ func TestApiGatewayAuthorizer(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -369,8 +370,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "no api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -379,8 +380,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "multiple api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo")},
 					{StageName: awssdk.String("baz")},
 				}, nil)
@@ -398,7 +399,7 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -406,8 +407,8 @@ func TestApiGatewayStage(t *testing.T) {
 		{
 			test: "cannot list api gateway stages",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayStageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayStageResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayStageResourceType),
@@ -433,7 +434,7 @@ func TestApiGatewayStage(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -463,8 +464,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "no api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -473,8 +474,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "multiple api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("21zk4y"), Path: awssdk.String("/")},
 					{Id: awssdk.String("2ltv32p058"), Path: awssdk.String("/")},
 				}, nil)
@@ -492,7 +493,7 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -500,8 +501,8 @@ func TestApiGatewayResource(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayResourceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayResourceResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayResourceResourceType),
@@ -527,7 +528,7 @@ func TestApiGatewayResource(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -554,7 +555,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "no api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -563,7 +564,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "single api gateway domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example-driftctl.com")},
 				}, nil)
 			},
@@ -577,7 +578,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayDomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -603,7 +604,7 @@ func TestApiGatewayDomainName(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -630,7 +631,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "no api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -639,7 +640,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "single api gateway vpc link",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigateway.UpdateVpcLinkOutput{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigateway.UpdateVpcLinkOutput{
 					{Id: awssdk.String("ipu24n")},
 				}, nil)
 			},
@@ -653,7 +654,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway vpc links",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayVpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType, resourceaws.AwsApiGatewayVpcLinkResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayVpcLinkResourceType),
@@ -679,7 +680,7 @@ func TestApiGatewayVpcLink(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -709,8 +710,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "no api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -719,8 +720,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "multiple api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateRequestValidatorOutput{
 					{Id: awssdk.String("ywlcuf")},
 					{Id: awssdk.String("qmpbs8")},
 				}, nil)
@@ -738,7 +739,7 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -746,8 +747,8 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 		{
 			test: "cannot list api gateway request validators",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiRequestValidators", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiRequestValidators", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRequestValidatorResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType, resourceaws.AwsApiGatewayRequestValidatorResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayRequestValidatorResourceType),
@@ -773,7 +774,7 @@ func TestApiGatewayRequestValidator(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -800,7 +801,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "no api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("3of73v5ob4")},
 					{Id: awssdk.String("9x7kq9pbyh"), Policy: awssdk.String("")},
 				}, nil)
@@ -812,7 +813,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "multiple api gateway rest api policies",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRestApis").Return([]*apigateway.RestApi{
+				repository.On("ListAllRestApis", mock.Anything).Return([]*apigateway.RestApi{
 					{Id: awssdk.String("c3n3aqga5d"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:c3n3aqga5d/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 					{Id: awssdk.String("9y1eus3hr7"), Policy: awssdk.String("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"*\"},\"Action\":\"execute-api:Invoke\",\"Resource\":\"arn:aws:execute-api:us-east-1:111111111111:9y1eus3hr7/*\",\"Condition\":{\"IpAddress\":{\"aws:SourceIp\":\"123.123.123.123/32\"}}}]}")},
 				}, nil)
@@ -830,7 +831,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayRestApiPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayRestApiPolicyResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -856,7 +857,7 @@ func TestApiGatewayRestApiPolicy(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -886,8 +887,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "no domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -896,8 +897,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "multiple domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return([]*apigateway.BasePathMapping{
 					{BasePath: awssdk.String("foo")},
 					{BasePath: awssdk.String("(none)")},
 				}, nil)
@@ -915,7 +916,7 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "cannot list domain names",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(nil, dummyError)
+				repo.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -923,8 +924,8 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 		{
 			test: "cannot list domain name base path mappings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllDomainNames").Return(domainNames, nil)
-				repo.On("ListAllDomainNameBasePathMappings", *domainNames[0].DomainName).Return(nil, dummyError)
+				repo.On("ListAllDomainNames", mock.Anything).Return(domainNames, nil)
+				repo.On("ListAllDomainNameBasePathMappings", mock.Anything, *domainNames[0].DomainName).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayBasePathMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType, resourceaws.AwsApiGatewayBasePathMappingResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayBasePathMappingResourceType),
@@ -950,7 +951,7 @@ func TestApiGatewayBasePathMapping(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -980,8 +981,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "no api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -992,8 +993,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "multiple api gateway methods",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1017,7 +1018,7 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1025,8 +1026,8 @@ func TestApiGatewayMethod(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1052,7 +1053,7 @@ func TestApiGatewayMethod(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, scanOptions, testFilter))
+			s := remote.NewSortableScanner(NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter))
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1082,8 +1083,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "no api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1092,8 +1093,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "multiple api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return([]*apigateway.Model{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return([]*apigateway.Model{
 					{Id: awssdk.String("g68a4s")},
 					{Id: awssdk.String("85v536")},
 				}, nil)
@@ -1111,7 +1112,7 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1119,8 +1120,8 @@ func TestApiGatewayModel(t *testing.T) {
 		{
 			test: "cannot list api gateway models",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiModels", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiModels", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayModelResourceType, resourceaws.AwsApiGatewayModelResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayModelResourceType),
@@ -1146,7 +1147,7 @@ func TestApiGatewayModel(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1176,8 +1177,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "no api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1190,8 +1191,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "multiple api gateway method responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("hl7ksq"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {MethodResponses: map[string]*apigateway.MethodResponse{
 							"200": {},
@@ -1217,7 +1218,7 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1225,8 +1226,8 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1252,7 +1253,7 @@ func TestApiGatewayMethodResponse(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, scanOptions, testFilter))
+			s := remote.NewSortableScanner(NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter))
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1282,8 +1283,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "no api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1292,8 +1293,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "multiple api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return([]*apigateway.UpdateGatewayResponseOutput{
 					{ResponseType: awssdk.String("UNAUTHORIZED")},
 					{ResponseType: awssdk.String("ACCESS_DENIED")},
 				}, nil)
@@ -1311,7 +1312,7 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1319,8 +1320,8 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway gateway responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiGatewayResponses", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiGatewayResponses", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayGatewayResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType, resourceaws.AwsApiGatewayGatewayResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayGatewayResponseResourceType),
@@ -1346,7 +1347,7 @@ func TestApiGatewayGatewayResponse(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1376,8 +1377,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "no api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{}},
 				}, nil)
 			},
@@ -1388,8 +1389,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "multiple api gateway method settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return([]*apigateway.Stage{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return([]*apigateway.Stage{
 					{StageName: awssdk.String("foo"), MethodSettings: map[string]*apigateway.MethodSetting{
 						"*/*":        {},
 						"foo/GET":    {},
@@ -1413,7 +1414,7 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1421,8 +1422,8 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 		{
 			test: "cannot list api gateway settings",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiStages", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiStages", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayMethodSettingsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayMethodSettingsResourceType, resourceaws.AwsApiGatewayStageResourceType),
@@ -1448,7 +1449,7 @@ func TestApiGatewayMethodSettings(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, scanOptions, testFilter))
+			s := remote.NewSortableScanner(NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter))
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1478,8 +1479,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "no api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo")},
 				}, nil)
 			},
@@ -1490,8 +1491,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "multiple api gateway integrations",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET":    {},
 						"POST":   {},
@@ -1515,7 +1516,7 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1523,8 +1524,8 @@ func TestApiGatewayIntegration(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1550,7 +1551,7 @@ func TestApiGatewayIntegration(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, scanOptions, testFilter))
+			s := remote.NewSortableScanner(NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter))
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1580,8 +1581,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "no api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {},
 					}},
@@ -1594,8 +1595,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "multiple api gateway integration responses",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return([]*apigateway.Resource{
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return([]*apigateway.Resource{
 					{Id: awssdk.String("z9ag20"), Path: awssdk.String("/foo"), ResourceMethods: map[string]*apigateway.Method{
 						"GET": {
 							MethodIntegration: &apigateway.Integration{
@@ -1621,7 +1622,7 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list rest apis",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayRestApiResourceType),
@@ -1629,8 +1630,8 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway resources",
 			mocks: func(repo *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllRestApis").Return(apis, nil)
-				repo.On("ListAllRestApiResources", *apis[0].Id).Return(nil, dummyError)
+				repo.On("ListAllRestApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllRestApiResources", mock.Anything, *apis[0].Id).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayIntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayIntegrationResponseResourceType, resourceaws.AwsApiGatewayResourceResourceType),
@@ -1656,7 +1657,7 @@ func TestApiGatewayIntegrationResponse(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := remote.NewSortableScanner(NewScanner(remoteLibrary, alerter, scanOptions, testFilter))
+			s := remote.NewSortableScanner(NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter))
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
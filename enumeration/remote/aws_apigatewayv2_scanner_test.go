@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -35,7 +36,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +45,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "single api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("f5vdrg12tk")},
 				}, nil)
 			},
@@ -58,7 +59,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ApiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -84,7 +85,7 @@ func TestApiGatewayV2Api(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -111,7 +112,7 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -120,10 +121,10 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single route",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("a-gateway")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Route{{
 						RouteId:  awssdk.String("a-route"),
 						RouteKey: awssdk.String("POST /an-example"),
@@ -144,7 +145,7 @@ func TestApiGatewayV2Route(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -170,7 +171,7 @@ func TestApiGatewayV2Route(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -197,10 +198,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single deployment",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{{
 						DeploymentId: awssdk.String("a-deployment"),
 					}}, nil)
@@ -217,7 +218,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "no API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -226,10 +227,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "single API gateway with no deployments",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).
 					Return([]*apigatewayv2.Deployment{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -239,7 +240,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "error listing API gateways",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -247,10 +248,10 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 		{
 			test: "error listing deployments of an API",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiDeployments", awssdk.String("a-gateway")).Return(nil, dummyError)
+				repository.On("ListAllApiDeployments", mock.Anything, awssdk.String("a-gateway")).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DeploymentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DeploymentResourceType, resourceaws.AwsApiGatewayV2DeploymentResourceType),
@@ -276,7 +277,7 @@ func TestApiGatewayV2Deployment(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -303,7 +304,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "no api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{}, nil)
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -312,7 +313,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "single api gateway v2 vpc link",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return([]*apigatewayv2.VpcLink{
+				repository.On("ListAllVpcLinks", mock.Anything).Return([]*apigatewayv2.VpcLink{
 					{VpcLinkId: awssdk.String("b8r351")},
 				}, nil)
 			},
@@ -326,7 +327,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 vpc links",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVpcLinks").Return(nil, dummyError)
+				repository.On("ListAllVpcLinks", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2VpcLinkResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType, resourceaws.AwsApiGatewayV2VpcLinkResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2VpcLinkResourceType),
@@ -352,7 +353,7 @@ func TestApiGatewayV2VpcLink(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -384,9 +385,9 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "no api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -395,11 +396,11 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "multiple api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("xaappu")},
 				}, nil).Once()
-				repo.On("ListAllApiAuthorizers", *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Authorizer{
 					{AuthorizerId: awssdk.String("bwhebj")},
 				}, nil).Once()
 			},
@@ -416,7 +417,7 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -424,8 +425,8 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 authorizers",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiAuthorizers", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiAuthorizers", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2AuthorizerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType, resourceaws.AwsApiGatewayV2AuthorizerResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2AuthorizerResourceType),
@@ -451,7 +452,7 @@ func TestApiGatewayV2Authorizer(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -483,9 +484,9 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "no api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -494,14 +495,14 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "multiple api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("xaappu"),
 						IntegrationType: awssdk.String("MOCK"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiIntegrations", *apis[1].ApiId).Return([]*apigatewayv2.Integration{
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Integration{
 					{
 						IntegrationId:   awssdk.String("bwhebj"),
 						IntegrationType: awssdk.String("MOCK"),
@@ -521,7 +522,7 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -529,8 +530,8 @@ func TestApiGatewayV2Integration(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiIntegrations", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiIntegrations", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType),
@@ -556,7 +557,7 @@ func TestApiGatewayV2Integration(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -588,9 +589,9 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "no api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{}, nil).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -599,14 +600,14 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "multiple api gateway v2 models",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("vdw6up"),
 						Name:    awssdk.String("model1"),
 					},
 				}, nil).Once()
-				repo.On("ListAllApiModels", *apis[1].ApiId).Return([]*apigatewayv2.Model{
+				repo.On("ListAllApiModels", mock.Anything, *apis[1].ApiId).Return([]*apigatewayv2.Model{
 					{
 						ModelId: awssdk.String("bwhebj"),
 						Name:    awssdk.String("model2"),
@@ -629,7 +630,7 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "cannot list apis",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -637,8 +638,8 @@ func TestApiGatewayV2Model(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 model",
 			mocks: func(repo *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllApis").Return(apis, nil)
-				repo.On("ListAllApiModels", *apis[0].ApiId).Return(nil, dummyError)
+				repo.On("ListAllApis", mock.Anything).Return(apis, nil)
+				repo.On("ListAllApiModels", mock.Anything, *apis[0].ApiId).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2ModelResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType, resourceaws.AwsApiGatewayV2ModelResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2ModelResourceType),
@@ -664,7 +665,7 @@ func TestApiGatewayV2Model(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -691,7 +692,7 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "no api gateway v2 api",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{}, nil)
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -700,10 +701,10 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "single api gateway v2 api with a single stage",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("a-gateway")},
 				}, nil)
-				repository.On("ListAllApiStages", "a-gateway").
+				repository.On("ListAllApiStages", mock.Anything, "a-gateway").
 					Return([]*apigatewayv2.Stage{{
 						StageName: awssdk.String("a-stage"),
 					}}, nil)
@@ -718,7 +719,7 @@ func TestApiGatewayV2Stage(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2StageResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2StageResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2StageResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -744,7 +745,7 @@ func TestApiGatewayV2Stage(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -771,14 +772,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "no api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -788,14 +789,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 route with one route response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").
 					Return([]*apigatewayv2.RouteResponse{
 						{RouteResponseId: awssdk.String("nbw7vw")},
 					}, nil)
@@ -810,7 +811,7 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -818,10 +819,10 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 routes",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).Return(nil, dummyError)
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResourceType),
@@ -829,14 +830,14 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 route responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("77ooqulkke")},
 				}, nil)
-				repository.On("ListAllApiRoutes", awssdk.String("77ooqulkke")).
+				repository.On("ListAllApiRoutes", mock.Anything, awssdk.String("77ooqulkke")).
 					Return([]*apigatewayv2.Route{
 						{RouteId: awssdk.String("liqc5u4")},
 					}, nil)
-				repository.On("ListAllApiRouteResponses", "77ooqulkke", "liqc5u4").Return(nil, dummyError)
+				repository.On("ListAllApiRouteResponses", mock.Anything, "77ooqulkke", "liqc5u4").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2RouteResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2RouteResponseResourceType, resourceaws.AwsApiGatewayV2RouteResponseResourceType),
@@ -862,7 +863,7 @@ func TestApiGatewayV2RouteResponse(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -889,7 +890,7 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "no api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -898,10 +899,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "single api gateway v2 domain with a single mapping",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{{
 						Stage:        awssdk.String("a-stage"),
 						ApiId:        awssdk.String("foobar"),
@@ -918,7 +919,7 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domains",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return(nil, dummyError)
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayDomainNameResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayDomainNameResourceType),
@@ -926,10 +927,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 mappings",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2MappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2MappingResourceType, resourceaws.AwsApiGatewayV2MappingResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -938,10 +939,10 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 		{
 			test: "returning mapping with invalid attributes",
 			mocks: func(repositoryV1 *repository.MockApiGatewayRepository, repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repositoryV1.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repositoryV1.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("example.com")},
 				}, nil)
-				repository.On("ListAllApiMappings", "example.com").
+				repository.On("ListAllApiMappings", mock.Anything, "example.com").
 					Return([]*apigatewayv2.ApiMapping{
 						{
 							ApiMappingId: awssdk.String("barfoo"),
@@ -983,7 +984,7 @@ func TestApiGatewayV2Mapping(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1011,7 +1012,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "no api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{}, nil)
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -1020,7 +1021,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "single api gateway v2 domain name",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return([]*apigateway.DomainName{
+				repository.On("ListAllDomainNames", mock.Anything).Return([]*apigateway.DomainName{
 					{DomainName: awssdk.String("b8r351.example.com")},
 				}, nil)
 			},
@@ -1034,7 +1035,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 domain names",
 			mocks: func(repository *repository.MockApiGatewayRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDomainNames").Return(nil, dummyError)
+				repository.On("ListAllDomainNames", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2DomainNameResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType, resourceaws.AwsApiGatewayV2DomainNameResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsApiGatewayV2DomainNameResourceType),
@@ -1060,7 +1061,7 @@ func TestApiGatewayV2DomainName(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1087,14 +1088,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "no api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -1104,14 +1105,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "single api gateway v2 integration with one integration response",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").
 					Return([]*apigatewayv2.IntegrationResponse{
 						{IntegrationResponseId: awssdk.String("sf67ti7")},
 					}, nil)
@@ -1126,7 +1127,7 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 apis",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return(nil, dummyError)
+				repository.On("ListAllApis", mock.Anything).Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2ApiResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2ApiResourceType),
@@ -1134,10 +1135,10 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integrations",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").Return(nil, dummyError)
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResourceType),
@@ -1145,14 +1146,14 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 		{
 			test: "cannot list api gateway v2 integration responses",
 			mocks: func(repository *repository.MockApiGatewayV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllApis").Return([]*apigatewayv2.Api{
+				repository.On("ListAllApis", mock.Anything).Return([]*apigatewayv2.Api{
 					{ApiId: awssdk.String("yw28nwdf34")},
 				}, nil)
-				repository.On("ListAllApiIntegrations", "yw28nwdf34").
+				repository.On("ListAllApiIntegrations", mock.Anything, "yw28nwdf34").
 					Return([]*apigatewayv2.Integration{
 						{IntegrationId: awssdk.String("fmezvlh")},
 					}, nil)
-				repository.On("ListAllApiIntegrationResponses", "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
+				repository.On("ListAllApiIntegrationResponses", mock.Anything, "yw28nwdf34", "fmezvlh").Return(nil, dummyError)
 				alerter.On("SendAlert", resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType), alerts.EnumerationPhase)).Return()
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType, resourceaws.AwsApiGatewayV2IntegrationResponseResourceType),
@@ -1178,7 +1179,7 @@ func TestApiGatewayV2IntegrationResponse(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
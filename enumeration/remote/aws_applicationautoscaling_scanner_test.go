@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -37,9 +38,9 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			test:    "should return one target",
 			dirName: "aws_appautoscaling_target_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
+				client.On("DescribeScalableTargets", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalableTarget{
 					{
 						ResourceId:        awssdk.String("table/GameScores"),
 						RoleARN:           awssdk.String("arn:aws:iam::533948124879:role/aws-service-role/dynamodb.application-autoscaling.amazonaws.com/AWSServiceRoleForApplicationAutoScaling_DynamoDBTable"),
@@ -50,7 +51,7 @@ func TestAppAutoScalingTarget(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalableTarget{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			wantErr: nil,
 		},
@@ -58,9 +59,9 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_target_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalableTargets", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalableTargets", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
 			},
 			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingTargetResourceType),
 		},
@@ -111,7 +112,7 @@ func TestAppAutoScalingTarget(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			if err != nil {
 				assert.EqualError(tt, c.wantErr, err.Error())
@@ -140,9 +141,9 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			test:    "should return one policy",
 			dirName: "aws_appautoscaling_policy_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
+				client.On("DescribeScalingPolicies", mock.Anything, "dynamodb").Return([]*applicationautoscaling.ScalingPolicy{
 					{
 						PolicyName:        awssdk.String("DynamoDBReadCapacityUtilization:table/GameScores"),
 						ResourceId:        awssdk.String("table/GameScores"),
@@ -151,7 +152,7 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return([]*applicationautoscaling.ScalingPolicy{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			wantErr: nil,
 		},
@@ -159,9 +160,9 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			test:    "should return remote error",
 			dirName: "aws_appautoscaling_policy_single",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScalingPolicies", mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
+				client.On("DescribeScalingPolicies", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("remote error")).Once()
 			},
 			wantErr: remoteerror.NewResourceListingError(errors.New("remote error"), resourceaws.AwsAppAutoscalingPolicyResourceType),
 		},
@@ -212,7 +213,7 @@ func TestAppAutoScalingPolicy(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			if err != nil {
 				assert.EqualError(tt, c.wantErr, err.Error())
@@ -251,9 +252,9 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 					return false
 				}
 
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{
 					{
 						ScheduledActionName: awssdk.String("action"),
 						ResourceId:          awssdk.String("table/GameScores"),
@@ -262,7 +263,7 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 					},
 				}, nil).Once()
 
-				client.On("DescribeScheduledActions", mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
+				client.On("DescribeScheduledActions", mock.Anything, mock.MatchedBy(matchServiceNamespaceFunc)).Return([]*applicationautoscaling.ScheduledAction{}, nil).Times(len(applicationautoscaling.ServiceNamespace_Values()) - 1)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 1)
@@ -274,9 +275,9 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 		{
 			test: "should return remote error",
 			mocks: func(client *repository.MockAppAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				client.On("ServiceNamespaceValues").Return(applicationautoscaling.ServiceNamespace_Values()).Once()
+				client.On("ServiceNamespaceValues", mock.Anything).Return(applicationautoscaling.ServiceNamespace_Values()).Once()
 
-				client.On("DescribeScheduledActions", mock.AnythingOfType("string")).Return(nil, dummyError).Once()
+				client.On("DescribeScheduledActions", mock.Anything, mock.AnythingOfType("string")).Return(nil, dummyError).Once()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -304,7 +305,7 @@ func TestAppAutoScalingScheduledAction(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
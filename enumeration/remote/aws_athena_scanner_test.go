@@ -0,0 +1,127 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAthenaWorkgroup(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockAthenaRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no athena workgroups",
+			dirName: "aws_athena_workgroup_empty",
+			mocks: func(client *repository.MockAthenaRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllWorkGroups", mock.Anything).Return([]*athena.WorkGroupSummary{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple athena workgroups ignoring primary",
+			dirName: "aws_athena_workgroup_multiple",
+			mocks: func(client *repository.MockAthenaRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllWorkGroups", mock.Anything).Return([]*athena.WorkGroupSummary{
+					{Name: awssdk.String("primary")},
+					{Name: awssdk.String("analysts")},
+					{Name: awssdk.String("reporting")},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list athena workgroups",
+			dirName: "aws_athena_workgroup_empty",
+			mocks: func(client *repository.MockAthenaRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllWorkGroups", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsAthenaWorkgroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAthenaWorkgroupResourceType, resourceaws.AwsAthenaWorkgroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockAthenaRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.AthenaRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewAthenaRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewAthenaWorkgroupEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsAthenaWorkgroupResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsAthenaWorkgroupResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsAthenaWorkgroupResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
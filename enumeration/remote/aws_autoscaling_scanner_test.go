@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -33,7 +34,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 		{
 			test: "no launch configuration",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchConfigurations").Return([]*autoscaling.LaunchConfiguration{}, nil)
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return([]*autoscaling.LaunchConfiguration{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -42,7 +43,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 		{
 			test: "multiple launch configurations",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchConfigurations").Return([]*autoscaling.LaunchConfiguration{
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return([]*autoscaling.LaunchConfiguration{
 					{LaunchConfigurationName: awssdk.String("web_config_1")},
 					{LaunchConfigurationName: awssdk.String("web_config_2")},
 				}, nil)
@@ -61,7 +62,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 			test: "cannot list launch configurations",
 			mocks: func(repository *repository.MockAutoScalingRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("DescribeLaunchConfigurations").Return(nil, awsError)
+				repository.On("DescribeLaunchConfigurations", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLaunchConfigurationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLaunchConfigurationResourceType, resourceaws.AwsLaunchConfigurationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -92,7 +93,7 @@ func TestAutoscaling_LaunchConfiguration(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
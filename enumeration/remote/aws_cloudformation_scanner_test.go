@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -39,14 +40,14 @@ func TestCloudformationStack(t *testing.T) {
 			test:    "no cloudformation stacks",
 			dirName: "aws_cloudformation_stack_empty",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStacks").Return([]*cloudformation.Stack{}, nil)
+				repository.On("ListAllStacks", mock.Anything).Return([]*cloudformation.Stack{}, nil)
 			},
 		},
 		{
 			test:    "multiple cloudformation stacks",
 			dirName: "aws_cloudformation_stack_multiple",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllStacks").Return([]*cloudformation.Stack{
+				repository.On("ListAllStacks", mock.Anything).Return([]*cloudformation.Stack{
 					{StackId: awssdk.String("arn:aws:cloudformation:us-east-1:047081014315:stack/bar-stack/c7a96e70-0f21-11ec-bd2a-0a2d95c2b2ab")},
 					{StackId: awssdk.String("arn:aws:cloudformation:us-east-1:047081014315:stack/foo-stack/c7aa0ab0-0f21-11ec-ba25-129d8c0b3757")},
 				}, nil)
@@ -57,7 +58,7 @@ func TestCloudformationStack(t *testing.T) {
 			dirName: "aws_cloudformation_stack_list",
 			mocks: func(repository *repository.MockCloudformationRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				repository.On("ListAllStacks").Return(nil, awsError)
+				repository.On("ListAllStacks", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsCloudformationStackResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudformationStackResourceType, resourceaws.AwsCloudformationStackResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -110,7 +111,7 @@ func TestCloudformationStack(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,14 +40,14 @@ func TestCloudfrontDistribution(t *testing.T) {
 			test:    "no cloudfront distributions",
 			dirName: "aws_cloudfront_distribution_empty",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{}, nil)
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{}, nil)
 			},
 		},
 		{
 			test:    "single cloudfront distribution",
 			dirName: "aws_cloudfront_distribution_single",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDistributions").Return([]*cloudfront.DistributionSummary{
+				repository.On("ListAllDistributions", mock.Anything).Return([]*cloudfront.DistributionSummary{
 					{Id: awssdk.String("E1M9CNS0XSHI19")},
 				}, nil)
 			},
@@ -56,7 +57,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			dirName: "aws_cloudfront_distribution_list",
 			mocks: func(repository *repository.MockCloudfrontRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				repository.On("ListAllDistributions").Return(nil, awsError)
+				repository.On("ListAllDistributions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsCloudfrontDistributionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudfrontDistributionResourceType, resourceaws.AwsCloudfrontDistributionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -109,7 +110,7 @@ func TestCloudfrontDistribution(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -0,0 +1,173 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudwatchEvents(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	eventBuses := []*cloudwatchevents.EventBus{
+		{Name: awssdk.String("default")},
+		{Name: awssdk.String("orders")},
+	}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CloudwatchEventsRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCloudwatchEventsRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "event buses",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventBusEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "orders", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventBusResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list event buses",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventBusEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventBusResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventBusResourceType, resourceaws.AwsCloudwatchEventBusResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "rules",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventRuleEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repo.On("ListAllRules", mock.Anything, "default").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+					{Name: awssdk.String("AutoScalingManagedRule"), ManagedBy: awssdk.String("autoscaling.amazonaws.com")},
+				}, nil)
+				repo.On("ListAllRules", mock.Anything, "orders").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("created")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "nightly", got[0].ResourceId())
+				assert.Equal(t, "default", *got[0].Attributes().GetString("event_bus_name"))
+				assert.Equal(t, "orders/created", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventRuleResourceType, got[1].ResourceType())
+			},
+		},
+		{
+			test: "cannot list event buses of rules",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventRuleEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventRuleResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventRuleResourceType, resourceaws.AwsCloudwatchEventBusResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "targets",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventTargetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(eventBuses, nil)
+				repo.On("ListAllRules", mock.Anything, "default").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("nightly")},
+				}, nil)
+				repo.On("ListAllRules", mock.Anything, "orders").Return([]*cloudwatchevents.Rule{
+					{Name: awssdk.String("created")},
+				}, nil)
+				repo.On("ListAllTargets", mock.Anything, "default", "nightly").Return([]*cloudwatchevents.Target{
+					{Id: awssdk.String("cleanup")},
+				}, nil)
+				repo.On("ListAllTargets", mock.Anything, "orders", "created").Return([]*cloudwatchevents.Target{
+					{Id: awssdk.String("notify")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "nightly-cleanup", got[0].ResourceId())
+				assert.Equal(t, "orders/created-notify", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchEventTargetResourceType, got[1].ResourceType())
+				assert.Equal(t, "created", *got[1].Attributes().GetString("rule"))
+				assert.Equal(t, "notify", *got[1].Attributes().GetString("target_id"))
+			},
+		},
+		{
+			test: "cannot list rules of targets",
+			enumerator: func(repo repository.CloudwatchEventsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchEventTargetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchEventsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEventBuses", mock.Anything).Return(eventBuses[:1], nil)
+				repo.On("ListAllRules", mock.Anything, "default").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchEventTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchEventTargetResourceType, resourceaws.AwsCloudwatchEventRuleResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchEventsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
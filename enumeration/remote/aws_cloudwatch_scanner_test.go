@@ -0,0 +1,258 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudwatchLogs(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	logGroups := []*cloudwatchlogs.LogGroup{
+		{LogGroupName: awssdk.String("application")},
+		{LogGroupName: awssdk.String("/aws/lambda/foo")},
+	}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CloudwatchLogsRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCloudwatchLogsRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "log groups",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLogGroups", mock.Anything).Return(logGroups, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "application", got[0].ResourceId())
+				assert.Equal(t, "/aws/lambda/foo", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogGroupResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list log groups",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLogGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogGroupResourceType, resourceaws.AwsCloudwatchLogGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "metric filters",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogMetricFilterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllMetricFilters", mock.Anything).Return([]*cloudwatchlogs.MetricFilter{
+					{FilterName: awssdk.String("errors"), LogGroupName: awssdk.String("application")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "errors", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogMetricFilterResourceType, got[0].ResourceType())
+				assert.Equal(t, "application", *got[0].Attributes().GetString("log_group_name"))
+			},
+		},
+		{
+			test: "cannot list metric filters",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogMetricFilterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllMetricFilters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogMetricFilterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogMetricFilterResourceType, resourceaws.AwsCloudwatchLogMetricFilterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "subscription filters",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogSubscriptionFilterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLogGroups", mock.Anything).Return(logGroups, nil)
+				repo.On("ListAllSubscriptionFilters", mock.Anything, "application").Return([]*cloudwatchlogs.SubscriptionFilter{
+					{FilterName: awssdk.String("to-kinesis"), LogGroupName: awssdk.String("application")},
+				}, nil)
+				repo.On("ListAllSubscriptionFilters", mock.Anything, "/aws/lambda/foo").Return([]*cloudwatchlogs.SubscriptionFilter{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, resourceaws.CreateCloudwatchLogSubscriptionFilterID("application"), got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchLogSubscriptionFilterResourceType, got[0].ResourceType())
+				assert.Equal(t, "to-kinesis", *got[0].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list log groups of subscription filters",
+			enumerator: func(repo repository.CloudwatchLogsRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchLogSubscriptionFilterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchLogsRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLogGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchLogSubscriptionFilterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchLogSubscriptionFilterResourceType, resourceaws.AwsCloudwatchLogGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchLogsRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCloudwatch(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CloudwatchRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCloudwatchRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "metric alarms",
+			enumerator: func(repo repository.CloudwatchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchMetricAlarmEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllMetricAlarms", mock.Anything).Return([]*cloudwatch.MetricAlarm{
+					{AlarmName: awssdk.String("cpu-high")},
+					{AlarmName: awssdk.String("errors")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "cpu-high", got[0].ResourceId())
+				assert.Equal(t, "errors", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchMetricAlarmResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list metric alarms",
+			enumerator: func(repo repository.CloudwatchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchMetricAlarmEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllMetricAlarms", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchMetricAlarmResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchMetricAlarmResourceType, resourceaws.AwsCloudwatchMetricAlarmResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "dashboards",
+			enumerator: func(repo repository.CloudwatchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchDashboardEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDashboards", mock.Anything).Return([]*cloudwatch.DashboardEntry{
+					{DashboardName: awssdk.String("overview")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "overview", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsCloudwatchDashboardResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list dashboards",
+			enumerator: func(repo repository.CloudwatchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCloudwatchDashboardEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCloudwatchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDashboards", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCloudwatchDashboardResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCloudwatchDashboardResourceType, resourceaws.AwsCloudwatchDashboardResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCloudwatchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
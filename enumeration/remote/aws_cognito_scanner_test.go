@@ -0,0 +1,303 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCognitoUserPool(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CognitoIdentityProviderRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCognitoIdentityProviderRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no user pool",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return([]*cognitoidentityprovider.UserPoolDescriptionType{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple user pools",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("eu-west-3_AbCdEf123"), Name: awssdk.String("test-app-pool")},
+					{Id: awssdk.String("eu-west-3_GhIjKl456"), Name: awssdk.String("shadow-pool")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "eu-west-3_AbCdEf123", got[0].ResourceId())
+				assert.Equal(t, "eu-west-3_GhIjKl456", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list user pools",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolResourceType, resourceaws.AwsCognitoUserPoolResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCognitoIdentityProviderRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCognitoUserPoolClient(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CognitoIdentityProviderRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCognitoIdentityProviderRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no user pool client",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolClientEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("eu-west-3_AbCdEf123"), Name: awssdk.String("test-app-pool")},
+					{Id: awssdk.String("eu-west-3_GhIjKl456"), Name: awssdk.String("shadow-pool")},
+				}, nil)
+				repo.On("ListAllUserPoolClients", mock.Anything, "eu-west-3_AbCdEf123").Return([]*cognitoidentityprovider.UserPoolClientDescription{}, nil)
+				repo.On("ListAllUserPoolClients", mock.Anything, "eu-west-3_GhIjKl456").Return([]*cognitoidentityprovider.UserPoolClientDescription{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple user pool clients",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolClientEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("eu-west-3_AbCdEf123"), Name: awssdk.String("test-app-pool")},
+					{Id: awssdk.String("eu-west-3_GhIjKl456"), Name: awssdk.String("shadow-pool")},
+				}, nil)
+				repo.On("ListAllUserPoolClients", mock.Anything, "eu-west-3_AbCdEf123").Return([]*cognitoidentityprovider.UserPoolClientDescription{
+					{ClientId: awssdk.String("1example23456789"), ClientName: awssdk.String("web"), UserPoolId: awssdk.String("eu-west-3_AbCdEf123")},
+				}, nil)
+				repo.On("ListAllUserPoolClients", mock.Anything, "eu-west-3_GhIjKl456").Return([]*cognitoidentityprovider.UserPoolClientDescription{
+					{ClientId: awssdk.String("2example98765432"), ClientName: awssdk.String("mobile"), UserPoolId: awssdk.String("eu-west-3_GhIjKl456")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "1example23456789", got[0].ResourceId())
+				assert.Equal(t, "2example98765432", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoUserPoolClientResourceType, got[0].ResourceType())
+				assert.Equal(t, resource.Attributes{"user_pool_id": "eu-west-3_AbCdEf123"}, *got[0].Attributes())
+				assert.Equal(t, resource.Attributes{"user_pool_id": "eu-west-3_GhIjKl456"}, *got[1].Attributes())
+			},
+		},
+		{
+			test: "cannot list user pools",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolClientEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolClientResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolClientResourceType, resourceaws.AwsCognitoUserPoolResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list user pool clients",
+			enumerator: func(repo repository.CognitoIdentityProviderRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoUserPoolClientEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityProviderRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllUserPools", mock.Anything).Return([]*cognitoidentityprovider.UserPoolDescriptionType{
+					{Id: awssdk.String("eu-west-3_AbCdEf123"), Name: awssdk.String("test-app-pool")},
+					{Id: awssdk.String("eu-west-3_GhIjKl456"), Name: awssdk.String("shadow-pool")},
+				}, nil)
+				repo.On("ListAllUserPoolClients", mock.Anything, "eu-west-3_AbCdEf123").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCognitoUserPoolClientResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoUserPoolClientResourceType, resourceaws.AwsCognitoUserPoolClientResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCognitoIdentityProviderRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestCognitoIdentityPool(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.CognitoIdentityRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockCognitoIdentityRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no identity pool",
+			enumerator: func(repo repository.CognitoIdentityRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoIdentityPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllIdentityPools", mock.Anything).Return([]*cognitoidentity.IdentityPoolShortDescription{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple identity pools",
+			enumerator: func(repo repository.CognitoIdentityRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoIdentityPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllIdentityPools", mock.Anything).Return([]*cognitoidentity.IdentityPoolShortDescription{
+					{IdentityPoolId: awssdk.String("eu-west-3:11111111-2222-3333-4444-555555555555"), IdentityPoolName: awssdk.String("first")},
+					{IdentityPoolId: awssdk.String("eu-west-3:66666666-7777-8888-9999-000000000000"), IdentityPoolName: awssdk.String("second")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "eu-west-3:11111111-2222-3333-4444-555555555555", got[0].ResourceId())
+				assert.Equal(t, "eu-west-3:66666666-7777-8888-9999-000000000000", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsCognitoIdentityPoolResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list identity pools",
+			enumerator: func(repo repository.CognitoIdentityRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewCognitoIdentityPoolEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockCognitoIdentityRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllIdentityPools", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsCognitoIdentityPoolResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsCognitoIdentityPoolResourceType, resourceaws.AwsCognitoIdentityPoolResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockCognitoIdentityRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
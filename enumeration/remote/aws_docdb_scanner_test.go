@@ -0,0 +1,137 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDocDB(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.DocDBRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockDocDBRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no cluster",
+			enumerator: func(repo repository.DocDBRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewDocDBClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockDocDBRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDBClusters", mock.Anything).Return([]*docdb.DBCluster{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple clusters",
+			enumerator: func(repo repository.DocDBRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewDocDBClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockDocDBRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDBClusters", mock.Anything).Return([]*docdb.DBCluster{
+					{DBClusterIdentifier: awssdk.String("docdb-cluster-1")},
+					{DBClusterIdentifier: awssdk.String("docdb-cluster-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "docdb-cluster-1", got[0].ResourceId())
+				assert.Equal(t, "docdb-cluster-2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsDocDBClusterResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			enumerator: func(repo repository.DocDBRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewDocDBClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockDocDBRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDBClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsDocDBClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDocDBClusterResourceType, resourceaws.AwsDocDBClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple parameter groups ignoring default and rds ones",
+			enumerator: func(repo repository.DocDBRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewDocDBClusterParameterGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockDocDBRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDBClusterParameterGroups", mock.Anything).Return([]*docdb.DBClusterParameterGroup{
+					{DBClusterParameterGroupName: awssdk.String("default.docdb4.0"), DBParameterGroupFamily: awssdk.String("docdb4.0")},
+					{DBClusterParameterGroupName: awssdk.String("aurora-params"), DBParameterGroupFamily: awssdk.String("aurora-mysql5.7")},
+					{DBClusterParameterGroupName: awssdk.String("docdb-params"), DBParameterGroupFamily: awssdk.String("docdb4.0")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "docdb-params", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsDocDBClusterParameterGroupResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list parameter groups",
+			enumerator: func(repo repository.DocDBRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewDocDBClusterParameterGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockDocDBRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDBClusterParameterGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsDocDBClusterParameterGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDocDBClusterParameterGroupResourceType, resourceaws.AwsDocDBClusterParameterGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockDocDBRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
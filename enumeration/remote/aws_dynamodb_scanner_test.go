@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -38,7 +39,7 @@ func TestDynamoDBTable(t *testing.T) {
 			test:    "no DynamoDB Table",
 			dirName: "aws_dynamodb_table_empty",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{}, nil)
+				client.On("ListAllTables", mock.Anything).Return([]*string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -46,7 +47,7 @@ func TestDynamoDBTable(t *testing.T) {
 			test:    "Multiple DynamoDB Table",
 			dirName: "aws_dynamodb_table_multiple",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTables").Return([]*string{
+				client.On("ListAllTables", mock.Anything).Return([]*string{
 					awssdk.String("GameScores"),
 					awssdk.String("example"),
 				}, nil)
@@ -58,7 +59,7 @@ func TestDynamoDBTable(t *testing.T) {
 			dirName: "aws_dynamodb_table_list",
 			mocks: func(client *repository.MockDynamoDBRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				client.On("ListAllTables").Return(nil, awsError)
+				client.On("ListAllTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDynamodbTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDynamodbTableResourceType, resourceaws.AwsDynamodbTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -111,7 +112,7 @@ func TestDynamoDBTable(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
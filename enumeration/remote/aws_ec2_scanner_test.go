@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,14 +40,14 @@ func TestEC2EbsVolume(t *testing.T) {
 			test:    "no volumes",
 			dirName: "aws_ec2_ebs_volume_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVolumes").Return([]*ec2.Volume{}, nil)
+				repository.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{}, nil)
 			},
 		},
 		{
 			test:    "multiple volumes",
 			dirName: "aws_ec2_ebs_volume_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllVolumes").Return([]*ec2.Volume{
+				repository.On("ListAllVolumes", mock.Anything).Return([]*ec2.Volume{
 					{VolumeId: awssdk.String("vol-081c7272a57a09db1")},
 					{VolumeId: awssdk.String("vol-01ddc91d3d9d1318b")},
 				}, nil)
@@ -57,7 +58,7 @@ func TestEC2EbsVolume(t *testing.T) {
 			dirName: "aws_ec2_ebs_volume_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllVolumes").Return(nil, awsError)
+				repository.On("ListAllVolumes", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsVolumeResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsVolumeResourceType, resourceaws.AwsEbsVolumeResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -110,7 +111,7 @@ func TestEC2EbsVolume(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -134,14 +135,14 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			test:    "no snapshots",
 			dirName: "aws_ec2_ebs_snapshot_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSnapshots").Return([]*ec2.Snapshot{}, nil)
+				repository.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{}, nil)
 			},
 		},
 		{
 			test:    "multiple snapshots",
 			dirName: "aws_ec2_ebs_snapshot_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSnapshots").Return([]*ec2.Snapshot{
+				repository.On("ListAllSnapshots", mock.Anything).Return([]*ec2.Snapshot{
 					{SnapshotId: awssdk.String("snap-0c509a2a880d95a39")},
 					{SnapshotId: awssdk.String("snap-00672558cecd93a61")},
 				}, nil)
@@ -152,7 +153,7 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			dirName: "aws_ec2_ebs_snapshot_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSnapshots").Return(nil, awsError)
+				repository.On("ListAllSnapshots", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsSnapshotResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsSnapshotResourceType, resourceaws.AwsEbsSnapshotResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -205,7 +206,7 @@ func TestEC2EbsSnapshot(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -229,7 +230,7 @@ func TestEC2Eip(t *testing.T) {
 			test:    "no eips",
 			dirName: "aws_ec2_eip_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddresses").Return([]*ec2.Address{
+				repository.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{
 					{}, // Test Eip without AllocationId because it can happen (seen in sentry)
 				}, nil)
 			},
@@ -238,7 +239,7 @@ func TestEC2Eip(t *testing.T) {
 			test:    "multiple eips",
 			dirName: "aws_ec2_eip_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddresses").Return([]*ec2.Address{
+				repository.On("ListAllAddresses", mock.Anything).Return([]*ec2.Address{
 					{AllocationId: awssdk.String("eipalloc-017d5267e4dda73f1")},
 					{AllocationId: awssdk.String("eipalloc-0cf714dc097c992cc")},
 				}, nil)
@@ -249,7 +250,7 @@ func TestEC2Eip(t *testing.T) {
 			dirName: "aws_ec2_eip_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllAddresses").Return(nil, awsError)
+				repository.On("ListAllAddresses", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEipResourceType, resourceaws.AwsEipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -302,7 +303,7 @@ func TestEC2Eip(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -326,14 +327,14 @@ func TestEC2Ami(t *testing.T) {
 			test:    "no amis",
 			dirName: "aws_ec2_ami_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*ec2.Image{}, nil)
+				repository.On("ListAllImages", mock.Anything).Return([]*ec2.Image{}, nil)
 			},
 		},
 		{
 			test:    "multiple amis",
 			dirName: "aws_ec2_ami_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*ec2.Image{
+				repository.On("ListAllImages", mock.Anything).Return([]*ec2.Image{
 					{ImageId: awssdk.String("ami-03a578b46f4c3081b")},
 					{ImageId: awssdk.String("ami-025962fd8b456731f")},
 				}, nil)
@@ -344,7 +345,7 @@ func TestEC2Ami(t *testing.T) {
 			dirName: "aws_ec2_ami_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllImages").Return(nil, awsError)
+				repository.On("ListAllImages", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsAmiResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsAmiResourceType, resourceaws.AwsAmiResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -397,7 +398,7 @@ func TestEC2Ami(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -421,14 +422,14 @@ func TestEC2KeyPair(t *testing.T) {
 			test:    "no key pairs",
 			dirName: "aws_ec2_key_pair_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{}, nil)
+				repository.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{}, nil)
 			},
 		},
 		{
 			test:    "multiple key pairs",
 			dirName: "aws_ec2_key_pair_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeyPairs").Return([]*ec2.KeyPairInfo{
+				repository.On("ListAllKeyPairs", mock.Anything).Return([]*ec2.KeyPairInfo{
 					{KeyName: awssdk.String("test")},
 					{KeyName: awssdk.String("bar")},
 				}, nil)
@@ -439,7 +440,7 @@ func TestEC2KeyPair(t *testing.T) {
 			dirName: "aws_ec2_key_pair_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllKeyPairs").Return(nil, awsError)
+				repository.On("ListAllKeyPairs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsKeyPairResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKeyPairResourceType, resourceaws.AwsKeyPairResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -492,7 +493,7 @@ func TestEC2KeyPair(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -516,14 +517,14 @@ func TestEC2EipAssociation(t *testing.T) {
 			test:    "no eip associations",
 			dirName: "aws_ec2_eip_association_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddressesAssociation").Return([]*ec2.Address{}, nil)
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return([]*ec2.Address{}, nil)
 			},
 		},
 		{
 			test:    "single eip association",
 			dirName: "aws_ec2_eip_association_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAddressesAssociation").Return([]*ec2.Address{
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return([]*ec2.Address{
 					{
 						AssociationId: awssdk.String("eipassoc-0e9a7356e30f0c3d1"),
 						AllocationId:  awssdk.String("eipalloc-017d5267e4dda73f1"),
@@ -536,7 +537,7 @@ func TestEC2EipAssociation(t *testing.T) {
 			dirName: "aws_ec2_eip_association_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllAddressesAssociation").Return(nil, awsError)
+				repository.On("ListAllAddressesAssociation", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEipAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEipAssociationResourceType, resourceaws.AwsEipAssociationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -589,7 +590,7 @@ func TestEC2EipAssociation(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -613,14 +614,14 @@ func TestEC2Instance(t *testing.T) {
 			test:    "no instances",
 			dirName: "aws_ec2_instance_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{}, nil)
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{}, nil)
 			},
 		},
 		{
 			test:    "multiple instances",
 			dirName: "aws_ec2_instance_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{InstanceId: awssdk.String("i-0d3650a23f4e45dc0")},
 					{InstanceId: awssdk.String("i-010376047a71419f1")},
 				}, nil)
@@ -630,7 +631,7 @@ func TestEC2Instance(t *testing.T) {
 			test:    "terminated instances",
 			dirName: "aws_ec2_instance_terminated",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInstances").Return([]*ec2.Instance{
+				repository.On("ListAllInstances", mock.Anything).Return([]*ec2.Instance{
 					{InstanceId: awssdk.String("i-0e1543baf4f2cd990")},
 					{InstanceId: awssdk.String("i-0a3a7ed51ae2b4fa0")}, // Nil
 				}, nil)
@@ -641,7 +642,7 @@ func TestEC2Instance(t *testing.T) {
 			dirName: "aws_ec2_instance_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllInstances").Return(nil, awsError)
+				repository.On("ListAllInstances", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsInstanceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsInstanceResourceType, resourceaws.AwsInstanceResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -694,7 +695,7 @@ func TestEC2Instance(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -718,14 +719,14 @@ func TestEC2InternetGateway(t *testing.T) {
 			test:    "no internet gateways",
 			dirName: "aws_ec2_internet_gateway_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInternetGateways").Return([]*ec2.InternetGateway{}, nil)
+				repository.On("ListAllInternetGateways", mock.Anything).Return([]*ec2.InternetGateway{}, nil)
 			},
 		},
 		{
 			test:    "multiple internet gateways",
 			dirName: "aws_ec2_internet_gateway_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllInternetGateways").Return([]*ec2.InternetGateway{
+				repository.On("ListAllInternetGateways", mock.Anything).Return([]*ec2.InternetGateway{
 					{InternetGatewayId: awssdk.String("igw-0184eb41aadc62d1c")},
 					{InternetGatewayId: awssdk.String("igw-047b487f5c60fca99")},
 				}, nil)
@@ -736,7 +737,7 @@ func TestEC2InternetGateway(t *testing.T) {
 			dirName: "aws_ec2_internet_gateway_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllInternetGateways").Return(nil, awsError)
+				repository.On("ListAllInternetGateways", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsInternetGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsInternetGatewayResourceType, resourceaws.AwsInternetGatewayResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -789,7 +790,7 @@ func TestEC2InternetGateway(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -814,7 +815,7 @@ func TestVPC(t *testing.T) {
 			test:    "no VPC",
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -822,7 +823,7 @@ func TestVPC(t *testing.T) {
 			test:    "VPC results",
 			dirName: "aws_vpc",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{
 					{
 						VpcId: awssdk.String("vpc-0768e1fd0029e3fc3"),
 					},
@@ -848,7 +849,7 @@ func TestVPC(t *testing.T) {
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllVPCs").Once().Return(nil, nil, awsError)
+				client.On("ListAllVPCs", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsVpcResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcResourceType, resourceaws.AwsVpcResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -901,7 +902,7 @@ func TestVPC(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -926,7 +927,7 @@ func TestDefaultVPC(t *testing.T) {
 			test:    "no VPC",
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -934,7 +935,7 @@ func TestDefaultVPC(t *testing.T) {
 			test:    "default VPC results",
 			dirName: "aws_default_vpc",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllVPCs").Once().Return([]*ec2.Vpc{
+				client.On("ListAllVPCs", mock.Anything).Once().Return([]*ec2.Vpc{
 					{
 						VpcId:     awssdk.String("vpc-0768e1fd0029e3fc3"),
 						IsDefault: awssdk.Bool(false),
@@ -957,7 +958,7 @@ func TestDefaultVPC(t *testing.T) {
 			dirName: "aws_vpc_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllVPCs").Once().Return(nil, nil, awsError)
+				client.On("ListAllVPCs", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultVpcResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultVpcResourceType, resourceaws.AwsDefaultVpcResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1010,7 +1011,7 @@ func TestDefaultVPC(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1034,7 +1035,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			test:    "no route table associations (test for nil values)",
 			dirName: "aws_ec2_route_table_association_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("assoc_with_nil"),
 						Associations: []*ec2.RouteTableAssociation{
@@ -1056,7 +1057,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			test:    "multiple route table associations (mixed subnet and gateway associations)",
 			dirName: "aws_ec2_route_table_association_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("rtb-05aa6c5673311a17b"), // route
 						Associations: []*ec2.RouteTableAssociation{
@@ -1121,7 +1122,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			dirName: "aws_ec2_route_table_association_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteTableAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteTableAssociationResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1174,7 +1175,7 @@ func TestEC2RouteTableAssociation(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -1198,14 +1199,14 @@ func TestEC2Subnet(t *testing.T) {
 			test:    "no subnets",
 			dirName: "aws_ec2_subnet_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
 			},
 		},
 		{
 			test:    "multiple subnets",
 			dirName: "aws_ec2_subnet_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{
 					{
 						SubnetId:     awssdk.String("subnet-05810d3f933925f6d"), // subnet1
 						DefaultForAz: awssdk.Bool(false),
@@ -1239,7 +1240,7 @@ func TestEC2Subnet(t *testing.T) {
 			dirName: "aws_ec2_subnet_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSubnets").Return(nil, nil, awsError)
+				repository.On("ListAllSubnets", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSubnetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSubnetResourceType, resourceaws.AwsSubnetResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1292,7 +1293,7 @@ func TestEC2Subnet(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1316,14 +1317,14 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			test:    "no default subnets",
 			dirName: "aws_ec2_default_subnet_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{}, []*ec2.Subnet{}, nil)
 			},
 		},
 		{
 			test:    "multiple default subnets",
 			dirName: "aws_ec2_default_subnet_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSubnets").Return([]*ec2.Subnet{
+				repository.On("ListAllSubnets", mock.Anything).Return([]*ec2.Subnet{
 					{
 						SubnetId:     awssdk.String("subnet-05810d3f933925f6d"), // subnet1
 						DefaultForAz: awssdk.Bool(false),
@@ -1357,7 +1358,7 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			dirName: "aws_ec2_default_subnet_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllSubnets").Return(nil, nil, awsError)
+				repository.On("ListAllSubnets", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultSubnetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultSubnetResourceType, resourceaws.AwsDefaultSubnetResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1410,7 +1411,7 @@ func TestEC2DefaultSubnet(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1434,14 +1435,14 @@ func TestEC2RouteTable(t *testing.T) {
 			test:    "no route tables",
 			dirName: "aws_ec2_route_table_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 		},
 		{
 			test:    "multiple route tables",
 			dirName: "aws_ec2_route_table_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{RouteTableId: awssdk.String("rtb-08b7b71af15e183ce")}, // table1
 					{RouteTableId: awssdk.String("rtb-0002ac731f6fdea55")}, // table2
 					{RouteTableId: awssdk.String("rtb-0c55d55593f33fbac")}, // table3
@@ -1462,7 +1463,7 @@ func TestEC2RouteTable(t *testing.T) {
 			dirName: "aws_ec2_route_table_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteTableResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1515,7 +1516,7 @@ func TestEC2RouteTable(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1539,14 +1540,14 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			test:    "no default route tables",
 			dirName: "aws_ec2_default_route_table_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 		},
 		{
 			test:    "multiple default route tables",
 			dirName: "aws_ec2_default_route_table_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{RouteTableId: awssdk.String("rtb-08b7b71af15e183ce")}, // table1
 					{RouteTableId: awssdk.String("rtb-0002ac731f6fdea55")}, // table2
 					{RouteTableId: awssdk.String("rtb-0c55d55593f33fbac")}, // table3
@@ -1567,7 +1568,7 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			dirName: "aws_ec2_default_route_table_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultRouteTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultRouteTableResourceType, resourceaws.AwsDefaultRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1620,7 +1621,7 @@ func TestEC2DefaultRouteTable(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1645,7 +1646,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			test:    "no security groups",
 			dirName: "aws_vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -1653,7 +1654,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			test:    "with security groups",
 			dirName: "aws_vpc_security_group_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   awssdk.String("sg-0254c038e32f25530"),
 						GroupName: awssdk.String("foo"),
@@ -1672,7 +1673,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			dirName: "aws_vpc_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSecurityGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecurityGroupResourceType, resourceaws.AwsSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1725,7 +1726,7 @@ func TestVpcSecurityGroup(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1750,7 +1751,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			test:    "no security groups",
 			dirName: "aws_vpc_default_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{}, []*ec2.SecurityGroup{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -1758,7 +1759,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			test:    "with security groups",
 			dirName: "aws_vpc_default_security_group_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:   awssdk.String("sg-0254c038e32f25530"),
 						GroupName: awssdk.String("foo"),
@@ -1777,7 +1778,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			dirName: "aws_vpc_default_security_group_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDefaultSecurityGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDefaultSecurityGroupResourceType, resourceaws.AwsDefaultSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1830,7 +1831,7 @@ func TestVpcDefaultSecurityGroup(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1854,14 +1855,14 @@ func TestEC2NatGateway(t *testing.T) {
 			test:    "no nat gateways",
 			dirName: "aws_ec2_nat_gateway_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNatGateways").Return([]*ec2.NatGateway{}, nil)
+				repository.On("ListAllNatGateways", mock.Anything).Return([]*ec2.NatGateway{}, nil)
 			},
 		},
 		{
 			test:    "single nat gateway",
 			dirName: "aws_ec2_nat_gateway_single",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNatGateways").Return([]*ec2.NatGateway{
+				repository.On("ListAllNatGateways", mock.Anything).Return([]*ec2.NatGateway{
 					{NatGatewayId: awssdk.String("nat-0a5408508b19ef490")},
 				}, nil)
 			},
@@ -1871,7 +1872,7 @@ func TestEC2NatGateway(t *testing.T) {
 			dirName: "aws_ec2_nat_gateway_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNatGateways").Return(nil, awsError)
+				repository.On("ListAllNatGateways", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsNatGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsNatGatewayResourceType, resourceaws.AwsNatGatewayResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -1924,7 +1925,7 @@ func TestEC2NatGateway(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1948,14 +1949,14 @@ func TestEC2NetworkACL(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 		},
 		{
 			test:    "network acl",
 			dirName: "aws_ec2_network_acl",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
 						IsDefault:    awssdk.Bool(false),
@@ -1976,7 +1977,7 @@ func TestEC2NetworkACL(t *testing.T) {
 			dirName: "aws_ec2_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsNetworkACLResourceType,
@@ -2040,7 +2041,7 @@ func TestEC2NetworkACL(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2064,14 +2065,14 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_network_acl_rule_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 		},
 		{
 			test:    "network acl rules",
 			dirName: "aws_ec2_network_acl_rule",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-0ad6d657494d17ee2"), // test
 						IsDefault:    awssdk.Bool(false),
@@ -2127,7 +2128,7 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			dirName: "aws_ec2_network_acl_rule_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsNetworkACLRuleResourceType,
@@ -2185,7 +2186,7 @@ func TestEC2NetworkACLRule(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2209,14 +2210,14 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			test:    "no network ACL",
 			dirName: "aws_ec2_default_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{}, nil)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{}, nil)
 			},
 		},
 		{
 			test:    "default network acl",
 			dirName: "aws_ec2_default_network_acl",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllNetworkACLs").Return([]*ec2.NetworkAcl{
+				repository.On("ListAllNetworkACLs", mock.Anything).Return([]*ec2.NetworkAcl{
 					{
 						NetworkAclId: awssdk.String("acl-043880b4682d2366b"),
 						IsDefault:    awssdk.Bool(false),
@@ -2237,7 +2238,7 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			dirName: "aws_ec2_default_network_acl_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllNetworkACLs").Return(nil, awsError)
+				repository.On("ListAllNetworkACLs", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert",
 					resourceaws.AwsDefaultNetworkACLResourceType,
@@ -2301,7 +2302,7 @@ func TestEC2DefaultNetworkACL(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2327,14 +2328,14 @@ func TestEC2Route(t *testing.T) {
 			test:    "no routes",
 			dirName: "aws_ec2_route_empty",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{}, nil)
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{}, nil)
 			},
 		},
 		{
 			test:    "multiple routes (mixed default_route_table and route_table)",
 			dirName: "aws_ec2_route_multiple",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllRouteTables").Return([]*ec2.RouteTable{
+				repository.On("ListAllRouteTables", mock.Anything).Return([]*ec2.RouteTable{
 					{
 						RouteTableId: awssdk.String("rtb-096bdfb69309c54c3"), // table1
 						Routes: []*ec2.Route{
@@ -2415,7 +2416,7 @@ func TestEC2Route(t *testing.T) {
 			dirName: "aws_ec2_route_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllRouteTables").Return(nil, awsError)
+				repository.On("ListAllRouteTables", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRouteResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRouteResourceType, resourceaws.AwsRouteTableResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2468,7 +2469,7 @@ func TestEC2Route(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2493,7 +2494,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			test:    "no security group rules",
 			dirName: "aws_vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId:             awssdk.String("sg-0254c038e32f25530"),
 						IpPermissions:       []*ec2.IpPermission{},
@@ -2507,7 +2508,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			test:    "with security group rules",
 			dirName: "aws_vpc_security_group_rule_multiple",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSecurityGroups").Once().Return([]*ec2.SecurityGroup{
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return([]*ec2.SecurityGroup{
 					{
 						GroupId: awssdk.String("sg-0254c038e32f25530"),
 						IpPermissions: []*ec2.IpPermission{
@@ -2604,7 +2605,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			dirName: "aws_vpc_security_group_rule_empty",
 			mocks: func(client *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSecurityGroups").Once().Return(nil, nil, awsError)
+				client.On("ListAllSecurityGroups", mock.Anything).Once().Return(nil, nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSecurityGroupRuleResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecurityGroupRuleResourceType, resourceaws.AwsSecurityGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2657,7 +2658,7 @@ func TestVpcSecurityGroupRule(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -2681,7 +2682,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 			test:    "no launch template",
 			dirName: "aws_launch_template",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeLaunchTemplates").Return([]*ec2.LaunchTemplate{}, nil)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return([]*ec2.LaunchTemplate{}, nil)
 			},
 		},
 		{
@@ -2693,7 +2694,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 					{LaunchTemplateId: awssdk.String("lt-00b2d18c6cee7fe23"), LatestVersionNumber: awssdk.Int64(1)},
 				}
 
-				repository.On("DescribeLaunchTemplates").Return(launchTemplates, nil)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return(launchTemplates, nil)
 			},
 		},
 		{
@@ -2701,7 +2702,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 			dirName: "aws_launch_template",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("DescribeLaunchTemplates").Return(nil, awsError)
+				repository.On("DescribeLaunchTemplates", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLaunchTemplateResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLaunchTemplateResourceType, resourceaws.AwsLaunchTemplateResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2754,7 +2755,7 @@ func TestEC2LaunchTemplate(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2779,7 +2780,7 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 			test:    "no encryption by default resource",
 			dirName: "aws_ebs_encryption_by_default_list",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("IsEbsEncryptionEnabledByDefault").Return(false, nil)
+				repository.On("IsEbsEncryptionEnabledByDefault", mock.Anything).Return(false, nil)
 			},
 		},
 		{
@@ -2787,7 +2788,7 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 			dirName: "aws_ebs_encryption_by_default_error",
 			mocks: func(repository *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("IsEbsEncryptionEnabledByDefault").Return(false, awsError)
+				repository.On("IsEbsEncryptionEnabledByDefault", mock.Anything).Return(false, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEbsEncryptionByDefaultResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEbsEncryptionByDefaultResourceType, resourceaws.AwsEbsEncryptionByDefaultResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -2840,7 +2841,7 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -2852,3 +2853,323 @@ func TestEC2EbsEncryptionByDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2Networking(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.EC2Repository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockEC2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "vpc endpoints",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCEndpointEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVpcEndpoints", mock.Anything).Return([]*ec2.VpcEndpoint{
+					{VpcEndpointId: awssdk.String("vpce-1"), State: awssdk.String("available")},
+					{VpcEndpointId: awssdk.String("vpce-2"), State: awssdk.String("deleted")},
+					{VpcEndpointId: awssdk.String("vpce-3"), State: awssdk.String("pendingAcceptance")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "vpce-1", got[0].ResourceId())
+				assert.Equal(t, "vpce-3", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcEndpointResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list vpc endpoints",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCEndpointEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVpcEndpoints", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcEndpointResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcEndpointResourceType, resourceaws.AwsVpcEndpointResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "vpc peering connections",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCPeeringConnectionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVpcPeeringConnections", mock.Anything).Return([]*ec2.VpcPeeringConnection{
+					{VpcPeeringConnectionId: awssdk.String("pcx-1"), Status: &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String("active")}},
+					{VpcPeeringConnectionId: awssdk.String("pcx-2"), Status: &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String("rejected")}},
+					{VpcPeeringConnectionId: awssdk.String("pcx-3"), Status: &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String("deleted")}},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "pcx-1", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcPeeringConnectionResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list vpc peering connections",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCPeeringConnectionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVpcPeeringConnections", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcPeeringConnectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcPeeringConnectionResourceType, resourceaws.AwsVpcPeeringConnectionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "transit gateways",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGateways", mock.Anything).Return([]*ec2.TransitGateway{
+					{TransitGatewayId: awssdk.String("tgw-1"), State: awssdk.String("available")},
+					{TransitGatewayId: awssdk.String("tgw-2"), State: awssdk.String("deleted")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "tgw-1", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateways",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGateways", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayResourceType, resourceaws.AwsEc2TransitGatewayResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "transit gateway vpc attachments",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayVpcAttachmentEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return([]*ec2.TransitGatewayVpcAttachment{
+					{TransitGatewayAttachmentId: awssdk.String("tgw-attach-1"), State: awssdk.String("available")},
+					{TransitGatewayAttachmentId: awssdk.String("tgw-attach-2"), State: awssdk.String("failed")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "tgw-attach-1", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateway vpc attachments",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayVpcAttachmentEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGatewayVpcAttachments", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType, resourceaws.AwsEc2TransitGatewayVpcAttachmentResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "transit gateway route tables without default association route table",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayRouteTableEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGatewayRouteTables", mock.Anything).Return([]*ec2.TransitGatewayRouteTable{
+					{TransitGatewayRouteTableId: awssdk.String("tgw-rtb-1"), State: awssdk.String("available"), DefaultAssociationRouteTable: awssdk.Bool(true)},
+					{TransitGatewayRouteTableId: awssdk.String("tgw-rtb-2"), State: awssdk.String("available"), DefaultAssociationRouteTable: awssdk.Bool(false)},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "tgw-rtb-2", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEc2TransitGatewayRouteTableResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list transit gateway route tables",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2TransitGatewayRouteTableEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTransitGatewayRouteTables", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEc2TransitGatewayRouteTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEc2TransitGatewayRouteTableResourceType, resourceaws.AwsEc2TransitGatewayRouteTableResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "flow logs",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2FlowLogEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFlowLogs", mock.Anything).Return([]*ec2.FlowLog{
+					{FlowLogId: awssdk.String("fl-1")},
+					{FlowLogId: awssdk.String("fl-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "fl-1", got[0].ResourceId())
+				assert.Equal(t, "fl-2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsFlowLogResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list flow logs",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2FlowLogEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFlowLogs", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsFlowLogResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsFlowLogResourceType, resourceaws.AwsFlowLogResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "dhcp options without default vpc ones",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCDhcpOptionsEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVPCs", mock.Anything).Return([]*ec2.Vpc{
+					{VpcId: awssdk.String("vpc-1"), DhcpOptionsId: awssdk.String("dopt-2")},
+				}, []*ec2.Vpc{
+					{VpcId: awssdk.String("vpc-default"), DhcpOptionsId: awssdk.String("dopt-1")},
+				}, nil)
+				repo.On("ListAllDhcpOptions", mock.Anything).Return([]*ec2.DhcpOptions{
+					{DhcpOptionsId: awssdk.String("dopt-1")},
+					{DhcpOptionsId: awssdk.String("dopt-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "dopt-2", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsVpcDhcpOptionsResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list vpcs of dhcp options",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCDhcpOptionsEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVPCs", mock.Anything).Return(nil, nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcDhcpOptionsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcDhcpOptionsResourceType, resourceaws.AwsDefaultVpcResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list dhcp options",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewVPCDhcpOptionsEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllVPCs", mock.Anything).Return([]*ec2.Vpc{}, []*ec2.Vpc{}, nil)
+				repo.On("ListAllDhcpOptions", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsVpcDhcpOptionsResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsVpcDhcpOptionsResourceType, resourceaws.AwsVpcDhcpOptionsResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "network interfaces without requester managed and instance primary ones",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2NetworkInterfaceEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllNetworkInterfaces", mock.Anything).Return([]*ec2.NetworkInterface{
+					{NetworkInterfaceId: awssdk.String("eni-1"), RequesterManaged: awssdk.Bool(false)},
+					{NetworkInterfaceId: awssdk.String("eni-2"), RequesterManaged: awssdk.Bool(true)},
+					{
+						NetworkInterfaceId: awssdk.String("eni-3"),
+						RequesterManaged:   awssdk.Bool(false),
+						Attachment: &ec2.NetworkInterfaceAttachment{
+							DeviceIndex:         awssdk.Int64(0),
+							DeleteOnTermination: awssdk.Bool(true),
+						},
+					},
+					{
+						NetworkInterfaceId: awssdk.String("eni-4"),
+						RequesterManaged:   awssdk.Bool(false),
+						Attachment: &ec2.NetworkInterfaceAttachment{
+							DeviceIndex:         awssdk.Int64(1),
+							DeleteOnTermination: awssdk.Bool(false),
+						},
+					},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "eni-1", got[0].ResourceId())
+				assert.Equal(t, "eni-4", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsNetworkInterfaceResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list network interfaces",
+			enumerator: func(repo repository.EC2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEC2NetworkInterfaceEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEC2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllNetworkInterfaces", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsNetworkInterfaceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsNetworkInterfaceResourceType, resourceaws.AwsNetworkInterfaceResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEC2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
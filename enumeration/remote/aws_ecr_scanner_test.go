@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,7 +40,7 @@ func TestECRRepository(t *testing.T) {
 			test:    "no repository",
 			dirName: "aws_ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{}, nil)
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{}, nil)
 			},
 			err: nil,
 		},
@@ -47,7 +48,7 @@ func TestECRRepository(t *testing.T) {
 			test:    "multiple repositories",
 			dirName: "aws_ecr_repository_multiple",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{
 					{RepositoryName: awssdk.String("test_ecr")},
 					{RepositoryName: awssdk.String("bar")},
 				}, nil)
@@ -59,7 +60,7 @@ func TestECRRepository(t *testing.T) {
 			dirName: "aws_ecr_repository_empty",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllRepositories").Return(nil, awsError)
+				client.On("ListAllRepositories", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsEcrRepositoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcrRepositoryResourceType, resourceaws.AwsEcrRepositoryResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -112,7 +113,7 @@ func TestECRRepository(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
@@ -135,17 +136,17 @@ func TestECRRepositoryPolicy(t *testing.T) {
 		{
 			test: "single repository policy",
 			mocks: func(client *repository.MockECRRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllRepositories").Return([]*ecr.Repository{
+				client.On("ListAllRepositories", mock.Anything).Return([]*ecr.Repository{
 					{RepositoryName: awssdk.String("test_ecr_repo_policy")},
 					{RepositoryName: awssdk.String("test_ecr_repo_without_policy")},
 				}, nil)
-				client.On("GetRepositoryPolicy", &ecr.Repository{
+				client.On("GetRepositoryPolicy", mock.Anything, &ecr.Repository{
 					RepositoryName: awssdk.String("test_ecr_repo_policy"),
 				}).Return(&ecr.GetRepositoryPolicyOutput{
 					RegistryId:     awssdk.String("1"),
 					RepositoryName: awssdk.String("test_ecr_repo_policy"),
 				}, nil)
-				client.On("GetRepositoryPolicy", &ecr.Repository{
+				client.On("GetRepositoryPolicy", mock.Anything, &ecr.Repository{
 					RepositoryName: awssdk.String("test_ecr_repo_without_policy"),
 				}).Return(nil, &ecr.RepositoryPolicyNotFoundException{})
 			},
@@ -177,7 +178,7 @@ func TestECRRepositoryPolicy(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
@@ -0,0 +1,160 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestECS(t *testing.T) {
+	clusterArn := "arn:aws:ecs:us-east-1:123456789012:cluster/production"
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.ECSRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockECSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "clusters",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String(clusterArn), ClusterName: awssdk.String("production"), Status: awssdk.String("ACTIVE")},
+					{ClusterArn: awssdk.String("arn:aws:ecs:us-east-1:123456789012:cluster/deleted"), ClusterName: awssdk.String("deleted"), Status: awssdk.String("INACTIVE")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, clusterArn, got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEcsClusterResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsClusterResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "services",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSServiceEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*ecs.Cluster{
+					{ClusterArn: awssdk.String(clusterArn), ClusterName: awssdk.String("production")},
+				}, nil)
+				repo.On("ListAllServices", mock.Anything, clusterArn).Return([]*ecs.Service{
+					{ServiceArn: awssdk.String("arn:aws:ecs:us-east-1:123456789012:service/production/api"), ServiceName: awssdk.String("api"), Status: awssdk.String("ACTIVE")},
+					{ServiceArn: awssdk.String("arn:aws:ecs:us-east-1:123456789012:service/production/old"), ServiceName: awssdk.String("old"), Status: awssdk.String("INACTIVE")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:service/production/api", got[0].ResourceId())
+				assert.Equal(t, clusterArn, *got[0].Attributes().GetString("cluster"))
+			},
+		},
+		{
+			test: "cannot list clusters of services",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSServiceEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEcsServiceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEcsServiceResourceType, resourceaws.AwsEcsClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "task definitions",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSTaskDefinitionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllTaskDefinitions", mock.Anything).Return([]*string{
+					awssdk.String("arn:aws:ecs:us-east-1:123456789012:task-definition/api:12"),
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "api", got[0].ResourceId())
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:task-definition/api:12", *got[0].Attributes().GetString("arn"))
+			},
+		},
+		{
+			test: "capacity providers",
+			enumerator: func(repo repository.ECSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewECSCapacityProviderEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockECSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllCapacityProviders", mock.Anything).Return([]*ecs.CapacityProvider{
+					{Name: awssdk.String("FARGATE")},
+					{Name: awssdk.String("FARGATE_SPOT")},
+					{Name: awssdk.String("spot"), CapacityProviderArn: awssdk.String("arn:aws:ecs:us-east-1:123456789012:capacity-provider/spot")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:ecs:us-east-1:123456789012:capacity-provider/spot", got[0].ResourceId())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockECSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEFS(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.EFSRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockEFSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no file system",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSFileSystemEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return([]*efs.FileSystemDescription{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple file systems",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSFileSystemEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0123456789"), LifeCycleState: awssdk.String(efs.LifeCycleStateAvailable)},
+					{FileSystemId: awssdk.String("fs-9876543210"), LifeCycleState: awssdk.String(efs.LifeCycleStateDeleted)},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "fs-0123456789", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEfsFileSystemResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list file systems",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSFileSystemEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEfsFileSystemResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsFileSystemResourceType, resourceaws.AwsEfsFileSystemResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple mount targets",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSMountTargetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0123456789")},
+				}, nil)
+				repo.On("ListAllMountTargets", mock.Anything, "fs-0123456789").Return([]*efs.MountTargetDescription{
+					{MountTargetId: awssdk.String("fsmt-0123456789")},
+					{MountTargetId: awssdk.String("fsmt-9876543210")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "fsmt-0123456789", got[0].ResourceId())
+				assert.Equal(t, "fsmt-9876543210", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsEfsMountTargetResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list file systems for mount targets",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSMountTargetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEfsMountTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsMountTargetResourceType, resourceaws.AwsEfsFileSystemResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list mount targets",
+			enumerator: func(repo repository.EFSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEFSMountTargetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEFSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllFileSystems", mock.Anything).Return([]*efs.FileSystemDescription{
+					{FileSystemId: awssdk.String("fs-0123456789")},
+				}, nil)
+				repo.On("ListAllMountTargets", mock.Anything, "fs-0123456789").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEfsMountTargetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEfsMountTargetResourceType, resourceaws.AwsEfsMountTargetResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEFSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEKS(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	clusters := []*string{awssdk.String("production"), awssdk.String("staging")}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.EKSRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockEKSRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "clusters",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(clusters, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "production", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksClusterResourceType, got[0].ResourceType())
+				assert.Equal(t, "staging", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksClusterResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "node groups",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSNodeGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(clusters, nil)
+				repo.On("ListAllNodeGroups", mock.Anything, "production").Return([]*string{awssdk.String("workers")}, nil)
+				repo.On("ListAllNodeGroups", mock.Anything, "staging").Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "production:workers", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksNodeGroupResourceType, got[0].ResourceType())
+				assert.Equal(t, "production", *got[0].Attributes().GetString("cluster_name"))
+			},
+		},
+		{
+			test: "cannot list clusters of node groups",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSNodeGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsEksNodeGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsEksNodeGroupResourceType, resourceaws.AwsEksClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "addons",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSAddonEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(clusters[:1], nil)
+				repo.On("ListAllAddons", mock.Anything, "production").Return([]*string{awssdk.String("vpc-cni"), awssdk.String("coredns")}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "production:vpc-cni", got[0].ResourceId())
+				assert.Equal(t, "production:coredns", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksAddonResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "fargate profiles",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSFargateProfileEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(clusters[:1], nil)
+				repo.On("ListAllFargateProfiles", mock.Anything, "production").Return([]*string{awssdk.String("default")}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "production:default", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksFargateProfileResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "identity provider configs",
+			enumerator: func(repo repository.EKSRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewEKSIdentityProviderConfigEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockEKSRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(clusters[:1], nil)
+				repo.On("ListAllIdentityProviderConfigs", mock.Anything, "production").Return([]*eks.IdentityProviderConfig{
+					{Name: awssdk.String("okta"), Type: awssdk.String("oidc")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "production:okta", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsEksIdentityProviderConfigResourceType, got[0].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockEKSRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestElasticsearch(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	domains := []*elasticsearchservice.ElasticsearchDomainStatus{
+		{
+			ARN:                  awssdk.String("arn:aws:es:us-east-1:123456789012:domain/legacy"),
+			DomainName:           awssdk.String("legacy"),
+			ElasticsearchVersion: awssdk.String("7.10"),
+		},
+		{
+			ARN:                  awssdk.String("arn:aws:es:us-east-1:123456789012:domain/search"),
+			DomainName:           awssdk.String("search"),
+			ElasticsearchVersion: awssdk.String("OpenSearch_1.0"),
+		},
+		{
+			ARN:                  awssdk.String("arn:aws:es:us-east-1:123456789012:domain/deleted"),
+			DomainName:           awssdk.String("deleted"),
+			ElasticsearchVersion: awssdk.String("OpenSearch_1.0"),
+			Deleted:              awssdk.Bool(true),
+		},
+	}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.ElasticsearchRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockElasticsearchRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no domain",
+			enumerator: func(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewElasticsearchDomainEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomains", mock.Anything).Return([]*elasticsearchservice.ElasticsearchDomainStatus{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "elasticsearch domains",
+			enumerator: func(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewElasticsearchDomainEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomains", mock.Anything).Return(domains, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:es:us-east-1:123456789012:domain/legacy", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsElasticsearchDomainResourceType, got[0].ResourceType())
+				assert.Equal(t, resource.Attributes{"domain_name": "legacy"}, *got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list elasticsearch domains",
+			enumerator: func(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewElasticsearchDomainEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomains", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsElasticsearchDomainResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsElasticsearchDomainResourceType, resourceaws.AwsElasticsearchDomainResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "opensearch domains",
+			enumerator: func(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewOpenSearchDomainEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomains", mock.Anything).Return(domains, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:es:us-east-1:123456789012:domain/search", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsOpenSearchDomainResourceType, got[0].ResourceType())
+				assert.Equal(t, resource.Attributes{"domain_name": "search"}, *got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list opensearch domains",
+			enumerator: func(repo repository.ElasticsearchRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewOpenSearchDomainEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockElasticsearchRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomains", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsOpenSearchDomainResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsOpenSearchDomainResourceType, resourceaws.AwsOpenSearchDomainResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockElasticsearchRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
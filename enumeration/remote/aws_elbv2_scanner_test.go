@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -35,7 +36,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		{
 			test: "no load balancer",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{}, nil)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -44,7 +45,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		{
 			test: "should list load balancers",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn:  awssdk.String("arn:aws:elasticloadbalancing:us-east-1:533948124879:loadbalancer/app/acc-test-lb-tf/9114c60e08560420"),
 						LoadBalancerName: awssdk.String("acc-test-lb-tf"),
@@ -61,7 +62,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 			test: "cannot list load balancers (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLoadBalancerResourceType, resourceaws.AwsLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -72,7 +73,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 		{
 			test: "cannot list load balancers (dummy error)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -101,7 +102,7 @@ func TestELBV2_LoadBalancer(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -127,12 +128,12 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "no load balancer listener",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return([]*elbv2.Listener{}, nil)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return([]*elbv2.Listener{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -141,13 +142,13 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "should list load balancer listener",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return([]*elbv2.Listener{
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return([]*elbv2.Listener{
 					{
 						ListenerArn: awssdk.String("test-lb-listener-1"),
 					},
@@ -162,14 +163,14 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "cannot list load balancer listeners (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return(nil, awsError)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerListenerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingError(awsError, resourceaws.AwsLoadBalancerListenerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -181,7 +182,7 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 			test: "cannot list load balancers (403)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllLoadBalancers").Return(nil, awsError)
+				repository.On("ListAllLoadBalancers", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLoadBalancerListenerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLoadBalancerListenerResourceType, resourceaws.AwsLoadBalancerResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -192,13 +193,13 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 		{
 			test: "cannot list load balancer listeners (dummy error)",
 			mocks: func(repository *repository.MockELBV2Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllLoadBalancers").Return([]*elbv2.LoadBalancer{
+				repository.On("ListAllLoadBalancers", mock.Anything).Return([]*elbv2.LoadBalancer{
 					{
 						LoadBalancerArn: awssdk.String("test-lb"),
 					},
 				}, nil)
 
-				repository.On("ListAllLoadBalancerListeners", "test-lb").Return(nil, dummyError)
+				repository.On("ListAllLoadBalancerListeners", mock.Anything, "test-lb").Return(nil, dummyError)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -227,7 +228,7 @@ func TestELBV2_LoadBalancerListener(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
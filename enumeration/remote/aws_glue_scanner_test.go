@@ -0,0 +1,439 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGlueCatalogDatabase(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no glue catalog databases",
+			dirName: "aws_glue_catalog_database_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDatabases", mock.Anything).Return([]*glue.Database{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple glue catalog databases",
+			dirName: "aws_glue_catalog_database_multiple",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDatabases", mock.Anything).Return([]*glue.Database{
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("analytics")},
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("raw")},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list glue catalog databases",
+			dirName: "aws_glue_catalog_database_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllDatabases", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCatalogDatabaseResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCatalogDatabaseResourceType, resourceaws.AwsGlueCatalogDatabaseResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.GlueRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewGlueRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewGlueCatalogDatabaseEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsGlueCatalogDatabaseResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsGlueCatalogDatabaseResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsGlueCatalogDatabaseResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestGlueCatalogTable(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no glue catalog tables",
+			dirName: "aws_glue_catalog_table_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDatabases", mock.Anything).Return([]*glue.Database{
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("analytics")},
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("raw")},
+				}, nil)
+				client.On("ListAllTables", mock.Anything, "analytics").Return([]*glue.TableData{}, nil)
+				client.On("ListAllTables", mock.Anything, "raw").Return([]*glue.TableData{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple glue catalog tables",
+			dirName: "aws_glue_catalog_table_multiple",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDatabases", mock.Anything).Return([]*glue.Database{
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("analytics")},
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("raw")},
+				}, nil)
+				client.On("ListAllTables", mock.Anything, "analytics").Return([]*glue.TableData{
+					{CatalogId: awssdk.String("526954929923"), DatabaseName: awssdk.String("analytics"), Name: awssdk.String("orders")},
+					{CatalogId: awssdk.String("526954929923"), DatabaseName: awssdk.String("analytics"), Name: awssdk.String("customers")},
+				}, nil)
+				client.On("ListAllTables", mock.Anything, "raw").Return([]*glue.TableData{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list glue catalog databases, thus tables",
+			dirName: "aws_glue_catalog_table_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllDatabases", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCatalogTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCatalogTableResourceType, resourceaws.AwsGlueCatalogDatabaseResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list glue catalog tables",
+			dirName: "aws_glue_catalog_table_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllDatabases", mock.Anything).Return([]*glue.Database{
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("analytics")},
+					{CatalogId: awssdk.String("526954929923"), Name: awssdk.String("raw")},
+				}, nil)
+				client.On("ListAllTables", mock.Anything, "analytics").Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCatalogTableResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCatalogTableResourceType, resourceaws.AwsGlueCatalogTableResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.GlueRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewGlueRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewGlueCatalogTableEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsGlueCatalogTableResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsGlueCatalogTableResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsGlueCatalogTableResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestGlueJob(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no glue jobs",
+			dirName: "aws_glue_job_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllJobs", mock.Anything).Return([]*glue.Job{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple glue jobs",
+			dirName: "aws_glue_job_multiple",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllJobs", mock.Anything).Return([]*glue.Job{
+					{Name: awssdk.String("orders-etl")},
+					{Name: awssdk.String("customers-etl")},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list glue jobs",
+			dirName: "aws_glue_job_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllJobs", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueJobResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueJobResourceType, resourceaws.AwsGlueJobResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.GlueRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewGlueRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewGlueJobEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsGlueJobResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsGlueJobResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsGlueJobResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestGlueCrawler(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockGlueRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no glue crawlers",
+			dirName: "aws_glue_crawler_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllCrawlers", mock.Anything).Return([]*glue.Crawler{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple glue crawlers",
+			dirName: "aws_glue_crawler_multiple",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllCrawlers", mock.Anything).Return([]*glue.Crawler{
+					{Name: awssdk.String("orders-crawler")},
+					{Name: awssdk.String("customers-crawler")},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list glue crawlers",
+			dirName: "aws_glue_crawler_empty",
+			mocks: func(client *repository.MockGlueRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllCrawlers", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsGlueCrawlerResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsGlueCrawlerResourceType, resourceaws.AwsGlueCrawlerResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockGlueRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.GlueRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewGlueRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewGlueCrawlerEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsGlueCrawlerResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsGlueCrawlerResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsGlueCrawlerResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
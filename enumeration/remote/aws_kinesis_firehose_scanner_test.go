@@ -0,0 +1,139 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKinesisFirehoseDeliveryStream(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockFirehoseRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no firehose delivery streams",
+			dirName: "aws_kinesis_firehose_delivery_stream_empty",
+			mocks: func(client *repository.MockFirehoseRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDeliveryStreams", mock.Anything).Return([]*firehose.DeliveryStreamDescription{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple firehose delivery streams",
+			dirName: "aws_kinesis_firehose_delivery_stream_multiple",
+			mocks: func(client *repository.MockFirehoseRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllDeliveryStreams", mock.Anything).Return([]*firehose.DeliveryStreamDescription{
+					{
+						DeliveryStreamARN:    awssdk.String("arn:aws:firehose:us-east-1:526954929923:deliverystream/clickstream-archive"),
+						DeliveryStreamName:   awssdk.String("clickstream-archive"),
+						DeliveryStreamStatus: awssdk.String(firehose.DeliveryStreamStatusActive),
+					},
+					{
+						DeliveryStreamARN:    awssdk.String("arn:aws:firehose:us-east-1:526954929923:deliverystream/orders-archive"),
+						DeliveryStreamName:   awssdk.String("orders-archive"),
+						DeliveryStreamStatus: awssdk.String(firehose.DeliveryStreamStatusActive),
+					},
+					{
+						DeliveryStreamARN:    awssdk.String("arn:aws:firehose:us-east-1:526954929923:deliverystream/deleted"),
+						DeliveryStreamName:   awssdk.String("deleted"),
+						DeliveryStreamStatus: awssdk.String(firehose.DeliveryStreamStatusDeleting),
+					},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list firehose delivery streams",
+			dirName: "aws_kinesis_firehose_delivery_stream_empty",
+			mocks: func(client *repository.MockFirehoseRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllDeliveryStreams", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockFirehoseRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.FirehoseRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewFirehoseRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewKinesisFirehoseDeliveryStreamEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsKinesisFirehoseDeliveryStreamResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
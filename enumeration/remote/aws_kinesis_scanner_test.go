@@ -0,0 +1,139 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/terraform"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/mocks"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/snyk/driftctl/test/goldenfile"
+	terraform2 "github.com/snyk/driftctl/test/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKinesisStream(t *testing.T) {
+	cases := []struct {
+		test    string
+		dirName string
+		mocks   func(*repository.MockKinesisRepository, *mocks.AlerterInterface)
+		wantErr error
+	}{
+		{
+			test:    "no kinesis streams",
+			dirName: "aws_kinesis_stream_empty",
+			mocks: func(client *repository.MockKinesisRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllStreams", mock.Anything).Return([]*kinesis.StreamDescriptionSummary{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "multiple kinesis streams",
+			dirName: "aws_kinesis_stream_multiple",
+			mocks: func(client *repository.MockKinesisRepository, alerter *mocks.AlerterInterface) {
+				client.On("ListAllStreams", mock.Anything).Return([]*kinesis.StreamDescriptionSummary{
+					{
+						StreamARN:    awssdk.String("arn:aws:kinesis:us-east-1:526954929923:stream/clickstream"),
+						StreamName:   awssdk.String("clickstream"),
+						StreamStatus: awssdk.String(kinesis.StreamStatusActive),
+					},
+					{
+						StreamARN:    awssdk.String("arn:aws:kinesis:us-east-1:526954929923:stream/orders"),
+						StreamName:   awssdk.String("orders"),
+						StreamStatus: awssdk.String(kinesis.StreamStatusUpdating),
+					},
+					{
+						StreamARN:    awssdk.String("arn:aws:kinesis:us-east-1:526954929923:stream/deleted"),
+						StreamName:   awssdk.String("deleted"),
+						StreamStatus: awssdk.String(kinesis.StreamStatusDeleting),
+					},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			test:    "cannot list kinesis streams",
+			dirName: "aws_kinesis_stream_empty",
+			mocks: func(client *repository.MockKinesisRepository, alerter *mocks.AlerterInterface) {
+				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+				client.On("ListAllStreams", mock.Anything).Return(nil, awsError)
+
+				alerter.On("SendAlert", resourceaws.AwsKinesisStreamResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKinesisStreamResourceType, resourceaws.AwsKinesisStreamResourceType), alerts.EnumerationPhase)).Return()
+			},
+			wantErr: nil,
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+	deserializer := resource.NewDeserializer(factory)
+
+	for _, c := range cases {
+		t.Run(c.test, func(tt *testing.T) {
+			shouldUpdate := c.dirName == *goldenfile.Update
+
+			sess := session.Must(session.NewSessionWithOptions(session.Options{
+				SharedConfigState: session.SharedConfigEnable,
+			}))
+
+			scanOptions := ScannerOptions{Deep: true}
+			providerLibrary := terraform.NewProviderLibrary()
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockKinesisRepository{}
+			c.mocks(fakeRepo, alerter)
+			var repo repository.KinesisRepository = fakeRepo
+			providerVersion := "3.62.0"
+			realProvider, err := terraform2.InitTestAwsProvider(providerLibrary, providerVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider := terraform2.NewFakeTerraformProvider(realProvider)
+			provider.WithResponse(c.dirName)
+
+			// Replace mock by real resources if we are in update mode
+			if shouldUpdate {
+				err := realProvider.Init()
+				if err != nil {
+					t.Fatal(err)
+				}
+				provider.ShouldUpdate()
+				repo = repository.NewKinesisRepository(sess, cache.New(0))
+			}
+
+			remoteLibrary.AddEnumerator(aws.NewKinesisStreamEnumerator(repo, factory))
+			remoteLibrary.AddDetailsFetcher(resourceaws.AwsKinesisStreamResourceType, common.NewGenericDetailsFetcher(resourceaws.AwsKinesisStreamResourceType, provider, deserializer))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.Equal(tt, err, c.wantErr)
+			if err != nil {
+				return
+			}
+			test.TestAgainstGoldenFile(got, resourceaws.AwsKinesisStreamResourceType, c.dirName, provider, deserializer, shouldUpdate, tt)
+			fakeRepo.AssertExpectations(tt)
+			alerter.AssertExpectations(tt)
+		})
+	}
+}
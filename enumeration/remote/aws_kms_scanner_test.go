@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,14 +40,14 @@ func TestKMSKey(t *testing.T) {
 			test:    "no keys",
 			dirName: "aws_kms_key_empty",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeys").Return([]*kms.KeyListEntry{}, nil)
+				repository.On("ListAllKeys", mock.Anything).Return([]*kms.KeyListEntry{}, nil)
 			},
 		},
 		{
 			test:    "multiple keys",
 			dirName: "aws_kms_key_multiple",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllKeys").Return([]*kms.KeyListEntry{
+				repository.On("ListAllKeys", mock.Anything).Return([]*kms.KeyListEntry{
 					{KeyId: awssdk.String("8ee21d91-c000-428c-8032-235aac55da36")},
 					{KeyId: awssdk.String("5d765f32-bfdc-4610-b6ab-f82db5d0601b")},
 					{KeyId: awssdk.String("89d2c023-ea53-40a5-b20a-d84905c622d7")},
@@ -58,7 +59,7 @@ func TestKMSKey(t *testing.T) {
 			dirName: "aws_kms_key_list",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllKeys").Return(nil, awsError)
+				repository.On("ListAllKeys", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsKmsKeyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKmsKeyResourceType, resourceaws.AwsKmsKeyResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -111,7 +112,7 @@ func TestKMSKey(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -135,14 +136,14 @@ func TestKMSAlias(t *testing.T) {
 			test:    "no aliases",
 			dirName: "aws_kms_alias_empty",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAliases").Return([]*kms.AliasListEntry{}, nil)
+				repository.On("ListAllAliases", mock.Anything).Return([]*kms.AliasListEntry{}, nil)
 			},
 		},
 		{
 			test:    "multiple aliases",
 			dirName: "aws_kms_alias_multiple",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllAliases").Return([]*kms.AliasListEntry{
+				repository.On("ListAllAliases", mock.Anything).Return([]*kms.AliasListEntry{
 					{AliasName: awssdk.String("alias/foo")},
 					{AliasName: awssdk.String("alias/bar")},
 					{AliasName: awssdk.String("alias/baz20210225124429210500000001")},
@@ -154,7 +155,7 @@ func TestKMSAlias(t *testing.T) {
 			dirName: "aws_kms_alias_list",
 			mocks: func(repository *repository.MockKMSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllAliases").Return(nil, awsError)
+				repository.On("ListAllAliases", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsKmsAliasResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsKmsAliasResourceType, resourceaws.AwsKmsAliasResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -207,7 +208,7 @@ func TestKMSAlias(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -42,7 +43,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "no lambda functions",
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, nil)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +51,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "with lambda functions",
 			dirName: "aws_lambda_function_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -65,7 +66,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			test:    "One lambda with signing",
 			dirName: "aws_lambda_function_signed",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{
 					{
 						FunctionName: awssdk.String("foo"),
 					},
@@ -78,7 +79,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaFunctions").Return([]*lambda.FunctionConfiguration{}, awsError)
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return([]*lambda.FunctionConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaFunctionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaFunctionResourceType, resourceaws.AwsLambdaFunctionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -131,7 +132,7 @@ func TestScanLambdaFunction(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -156,7 +157,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "no EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, nil)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, nil)
 			},
 			err: nil,
 		},
@@ -164,7 +165,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "with 2 sqs EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_sqs_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("13ff66f8-37eb-4ad6-a0a8-594fea72df4f"),
 					},
@@ -179,7 +180,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			test:    "with dynamo EventSourceMapping",
 			dirName: "aws_lambda_source_mapping_dynamo_multiple",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{
 					{
 						UUID: awssdk.String("1aa9c4a0-060b-41c1-a9ae-dc304ebcdb00"),
 					},
@@ -192,7 +193,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			dirName: "aws_lambda_function_empty",
 			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repo.On("ListAllLambdaEventSourceMappings").Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
+				repo.On("ListAllLambdaEventSourceMappings", mock.Anything).Return([]*lambda.EventSourceMappingConfiguration{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsLambdaEventSourceMappingResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaEventSourceMappingResourceType, resourceaws.AwsLambdaEventSourceMappingResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -245,7 +246,7 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -257,3 +258,110 @@ func TestScanLambdaEventSourceMapping(t *testing.T) {
 		})
 	}
 }
+
+func TestLambdaPermissionAndAlias(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	functions := []*lambda.FunctionConfiguration{
+		{FunctionName: awssdk.String("foo")},
+		{FunctionName: awssdk.String("bar")},
+	}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.LambdaRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockLambdaRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "permissions",
+			enumerator: func(repo repository.LambdaRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewLambdaPermissionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return(functions, nil)
+				repo.On("GetLambdaFunctionPolicy", mock.Anything, "foo").Return(awssdk.String(`{"Version":"2012-10-17","Id":"default","Statement":[{"Sid":"AllowExecutionFromSNS","Effect":"Allow"},{"Sid":"AllowExecutionFromS3","Effect":"Allow"}]}`), nil)
+				repo.On("GetLambdaFunctionPolicy", mock.Anything, "bar").Return(nil, &lambda.ResourceNotFoundException{})
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "AllowExecutionFromSNS", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsLambdaPermissionResourceType, got[0].ResourceType())
+				assert.Equal(t, "foo", *got[0].Attributes().GetString("function_name"))
+				assert.Equal(t, "AllowExecutionFromS3", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list functions of permissions",
+			enumerator: func(repo repository.LambdaRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewLambdaPermissionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsLambdaPermissionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaPermissionResourceType, resourceaws.AwsLambdaFunctionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "aliases",
+			enumerator: func(repo repository.LambdaRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewLambdaAliasEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return(functions, nil)
+				repo.On("ListAllLambdaAliases", mock.Anything, "foo").Return([]*lambda.AliasConfiguration{
+					{Name: awssdk.String("live"), AliasArn: awssdk.String("arn:aws:lambda:us-east-1:123456789012:function:foo:live")},
+				}, nil)
+				repo.On("ListAllLambdaAliases", mock.Anything, "bar").Return([]*lambda.AliasConfiguration{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:foo:live", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsLambdaAliasResourceType, got[0].ResourceType())
+				assert.Equal(t, "live", *got[0].Attributes().GetString("name"))
+			},
+		},
+		{
+			test: "cannot list functions of aliases",
+			enumerator: func(repo repository.LambdaRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewLambdaAliasEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockLambdaRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllLambdaFunctions", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsLambdaAliasResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsLambdaAliasResourceType, resourceaws.AwsLambdaFunctionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockLambdaRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMSK(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.MSKRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockMSKRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no cluster",
+			enumerator: func(repo repository.MSKRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewMSKClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockMSKRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*kafka.ClusterInfo{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple clusters ignoring deleting ones",
+			enumerator: func(repo repository.MSKRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewMSKClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockMSKRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*kafka.ClusterInfo{
+					{ClusterArn: awssdk.String("arn:aws:kafka:us-east-1:123456789012:cluster/cluster-1/1"), State: awssdk.String(kafka.ClusterStateActive)},
+					{ClusterArn: awssdk.String("arn:aws:kafka:us-east-1:123456789012:cluster/cluster-2/2"), State: awssdk.String(kafka.ClusterStateDeleting)},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:kafka:us-east-1:123456789012:cluster/cluster-1/1", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsMskClusterResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			enumerator: func(repo repository.MSKRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewMSKClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockMSKRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsMskClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsMskClusterResourceType, resourceaws.AwsMskClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockMSKRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,14 +40,14 @@ func TestRDSDBInstance(t *testing.T) {
 			test:    "no db instances",
 			dirName: "aws_rds_db_instance_empty",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBInstances").Return([]*rds.DBInstance{}, nil)
+				repository.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{}, nil)
 			},
 		},
 		{
 			test:    "single db instance",
 			dirName: "aws_rds_db_instance_single",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBInstances").Return([]*rds.DBInstance{
+				repository.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{
 					{DBInstanceIdentifier: awssdk.String("terraform-20201015115018309600000001")},
 				}, nil)
 			},
@@ -55,7 +56,7 @@ func TestRDSDBInstance(t *testing.T) {
 			test:    "multiple mixed db instances",
 			dirName: "aws_rds_db_instance_multiple",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBInstances").Return([]*rds.DBInstance{
+				repository.On("ListAllDBInstances", mock.Anything).Return([]*rds.DBInstance{
 					{DBInstanceIdentifier: awssdk.String("terraform-20201015115018309600000001")},
 					{DBInstanceIdentifier: awssdk.String("database-1")},
 				}, nil)
@@ -66,7 +67,7 @@ func TestRDSDBInstance(t *testing.T) {
 			dirName: "aws_rds_db_instance_list",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllDBInstances").Return(nil, awsError)
+				repository.On("ListAllDBInstances", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDbInstanceResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDbInstanceResourceType, resourceaws.AwsDbInstanceResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -119,7 +120,7 @@ func TestRDSDBInstance(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -143,14 +144,14 @@ func TestRDSDBSubnetGroup(t *testing.T) {
 			test:    "no db subnet groups",
 			dirName: "aws_rds_db_subnet_group_empty",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBSubnetGroups").Return([]*rds.DBSubnetGroup{}, nil)
+				repository.On("ListAllDBSubnetGroups", mock.Anything).Return([]*rds.DBSubnetGroup{}, nil)
 			},
 		},
 		{
 			test:    "multiple db subnet groups",
 			dirName: "aws_rds_db_subnet_group_multiple",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBSubnetGroups").Return([]*rds.DBSubnetGroup{
+				repository.On("ListAllDBSubnetGroups", mock.Anything).Return([]*rds.DBSubnetGroup{
 					{DBSubnetGroupName: awssdk.String("foo")},
 					{DBSubnetGroupName: awssdk.String("bar")},
 				}, nil)
@@ -161,7 +162,7 @@ func TestRDSDBSubnetGroup(t *testing.T) {
 			dirName: "aws_rds_db_subnet_group_list",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllDBSubnetGroups").Return(nil, awsError)
+				repository.On("ListAllDBSubnetGroups", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsDbSubnetGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsDbSubnetGroupResourceType, resourceaws.AwsDbSubnetGroupResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -214,7 +215,7 @@ func TestRDSDBSubnetGroup(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -238,14 +239,14 @@ func TestRDSCluster(t *testing.T) {
 			test:    "no cluster",
 			dirName: "aws_rds_cluster_empty",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBClusters").Return([]*rds.DBCluster{}, nil)
+				repository.On("ListAllDBClusters", mock.Anything).Return([]*rds.DBCluster{}, nil)
 			},
 		},
 		{
 			test:    "should return one result",
 			dirName: "aws_rds_clusters_results",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllDBClusters").Return([]*rds.DBCluster{
+				repository.On("ListAllDBClusters", mock.Anything).Return([]*rds.DBCluster{
 					{
 						DBClusterIdentifier: awssdk.String("aurora-cluster-demo"),
 						DatabaseName:        awssdk.String("mydb"),
@@ -261,7 +262,7 @@ func TestRDSCluster(t *testing.T) {
 			dirName: "aws_rds_cluster_denied",
 			mocks: func(repository *repository.MockRDSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 400, "")
-				repository.On("ListAllDBClusters").Return(nil, awsError).Once()
+				repository.On("ListAllDBClusters", mock.Anything).Return(nil, awsError).Once()
 
 				alerter.On("SendAlert", resourceaws.AwsRDSClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRDSClusterResourceType, resourceaws.AwsRDSClusterResourceType), alerts.EnumerationPhase)).Return().Once()
 			},
@@ -314,7 +315,7 @@ func TestRDSCluster(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -0,0 +1,167 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRedshift(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.RedshiftRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockRedshiftRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no cluster",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*redshift.Cluster{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple clusters",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return([]*redshift.Cluster{
+					{ClusterIdentifier: awssdk.String("redshift-cluster-1")},
+					{ClusterIdentifier: awssdk.String("redshift-cluster-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "redshift-cluster-1", got[0].ResourceId())
+				assert.Equal(t, "redshift-cluster-2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsRedshiftClusterResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list clusters",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftClusterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllClusters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsRedshiftClusterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRedshiftClusterResourceType, resourceaws.AwsRedshiftClusterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple subnet groups",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftSubnetGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSubnetGroups", mock.Anything).Return([]*redshift.ClusterSubnetGroup{
+					{ClusterSubnetGroupName: awssdk.String("subnet-group-1")},
+					{ClusterSubnetGroupName: awssdk.String("subnet-group-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "subnet-group-1", got[0].ResourceId())
+				assert.Equal(t, "subnet-group-2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsRedshiftSubnetGroupResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list subnet groups",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftSubnetGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSubnetGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsRedshiftSubnetGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRedshiftSubnetGroupResourceType, resourceaws.AwsRedshiftSubnetGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple parameter groups ignoring default ones",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftParameterGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllParameterGroups", mock.Anything).Return([]*redshift.ClusterParameterGroup{
+					{ParameterGroupName: awssdk.String("default.redshift-1.0")},
+					{ParameterGroupName: awssdk.String("parameter-group-1")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "parameter-group-1", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsRedshiftParameterGroupResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list parameter groups",
+			enumerator: func(repo repository.RedshiftRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewRedshiftParameterGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockRedshiftRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllParameterGroups", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsRedshiftParameterGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRedshiftParameterGroupResourceType, resourceaws.AwsRedshiftParameterGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockRedshiftRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
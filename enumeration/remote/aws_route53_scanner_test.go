@@ -1,6 +1,8 @@
 package remote
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
@@ -41,7 +43,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			test:    "no health check",
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{}, nil)
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{}, nil)
 			},
 			err: nil,
 		},
@@ -49,7 +51,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			test:    "Multiple health check",
 			dirName: "aws_route53_health_check_multiple",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllHealthChecks").Return([]*route53.HealthCheck{
+				client.On("ListAllHealthChecks", mock.Anything).Return([]*route53.HealthCheck{
 					{Id: awssdk.String("7001a9df-ded4-4802-9909-668eb80b972b")},
 					{Id: awssdk.String("84fc318a-2e0d-41d6-b638-280e2f0f4e26")},
 				}, nil)
@@ -61,7 +63,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			dirName: "aws_route53_health_check_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllHealthChecks").Return(nil, awsError)
+				client.On("ListAllHealthChecks", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53HealthCheckResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53HealthCheckResourceType, resourceaws.AwsRoute53HealthCheckResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -114,7 +116,7 @@ func TestRoute53_HealthCheck(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -139,7 +141,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "no zones",
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					nil,
 				)
@@ -150,7 +152,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "single zone",
 			dirName: "aws_route53_zone_single",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z08068311RGDXPHF8KE62"),
@@ -166,7 +168,7 @@ func TestRoute53_Zone(t *testing.T) {
 			test:    "multiples zone (test pagination)",
 			dirName: "aws_route53_zone_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z01809283VH9BBALZHO7B"),
@@ -191,7 +193,7 @@ func TestRoute53_Zone(t *testing.T) {
 			dirName: "aws_route53_zone_empty",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError,
 				)
@@ -247,7 +249,7 @@ func TestRoute53_Zone(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -272,7 +274,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "no records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -281,7 +283,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{}, nil)
 			},
 			err: nil,
 		},
@@ -289,7 +291,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "multiples records in multiples zones",
 			dirName: "aws_route53_record_multiples",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z1035360GLIB82T1EH2G"),
@@ -302,7 +304,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z1035360GLIB82T1EH2G").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("foo-0.com"),
 						Type: awssdk.String("NS"),
@@ -324,7 +326,7 @@ func TestRoute53_Record(t *testing.T) {
 						Type: awssdk.String("A"),
 					},
 				}, nil)
-				client.On("ListRecordsForZone", "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z10347383HV75H96J919W").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test2"),
 						Type: awssdk.String("A"),
@@ -337,7 +339,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "explicit subdomain records",
 			dirName: "aws_route53_record_explicit_subdomain",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -346,7 +348,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil,
 				)
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return([]*route53.ResourceRecordSet{
 					{
 						Name: awssdk.String("test0"),
 						Type: awssdk.String("TXT"),
@@ -380,7 +382,7 @@ func TestRoute53_Record(t *testing.T) {
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{},
 					awsError)
 
@@ -392,7 +394,7 @@ func TestRoute53_Record(t *testing.T) {
 			test:    "cannot list records",
 			dirName: "aws_route53_zone_with_no_record",
 			mocks: func(client *repository.MockRoute53Repository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllZones").Return(
+				client.On("ListAllZones", mock.Anything).Return(
 					[]*route53.HostedZone{
 						{
 							Id:   awssdk.String("Z06486383UC8WYSBZTWFM"),
@@ -401,7 +403,7 @@ func TestRoute53_Record(t *testing.T) {
 					},
 					nil)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListRecordsForZone", "Z06486383UC8WYSBZTWFM").Return(
+				client.On("ListRecordsForZone", mock.Anything, "Z06486383UC8WYSBZTWFM").Return(
 					[]*route53.ResourceRecordSet{}, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsRoute53RecordResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsRoute53RecordResourceType, resourceaws.AwsRoute53RecordResourceType), alerts.EnumerationPhase)).Return()
@@ -455,7 +457,7 @@ func TestRoute53_Record(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
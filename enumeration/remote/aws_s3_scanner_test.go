@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -45,7 +46,7 @@ func TestS3Bucket(t *testing.T) {
 			test: "multiple bucket", dirName: "aws_s3_bucket_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -53,7 +54,7 @@ func TestS3Bucket(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -61,7 +62,7 @@ func TestS3Bucket(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -69,7 +70,7 @@ func TestS3Bucket(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -81,7 +82,7 @@ func TestS3Bucket(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_list",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -136,7 +137,7 @@ func TestS3Bucket(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -161,7 +162,7 @@ func TestS3BucketInventory(t *testing.T) {
 			test: "multiple bucket with multiple inventories", dirName: "aws_s3_bucket_inventories_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -169,7 +170,7 @@ func TestS3BucketInventory(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -177,7 +178,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -185,7 +186,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"eu-west-1",
@@ -193,7 +194,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -209,7 +210,7 @@ func TestS3BucketInventory(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_inventories_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketInventoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketInventoryResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -218,14 +219,14 @@ func TestS3BucketInventory(t *testing.T) {
 		{
 			test: "cannot list bucket inventories", dirName: "aws_s3_bucket_inventories_list_inventories",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -233,7 +234,7 @@ func TestS3BucketInventory(t *testing.T) {
 				)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketInventoryConfigurations",
+					"ListBucketInventoryConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -294,7 +295,7 @@ func TestS3BucketInventory(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -320,13 +321,13 @@ func TestS3BucketNotification(t *testing.T) {
 			dirName: "aws_s3_bucket_notifications_no_notif",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-notifications")},
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-no-notifications",
 				).Return(
 					"eu-west-3",
@@ -334,7 +335,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketNotification",
+					"GetBucketNotification", mock.Anything,
 					"dritftctl-test-no-notifications",
 					"eu-west-3",
 				).Return(
@@ -347,7 +348,7 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "multiple bucket with notifications", dirName: "aws_s3_bucket_notifications_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -355,7 +356,7 @@ func TestS3BucketNotification(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -363,7 +364,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -371,7 +372,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketNotification",
+					"GetBucketNotification", mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -389,7 +390,7 @@ func TestS3BucketNotification(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -401,19 +402,19 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "Cannot get bucket notification", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-notifications-error")},
 				}, nil)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-notifications-error",
 				).Return(
 					"eu-west-3",
 					nil,
 				)
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("GetBucketNotification", "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
+				repository.On("GetBucketNotification", mock.Anything, "dritftctl-test-notifications-error", "eu-west-3").Return(nil, awsError)
 
 				alerter.On("SendAlert", "aws_s3_bucket_notification.dritftctl-test-notifications-error", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, "aws_s3_bucket_notification.dritftctl-test-notifications-error", resourceaws.AwsS3BucketNotificationResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -423,7 +424,7 @@ func TestS3BucketNotification(t *testing.T) {
 			test: "Cannot list bucket", dirName: "aws_s3_bucket_notifications_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketNotificationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketNotificationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -478,7 +479,7 @@ func TestS3BucketNotification(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -503,7 +504,7 @@ func TestS3BucketMetrics(t *testing.T) {
 			test: "multiple bucket with multiple metrics", dirName: "aws_s3_bucket_metrics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -511,7 +512,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -519,7 +520,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -527,7 +528,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -535,7 +536,7 @@ func TestS3BucketMetrics(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -551,7 +552,7 @@ func TestS3BucketMetrics(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_metrics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketMetricResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketMetricResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -560,14 +561,14 @@ func TestS3BucketMetrics(t *testing.T) {
 		{
 			test: "cannot list metrics", dirName: "aws_s3_bucket_metrics_list_metrics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -576,7 +577,7 @@ func TestS3BucketMetrics(t *testing.T) {
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketMetricsConfigurations",
+					"ListBucketMetricsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -637,7 +638,7 @@ func TestS3BucketMetrics(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -663,13 +664,13 @@ func TestS3BucketPolicy(t *testing.T) {
 			dirName: "aws_s3_bucket_policy_no_policy",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("dritftctl-test-no-policy")},
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"dritftctl-test-no-policy",
 				).Return(
 					"eu-west-3",
@@ -677,7 +678,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketPolicy",
+					"GetBucketPolicy", mock.Anything,
 					"dritftctl-test-no-policy",
 					"eu-west-3",
 				).Return(
@@ -690,7 +691,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "multiple bucket with policies", dirName: "aws_s3_bucket_policies_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -698,7 +699,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -706,7 +707,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -714,7 +715,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -722,7 +723,7 @@ func TestS3BucketPolicy(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketPolicy",
+					"GetBucketPolicy", mock.Anything,
 					"bucket-martin-test-drift2",
 					"eu-west-3",
 				).Return(
@@ -738,7 +739,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_policies_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketPolicyResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -793,7 +794,7 @@ func TestS3BucketPolicy(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -818,23 +819,23 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		{
 			test: "multiple bucket, one with access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-with-public-access-block")},
 					{Name: awssdk.String("bucket-without-public-access-block")},
 				}, nil)
 
-				repository.On("GetBucketLocation", "bucket-with-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-with-public-access-block").
 					Return("us-east-1", nil)
-				repository.On("GetBucketLocation", "bucket-without-public-access-block").
+				repository.On("GetBucketLocation", mock.Anything, "bucket-without-public-access-block").
 					Return("us-east-1", nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-with-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-with-public-access-block", "us-east-1").
 					Return(&s3.PublicAccessBlockConfiguration{
 						BlockPublicAcls:   awssdk.Bool(true),
 						BlockPublicPolicy: awssdk.Bool(false),
 					}, nil)
 
-				repository.On("GetBucketPublicAccessBlock", "bucket-without-public-access-block", "us-east-1").
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "bucket-without-public-access-block", "us-east-1").
 					Return(nil, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -852,16 +853,16 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, resourceaws.AwsS3BucketResourceType),
 		},
 		{
 			test: "cannot list public access block",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
-				repository.On("GetBucketLocation", "foobar").Return("us-east-1", nil)
-				repository.On("GetBucketPublicAccessBlock", "foobar", "us-east-1").Return(nil, dummyError)
+				repository.On("ListAllBuckets", mock.Anything).Return([]*s3.Bucket{{Name: awssdk.String("foobar")}}, nil)
+				repository.On("GetBucketLocation", mock.Anything, "foobar").Return("us-east-1", nil)
+				repository.On("GetBucketPublicAccessBlock", mock.Anything, "foobar", "us-east-1").Return(nil, dummyError)
 				alerter.On("SendAlert", "aws_s3_bucket_public_access_block.foobar", alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceScanningError(dummyError, resourceaws.AwsS3BucketPublicAccessBlockResourceType, "foobar"), alerts.EnumerationPhase)).Return()
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
@@ -893,7 +894,7 @@ func TestS3BucketPublicAccessBlock(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -921,7 +922,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 			dirName: "aws_s3_bucket_analytics_multiple",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				repository.On(
-					"ListAllBuckets",
+					"ListAllBuckets", mock.Anything,
 				).Return([]*s3.Bucket{
 					{Name: awssdk.String("bucket-martin-test-drift")},
 					{Name: awssdk.String("bucket-martin-test-drift2")},
@@ -929,7 +930,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				}, nil)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-1",
@@ -937,7 +938,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift2",
 				).Return(
 					"eu-west-3",
@@ -945,7 +946,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift3",
 				).Return(
 					"ap-northeast-1",
@@ -953,7 +954,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 				)
 
 				repository.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift2")},
 					"eu-west-3",
 				).Return(
@@ -969,7 +970,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 			test: "cannot list bucket", dirName: "aws_s3_bucket_analytics_list_bucket",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				repository.On("ListAllBuckets").Return(nil, awsError)
+				repository.On("ListAllBuckets", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsS3BucketAnalyticsConfigurationResourceType, resourceaws.AwsS3BucketResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -978,14 +979,14 @@ func TestS3BucketAnalytic(t *testing.T) {
 		{
 			test: "cannot list Analytics", dirName: "aws_s3_bucket_analytics_list_analytics",
 			mocks: func(repository *repository.MockS3Repository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllBuckets").Return(
+				repository.On("ListAllBuckets", mock.Anything).Return(
 					[]*s3.Bucket{
 						{Name: awssdk.String("bucket-martin-test-drift")},
 					},
 					nil,
 				)
 				repository.On(
-					"GetBucketLocation",
+					"GetBucketLocation", mock.Anything,
 					"bucket-martin-test-drift",
 				).Return(
 					"eu-west-3",
@@ -994,7 +995,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
 				repository.On(
-					"ListBucketAnalyticsConfigurations",
+					"ListBucketAnalyticsConfigurations", mock.Anything,
 					&s3.Bucket{Name: awssdk.String("bucket-martin-test-drift")},
 					"eu-west-3",
 				).Return(
@@ -1055,7 +1056,7 @@ func TestS3BucketAnalytic(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -1081,7 +1082,7 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		{
 			test: "existing access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(&s3control.PublicAccessBlockConfiguration{
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(&s3control.PublicAccessBlockConfiguration{
 					BlockPublicAcls:       awssdk.Bool(false),
 					BlockPublicPolicy:     awssdk.Bool(true),
 					IgnorePublicAcls:      awssdk.Bool(false),
@@ -1103,7 +1104,7 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 		{
 			test: "cannot list access block",
 			mocks: func(repository *repository.MockS3ControlRepository, alerter *mocks.AlerterInterface) {
-				repository.On("DescribeAccountPublicAccessBlock", accountID).Return(nil, dummyError)
+				repository.On("DescribeAccountPublicAccessBlock", mock.Anything, accountID).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceaws.AwsS3AccountPublicAccessBlock),
 		},
@@ -1132,7 +1133,7 @@ func TestS3AccountPublicAccessBlock(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
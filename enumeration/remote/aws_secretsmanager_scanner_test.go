@@ -0,0 +1,154 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSecretsManager(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+	databaseArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:database-AbCdEf"
+	apiKeyArn := "arn:aws:secretsmanager:us-east-1:123456789012:secret:api-key-GhIjKl"
+	secrets := []*secretsmanager.SecretListEntry{
+		{ARN: awssdk.String(databaseArn), Name: awssdk.String("database")},
+		{ARN: awssdk.String(apiKeyArn), Name: awssdk.String("api-key"), RotationEnabled: awssdk.Bool(true)},
+	}
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SecretsManagerRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSecretsManagerRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "secrets",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, databaseArn, got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretResourceType, got[0].ResourceType())
+				assert.Equal(t, apiKeyArn, got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list secrets",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "secret policies",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretPolicyEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+				repo.On("GetSecretPolicy", mock.Anything, databaseArn).Return(awssdk.String(`{"Version":"2012-10-17","Statement":[]}`), nil)
+				repo.On("GetSecretPolicy", mock.Anything, apiKeyArn).Return(nil, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, databaseArn, got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretPolicyResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list secrets of secret policies",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretPolicyEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, resourceaws.AwsSecretsManagerSecretResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot get secret policies",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretPolicyEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+				repo.On("GetSecretPolicy", mock.Anything, databaseArn).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSecretsManagerSecretPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSecretsManagerSecretPolicyResourceType, resourceaws.AwsSecretsManagerSecretPolicyResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "secret rotations",
+			enumerator: func(repo repository.SecretsManagerRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSecretsManagerSecretRotationEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSecretsManagerRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllSecrets", mock.Anything).Return(secrets, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, apiKeyArn, got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSecretsManagerSecretRotationResourceType, got[0].ResourceType())
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSecretsManagerRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
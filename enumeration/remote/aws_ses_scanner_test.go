@@ -0,0 +1,262 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSESDomainIdentity(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SESRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSESRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no domain identity",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESDomainIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomainIdentities", mock.Anything).Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple domain identities",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESDomainIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomainIdentities", mock.Anything).Return([]*string{awssdk.String("example.com"), awssdk.String("example.org")}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "example.com", got[0].ResourceId())
+				assert.Equal(t, "example.org", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSesDomainIdentityResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list domain identities",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESDomainIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDomainIdentities", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSesDomainIdentityResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSesDomainIdentityResourceType, resourceaws.AwsSesDomainIdentityResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSESRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestSESEmailIdentity(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SESRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSESRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no email identity",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESEmailIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEmailIdentities", mock.Anything).Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple email identities",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESEmailIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEmailIdentities", mock.Anything).Return([]*string{awssdk.String("noreply@example.com"), awssdk.String("support@example.com")}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "noreply@example.com", got[0].ResourceId())
+				assert.Equal(t, "support@example.com", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSesEmailIdentityResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list email identities",
+			enumerator: func(repo repository.SESRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESEmailIdentityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllEmailIdentities", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSesEmailIdentityResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSesEmailIdentityResourceType, resourceaws.AwsSesEmailIdentityResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSESRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
+
+func TestSESV2ConfigurationSet(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SESV2Repository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSESV2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "no configuration set",
+			enumerator: func(repo repository.SESV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESV2ConfigurationSetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllConfigurationSets", mock.Anything).Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "multiple configuration sets",
+			enumerator: func(repo repository.SESV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESV2ConfigurationSetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllConfigurationSets", mock.Anything).Return([]*string{awssdk.String("transactional"), awssdk.String("marketing")}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "transactional", got[0].ResourceId())
+				assert.Equal(t, "marketing", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsSesv2ConfigurationSetResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list configuration sets",
+			enumerator: func(repo repository.SESV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSESV2ConfigurationSetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSESV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllConfigurationSets", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSesv2ConfigurationSetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSesv2ConfigurationSetResourceType, resourceaws.AwsSesv2ConfigurationSetResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSESV2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
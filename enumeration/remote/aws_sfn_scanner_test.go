@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSFN(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SFNRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSFNRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "state machines",
+			enumerator: func(repo repository.SFNRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSFNStateMachineEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllStateMachines", mock.Anything).Return([]*sfn.StateMachineListItem{
+					{StateMachineArn: awssdk.String("arn:aws:states:us-east-1:123456789012:stateMachine:checkout")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:states:us-east-1:123456789012:stateMachine:checkout", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSfnStateMachineResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list state machines",
+			enumerator: func(repo repository.SFNRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSFNStateMachineEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllStateMachines", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSfnStateMachineResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSfnStateMachineResourceType, resourceaws.AwsSfnStateMachineResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "activities",
+			enumerator: func(repo repository.SFNRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSFNActivityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllActivities", mock.Anything).Return([]*sfn.ActivityListItem{
+					{ActivityArn: awssdk.String("arn:aws:states:us-east-1:123456789012:activity:approve")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "arn:aws:states:us-east-1:123456789012:activity:approve", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSfnActivityResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list activities",
+			enumerator: func(repo repository.SFNRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSFNActivityEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSFNRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllActivities", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSfnActivityResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSfnActivityResourceType, resourceaws.AwsSfnActivityResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSFNRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShield(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.ShieldRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockShieldRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "protections",
+			enumerator: func(repo repository.ShieldRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewShieldProtectionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockShieldRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllProtections", mock.Anything).Return([]*shield.Protection{
+					{Id: awssdk.String("protection-1")},
+					{Id: awssdk.String("protection-2")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "protection-1", got[0].ResourceId())
+				assert.Equal(t, "protection-2", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsShieldProtectionResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "no protections without subscription",
+			enumerator: func(repo repository.ShieldRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewShieldProtectionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockShieldRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllProtections", mock.Anything).Return(nil, &shield.ResourceNotFoundException{})
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list protections",
+			enumerator: func(repo repository.ShieldRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewShieldProtectionEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockShieldRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllProtections", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsShieldProtectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsShieldProtectionResourceType, resourceaws.AwsShieldProtectionResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockShieldRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -42,7 +43,7 @@ func TestScanSNSTopic(t *testing.T) {
 			test:    "no SNS Topic",
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			err: nil,
 		},
@@ -50,7 +51,7 @@ func TestScanSNSTopic(t *testing.T) {
 			test:    "Multiple SNSTopic",
 			dirName: "aws_sns_topic_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic2")},
 					{TopicArn: awssdk.String("arn:aws:sns:eu-west-3:526954929923:user-updates-topic3")},
@@ -63,7 +64,7 @@ func TestScanSNSTopic(t *testing.T) {
 			dirName: "aws_sns_topic_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -116,7 +117,7 @@ func TestScanSNSTopic(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -141,7 +142,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			test:    "no SNS Topic policy",
 			dirName: "aws_sns_topic_policy_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{}, nil)
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{}, nil)
 			},
 			err: nil,
 		},
@@ -149,7 +150,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			test:    "Multiple SNSTopicPolicy",
 			dirName: "aws_sns_topic_policy_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllTopics").Return([]*sns.Topic{
+				client.On("ListAllTopics", mock.Anything).Return([]*sns.Topic{
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy")},
 					{TopicArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:my-topic-with-policy2")},
 				}, nil)
@@ -161,7 +162,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			dirName: "aws_sns_topic_policy_topic_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllTopics").Return(nil, awsError)
+				client.On("ListAllTopics", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicPolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicPolicyResourceType, resourceaws.AwsSnsTopicResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -214,7 +215,7 @@ func TestSNSTopicPolicyScan(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.err, err)
 			if err != nil {
@@ -239,7 +240,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "no SNS Topic Subscription",
 			dirName: "aws_sns_topic_subscription_empty",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{}, nil)
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{}, nil)
 			},
 			err: nil,
 		},
@@ -247,7 +248,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic:b6e66147-2b31-4486-8d4b-2a2272264c8e")},
 				}, nil)
@@ -258,7 +259,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			test:    "Multiple SNSTopic Subscription with one pending and one incorrect",
 			dirName: "aws_sns_topic_subscription_multiple",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllSubscriptions").Return([]*sns.Subscription{
+				client.On("ListAllSubscriptions", mock.Anything).Return([]*sns.Subscription{
 					{SubscriptionArn: awssdk.String("PendingConfirmation"), Endpoint: awssdk.String("TEST")},
 					{SubscriptionArn: awssdk.String("Incorrect"), Endpoint: awssdk.String("INCORRECT")},
 					{SubscriptionArn: awssdk.String("arn:aws:sns:us-east-1:526954929923:user-updates-topic2:c0f794c5-a009-4db4-9147-4c55959787fa")},
@@ -276,7 +277,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			dirName: "aws_sns_topic_subscription_list",
 			mocks: func(client *repository.MockSNSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllSubscriptions").Return(nil, awsError)
+				client.On("ListAllSubscriptions", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSnsTopicSubscriptionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSnsTopicSubscriptionResourceType, resourceaws.AwsSnsTopicSubscriptionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -329,7 +330,7 @@ func TestSNSTopicSubscriptionScan(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
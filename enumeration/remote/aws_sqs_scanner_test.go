@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -39,7 +40,7 @@ func TestSQSQueue(t *testing.T) {
 			test:    "no sqs queues",
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -47,7 +48,7 @@ func TestSQSQueue(t *testing.T) {
 			test:    "multiple sqs queues",
 			dirName: "aws_sqs_queue_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 				}, nil)
@@ -59,7 +60,7 @@ func TestSQSQueue(t *testing.T) {
 			dirName: "aws_sqs_queue_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueueResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueueResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -111,7 +112,7 @@ func TestSQSQueue(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -137,7 +138,7 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "no sqs queue policies",
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{}, nil)
+				client.On("ListAllQueues", mock.Anything).Return([]*string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -145,13 +146,13 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "multiple sqs queue policies (default or not)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{
 						Attributes: map[string]*string{
 							sqs.QueueAttributeNamePolicy: awssdk.String(""),
@@ -166,13 +167,13 @@ func TestSQSQueuePolicy(t *testing.T) {
 			test:    "multiple sqs queue policies (with nil attributes)",
 			dirName: "aws_sqs_queue_policy_multiple",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListAllQueues").Return([]*string{
+				client.On("ListAllQueues", mock.Anything).Return([]*string{
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/bar.fifo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/foo"),
 					awssdk.String("https://sqs.eu-west-3.amazonaws.com/047081014315/baz"),
 				}, nil)
 
-				client.On("GetQueueAttributes", mock.Anything).Return(
+				client.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(
 					&sqs.GetQueueAttributesOutput{},
 					nil,
 				)
@@ -184,7 +185,7 @@ func TestSQSQueuePolicy(t *testing.T) {
 			dirName: "aws_sqs_queue_policy_empty",
 			mocks: func(client *repository.MockSQSRepository, alerter *mocks.AlerterInterface) {
 				awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
-				client.On("ListAllQueues").Return(nil, awsError)
+				client.On("ListAllQueues", mock.Anything).Return(nil, awsError)
 
 				alerter.On("SendAlert", resourceaws.AwsSqsQueuePolicyResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSqsQueuePolicyResourceType, resourceaws.AwsSqsQueueResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -236,7 +237,7 @@ func TestSQSQueuePolicy(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
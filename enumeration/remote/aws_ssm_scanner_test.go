@@ -0,0 +1,123 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSSM(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.SSMRepository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockSSMRepository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "parameters",
+			enumerator: func(repo repository.SSMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSSMParameterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllParameters", mock.Anything).Return([]*ssm.ParameterMetadata{
+					{Name: awssdk.String("/app/database/host"), Type: awssdk.String("String")},
+					{Name: awssdk.String("/app/database/password"), Type: awssdk.String("SecureString")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "/app/database/host", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSsmParameterResourceType, got[0].ResourceType())
+				assert.Equal(t, "/app/database/password", got[1].ResourceId())
+			},
+		},
+		{
+			test: "cannot list parameters",
+			enumerator: func(repo repository.SSMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSSMParameterEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllParameters", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSsmParameterResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSsmParameterResourceType, resourceaws.AwsSsmParameterResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "documents",
+			enumerator: func(repo repository.SSMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSSMDocumentEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDocuments", mock.Anything).Return([]*ssm.DocumentIdentifier{
+					{Name: awssdk.String("bootstrap")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "bootstrap", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsSsmDocumentResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list documents",
+			enumerator: func(repo repository.SSMRepository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewSSMDocumentEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockSSMRepository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllDocuments", mock.Anything).Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsSsmDocumentResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsSsmDocumentResourceType, resourceaws.AwsSsmDocumentResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockSSMRepository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
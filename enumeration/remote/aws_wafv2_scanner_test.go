@@ -0,0 +1,215 @@
+package remote
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/aws"
+	"github.com/snyk/driftctl/enumeration/remote/aws/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	remoteerr "github.com/snyk/driftctl/enumeration/remote/error"
+	"github.com/snyk/driftctl/enumeration/resource"
+	resourceaws "github.com/snyk/driftctl/enumeration/resource/aws"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWAFV2(t *testing.T) {
+	awsError := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", errors.New("")), 403, "")
+
+	tests := []struct {
+		test           string
+		enumerator     func(repository.WAFV2Repository, resource.ResourceFactory) common.Enumerator
+		mocks          func(*repository.MockWAFV2Repository, *mocks.AlerterInterface)
+		assertExpected func(t *testing.T, got []*resource.Resource)
+	}{
+		{
+			test: "web acls of both scopes",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2WebACLEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllWebACLs", mock.Anything, "REGIONAL").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("acl-regional"), Name: awssdk.String("regional")},
+				}, nil)
+				repo.On("ListAllWebACLs", mock.Anything, "CLOUDFRONT").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("acl-cloudfront"), Name: awssdk.String("cloudfront")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "acl-regional", got[0].ResourceId())
+				assert.Equal(t, "acl-cloudfront", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2WebAclResourceType, got[0].ResourceType())
+				assert.Equal(t, resource.Attributes{"name": "regional", "scope": "REGIONAL"}, *got[0].Attributes())
+				assert.Equal(t, resource.Attributes{"name": "cloudfront", "scope": "CLOUDFRONT", "alias": "us-east-1"}, *got[1].Attributes())
+			},
+		},
+		{
+			test: "cannot list web acls",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2WebACLEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllWebACLs", mock.Anything, "REGIONAL").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsWafv2WebAclResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2WebAclResourceType, resourceaws.AwsWafv2WebAclResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "ip sets",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2IPSetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllIPSets", mock.Anything, "REGIONAL").Return([]*wafv2.IPSetSummary{
+					{Id: awssdk.String("ipset-regional"), Name: awssdk.String("regional")},
+				}, nil)
+				repo.On("ListAllIPSets", mock.Anything, "CLOUDFRONT").Return([]*wafv2.IPSetSummary{
+					{Id: awssdk.String("ipset-cloudfront"), Name: awssdk.String("cloudfront")},
+				}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "ipset-regional", got[0].ResourceId())
+				assert.Equal(t, "ipset-cloudfront", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2IpSetResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list ip sets",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2IPSetEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllIPSets", mock.Anything, "REGIONAL").Return([]*wafv2.IPSetSummary{}, nil)
+				repo.On("ListAllIPSets", mock.Anything, "CLOUDFRONT").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsWafv2IpSetResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2IpSetResourceType, resourceaws.AwsWafv2IpSetResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "rule groups",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2RuleGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllRuleGroups", mock.Anything, "REGIONAL").Return([]*wafv2.RuleGroupSummary{
+					{Id: awssdk.String("group-regional"), Name: awssdk.String("regional")},
+				}, nil)
+				repo.On("ListAllRuleGroups", mock.Anything, "CLOUDFRONT").Return([]*wafv2.RuleGroupSummary{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 1)
+				assert.Equal(t, "group-regional", got[0].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2RuleGroupResourceType, got[0].ResourceType())
+			},
+		},
+		{
+			test: "cannot list rule groups",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2RuleGroupEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllRuleGroups", mock.Anything, "REGIONAL").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsWafv2RuleGroupResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2RuleGroupResourceType, resourceaws.AwsWafv2RuleGroupResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "web acl associations",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2WebACLAssociationEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllWebACLs", mock.Anything, "REGIONAL").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("acl-1"), ARN: awssdk.String("arn:acl-1")},
+					{Id: awssdk.String("acl-2"), ARN: awssdk.String("arn:acl-2")},
+				}, nil)
+				repo.On("ListAllResourcesForWebACL", mock.Anything, "arn:acl-1").Return([]*string{
+					awssdk.String("arn:lb-1"),
+					awssdk.String("arn:stage-1"),
+				}, nil)
+				repo.On("ListAllResourcesForWebACL", mock.Anything, "arn:acl-2").Return([]*string{}, nil)
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 2)
+				assert.Equal(t, "arn:acl-1,arn:lb-1", got[0].ResourceId())
+				assert.Equal(t, "arn:acl-1,arn:stage-1", got[1].ResourceId())
+				assert.Equal(t, resourceaws.AwsWafv2WebAclAssociationResourceType, got[0].ResourceType())
+				assert.Equal(t, resource.Attributes{"web_acl_arn": "arn:acl-1", "resource_arn": "arn:lb-1"}, *got[0].Attributes())
+			},
+		},
+		{
+			test: "cannot list web acls of associations",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2WebACLAssociationEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllWebACLs", mock.Anything, "REGIONAL").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsWafv2WebAclAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2WebAclAssociationResourceType, resourceaws.AwsWafv2WebAclResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+		{
+			test: "cannot list web acl associations",
+			enumerator: func(repo repository.WAFV2Repository, factory resource.ResourceFactory) common.Enumerator {
+				return aws.NewWAFV2WebACLAssociationEnumerator(repo, factory)
+			},
+			mocks: func(repo *repository.MockWAFV2Repository, alerter *mocks.AlerterInterface) {
+				repo.On("ListAllWebACLs", mock.Anything, "REGIONAL").Return([]*wafv2.WebACLSummary{
+					{Id: awssdk.String("acl-1"), ARN: awssdk.String("arn:acl-1")},
+				}, nil)
+				repo.On("ListAllResourcesForWebACL", mock.Anything, "arn:acl-1").Return(nil, awsError)
+				alerter.On("SendAlert", resourceaws.AwsWafv2WebAclAssociationResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteAWSTerraform, remoteerr.NewResourceListingErrorWithType(awsError, resourceaws.AwsWafv2WebAclAssociationResourceType, resourceaws.AwsWafv2WebAclAssociationResourceType), alerts.EnumerationPhase)).Return()
+			},
+			assertExpected: func(t *testing.T, got []*resource.Resource) {
+				assert.Len(t, got, 0)
+			},
+		},
+	}
+
+	factory := terraform.NewTerraformResourceFactory()
+
+	for _, c := range tests {
+		t.Run(c.test, func(tt *testing.T) {
+			scanOptions := ScannerOptions{}
+			remoteLibrary := common.NewRemoteLibrary()
+
+			// Initialize mocks
+			alerter := &mocks.AlerterInterface{}
+			fakeRepo := &repository.MockWAFV2Repository{}
+			c.mocks(fakeRepo, alerter)
+
+			remoteLibrary.AddEnumerator(c.enumerator(fakeRepo, factory))
+
+			testFilter := &enumeration.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
+			got, err := s.Resources()
+			assert.NoError(tt, err)
+
+			c.assertExpected(tt, got)
+			alerter.AssertExpectations(tt)
+			fakeRepo.AssertExpectations(tt)
+			testFilter.AssertExpectations(tt)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package azurerm
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// AzurermActivityLogAttributor finds the last principal that modified a resource using the subscription Activity Log
+type AzurermActivityLogAttributor struct {
+	repository   repository.MonitorRepository
+	once         sync.Once
+	attributions map[string]common.Attribution
+	err          error
+}
+
+func NewAzurermActivityLogAttributor(repo repository.MonitorRepository) *AzurermActivityLogAttributor {
+	return &AzurermActivityLogAttributor{
+		repository:   repo,
+		attributions: make(map[string]common.Attribution),
+	}
+}
+
+func (a *AzurermActivityLogAttributor) Attribute(ctx context.Context, res *resource.Resource) (*common.Attribution, error) {
+	a.once.Do(func() { a.load(ctx) })
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	// Azure resource ids are case insensitive
+	if attribution, exist := a.attributions[strings.ToLower(res.ResourceId())]; exist {
+		return &attribution, nil
+	}
+
+	return nil, nil
+}
+
+func (a *AzurermActivityLogAttributor) load(ctx context.Context) {
+	events, err := a.repository.ListActivityLogEvents(ctx)
+	if err != nil {
+		a.err = err
+		return
+	}
+
+	for _, event := range events {
+		if event.ResourceID == nil || event.Caller == nil || event.EventTimestamp == nil {
+			continue
+		}
+		if event.Status == nil || event.Status.Value == nil || *event.Status.Value != "Succeeded" {
+			continue
+		}
+		if event.OperationName == nil || event.OperationName.Value == nil || strings.HasSuffix(strings.ToLower(*event.OperationName.Value), "/read") {
+			continue
+		}
+
+		id := strings.ToLower(*event.ResourceID)
+		if existing, exist := a.attributions[id]; exist && existing.Date.After(*event.EventTimestamp) {
+			continue
+		}
+		a.attributions[id] = common.Attribution{
+			Principal: *event.Caller,
+			Date:      *event.EventTimestamp,
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package azurerm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestAzurermActivityLogAttributor_Attribute(t *testing.T) {
+	vnetID := "/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/driftctl/providers/Microsoft.Network/virtualNetworks/network1"
+	events := []*repository.ActivityLogEvent{
+		{
+			Caller:         to.StringPtr("alice@example.com"),
+			EventTimestamp: timePtr(time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC)),
+			OperationName:  &repository.LocalizableString{Value: to.StringPtr("Microsoft.Network/virtualNetworks/write")},
+			ResourceID:     to.StringPtr(vnetID),
+			Status:         &repository.LocalizableString{Value: to.StringPtr("Succeeded")},
+		},
+		{
+			Caller:         to.StringPtr("bob@example.com"),
+			EventTimestamp: timePtr(time.Date(2022, 1, 11, 10, 0, 0, 0, time.UTC)),
+			OperationName:  &repository.LocalizableString{Value: to.StringPtr("Microsoft.Network/virtualNetworks/write")},
+			ResourceID:     to.StringPtr(vnetID),
+			Status:         &repository.LocalizableString{Value: to.StringPtr("Failed")},
+		},
+		{
+			Caller:         to.StringPtr("bob@example.com"),
+			EventTimestamp: timePtr(time.Date(2022, 1, 12, 10, 0, 0, 0, time.UTC)),
+			OperationName:  &repository.LocalizableString{Value: to.StringPtr("Microsoft.Network/virtualNetworks/read")},
+			ResourceID:     to.StringPtr(vnetID),
+			Status:         &repository.LocalizableString{Value: to.StringPtr("Succeeded")},
+		},
+		{
+			Caller:         to.StringPtr("bob@example.com"),
+			EventTimestamp: timePtr(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)),
+			OperationName:  &repository.LocalizableString{Value: to.StringPtr("Microsoft.Network/virtualNetworks/write")},
+			ResourceID:     to.StringPtr(vnetID),
+			Status:         &repository.LocalizableString{Value: to.StringPtr("Succeeded")},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		res      *resource.Resource
+		mocks    func(*repository.MockMonitorRepository)
+		expected *common.Attribution
+		wantErr  string
+	}{
+		{
+			name: "should return last successful write",
+			res: &resource.Resource{
+				Id:   "/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/DRIFTCTL/providers/Microsoft.Network/virtualNetworks/network1",
+				Type: "azurerm_virtual_network",
+			},
+			mocks: func(repo *repository.MockMonitorRepository) {
+				repo.On("ListActivityLogEvents", mock.Anything).Return(events, nil).Once()
+			},
+			expected: &common.Attribution{
+				Principal: "alice@example.com",
+				Date:      time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "should not find any attribution",
+			res: &resource.Resource{
+				Id:   "/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/driftctl/providers/Microsoft.Network/virtualNetworks/network2",
+				Type: "azurerm_virtual_network",
+			},
+			mocks: func(repo *repository.MockMonitorRepository) {
+				repo.On("ListActivityLogEvents", mock.Anything).Return(events, nil).Once()
+			},
+			expected: nil,
+		},
+		{
+			name: "should return remote error",
+			res: &resource.Resource{
+				Id:   vnetID,
+				Type: "azurerm_virtual_network",
+			},
+			mocks: func(repo *repository.MockMonitorRepository) {
+				repo.On("ListActivityLogEvents", mock.Anything).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &repository.MockMonitorRepository{}
+			tt.mocks(repo)
+
+			attributor := NewAzurermActivityLogAttributor(repo)
+			got, err := attributor.Attribute(context.TODO(), tt.res)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+
+			// Events should only be fetched once
+			_, _ = attributor.Attribute(context.TODO(), tt.res)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermLoadBalancerEnumerator) SupportedType() resource.ResourceType {
 	return azurerm.AzureLoadBalancerResourceType
 }
 
-func (e *AzurermLoadBalancerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	loadBalancers, err := e.repository.ListAllLoadBalancers()
+func (e *AzurermLoadBalancerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	loadBalancers, err := e.repository.ListAllLoadBalancers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
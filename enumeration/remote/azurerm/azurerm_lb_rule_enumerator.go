@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermLoadBalancerRuleEnumerator) SupportedType() resource.ResourceTyp
 	return azurerm.AzureLoadBalancerRuleResourceType
 }
 
-func (e *AzurermLoadBalancerRuleEnumerator) Enumerate() ([]*resource.Resource, error) {
-	loadBalancers, err := e.repository.ListAllLoadBalancers()
+func (e *AzurermLoadBalancerRuleEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	loadBalancers, err := e.repository.ListAllLoadBalancers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzureLoadBalancerResourceType)
 	}
@@ -32,7 +34,7 @@ func (e *AzurermLoadBalancerRuleEnumerator) Enumerate() ([]*resource.Resource, e
 	results := make([]*resource.Resource, 0)
 
 	for _, res := range loadBalancers {
-		rules, err := e.repository.ListLoadBalancerRules(res)
+		rules, err := e.repository.ListLoadBalancerRules(ctx, res)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
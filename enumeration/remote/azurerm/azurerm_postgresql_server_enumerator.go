@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *AzurermPostgresqlServerEnumerator) SupportedType() resource.ResourceTyp
 	return azurerm.AzurePostgresqlServerResourceType
 }
 
-func (e *AzurermPostgresqlServerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	servers, err := e.repository.ListAllServers()
+func (e *AzurermPostgresqlServerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	servers, err := e.repository.ListAllServers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
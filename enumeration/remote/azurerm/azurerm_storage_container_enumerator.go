@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/azurerm/repository"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,9 +25,9 @@ func (e *AzurermStorageContainerEnumerator) SupportedType() resource.ResourceTyp
 	return azurerm.AzureStorageContainerResourceType
 }
 
-func (e *AzurermStorageContainerEnumerator) Enumerate() ([]*resource.Resource, error) {
+func (e *AzurermStorageContainerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
 
-	accounts, err := e.repository.ListAllStorageAccount()
+	accounts, err := e.repository.ListAllStorageAccount(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), azurerm.AzureStorageAccountResourceType)
 	}
@@ -33,7 +35,7 @@ func (e *AzurermStorageContainerEnumerator) Enumerate() ([]*resource.Resource, e
 	results := make([]*resource.Resource, 0)
 
 	for _, account := range accounts {
-		containers, err := e.repository.ListAllStorageContainer(account)
+		containers, err := e.repository.ListAllStorageContainer(ctx, account)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
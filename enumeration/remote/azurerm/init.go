@@ -13,9 +13,9 @@ import (
 	"github.com/snyk/driftctl/enumeration/terraform"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, hashes []string, source terraform.ProviderSource, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, mirror terraform.ProviderMirror) error {
 
-	provider, err := NewAzureTerraformProvider(version, progress, configDir)
+	provider, err := NewAzureTerraformProvider(version, hashes, source, progress, configDir, mirror)
 	if err != nil {
 		return err
 	}
@@ -48,6 +48,11 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	providerLibrary.AddProvider(terraform.AZURE, provider)
 	deserializer := resource.NewDeserializer(factory)
 
+	if remoteLibrary.AttributionEnabled() {
+		monitorRepo := repository.NewMonitorRepository(cred, clientOptions, providerConfig, c)
+		remoteLibrary.SetAttributor(NewAzurermActivityLogAttributor(monitorRepo))
+	}
+
 	remoteLibrary.AddEnumerator(NewAzurermStorageAccountEnumerator(storageAccountRepo, factory))
 	remoteLibrary.AddEnumerator(NewAzurermStorageContainerEnumerator(storageAccountRepo, factory))
 	remoteLibrary.AddEnumerator(NewAzurermVirtualNetworkEnumerator(networkRepo, factory))
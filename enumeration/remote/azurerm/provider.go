@@ -13,15 +13,18 @@ import (
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 )
 
+// DefaultProviderVersion is the provider version used when none is configured
+const DefaultProviderVersion = "2.71.0"
+
 type AzureTerraformProvider struct {
 	*terraform.TerraformProvider
 	name    string
 	version string
 }
 
-func NewAzureTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*AzureTerraformProvider, error) {
+func NewAzureTerraformProvider(version string, hashes []string, source tf.ProviderSource, progress enumeration.ProgressCounter, configDir string, mirror tf.ProviderMirror) (*AzureTerraformProvider, error) {
 	if version == "" {
-		version = "2.71.0"
+		version = DefaultProviderVersion
 	}
 	// Just pass your version and name
 	p := &AzureTerraformProvider{
@@ -30,9 +33,13 @@ func NewAzureTerraformProvider(version string, progress enumeration.ProgressCoun
 	}
 	// Use TerraformProviderInstaller to retrieve the provider if needed
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Hostname:  source.Hostname,
+		Namespace: source.Namespace,
 		Key:       p.name,
 		Version:   version,
 		ConfigDir: configDir,
+		Mirror:    mirror,
+		Hashes:    hashes,
 	})
 	if err != nil {
 		return nil, err
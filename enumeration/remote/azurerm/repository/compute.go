@@ -11,8 +11,8 @@ import (
 )
 
 type ComputeRepository interface {
-	ListAllImages() ([]*armcompute.Image, error)
-	ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error)
+	ListAllImages(ctx context.Context) ([]*armcompute.Image, error)
+	ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error)
 }
 
 type imagesListPager interface {
@@ -63,7 +63,7 @@ func NewComputeRepository(cred azcore.TokenCredential, options *arm.ClientOption
 	}
 }
 
-func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
+func (s *computeRepository) ListAllImages(ctx context.Context) ([]*armcompute.Image, error) {
 	cacheKey := "computeListAllImages"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armcompute.Image), nil
@@ -71,7 +71,7 @@ func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
 
 	pager := s.imagesClient.List(nil)
 	results := make([]*armcompute.Image, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -86,7 +86,7 @@ func (s *computeRepository) ListAllImages() ([]*armcompute.Image, error) {
 	return results, nil
 }
 
-func (s *computeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error) {
+func (s *computeRepository) ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error) {
 	cacheKey := "computeListAllSSHPublicKeys"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armcompute.SSHPublicKeyResource), nil
@@ -94,7 +94,7 @@ func (s *computeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyRe
 
 	pager := s.sshPublicKeyClient.ListBySubscription(nil)
 	results := make([]*armcompute.SSHPublicKeyResource, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
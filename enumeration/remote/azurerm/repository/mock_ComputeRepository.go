@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockComputeRepository struct {
 	mock.Mock
 }
 
-// ListAllImages provides a mock function with given fields:
-func (_m *MockComputeRepository) ListAllImages() ([]*armcompute.Image, error) {
-	ret := _m.Called()
+// ListAllImages provides a mock function with given fields: ctx
+func (_m *MockComputeRepository) ListAllImages(ctx context.Context) ([]*armcompute.Image, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armcompute.Image
-	if rf, ok := ret.Get(0).(func() []*armcompute.Image); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armcompute.Image); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armcompute.Image)
@@ -26,8 +28,8 @@ func (_m *MockComputeRepository) ListAllImages() ([]*armcompute.Image, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockComputeRepository) ListAllImages() ([]*armcompute.Image, error) {
 	return r0, r1
 }
 
-// ListAllPublicKeys provides a mock function with given fields:
-func (_m *MockComputeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublicKeyResource, error) {
-	ret := _m.Called()
+// ListAllPublicKeys provides a mock function with given fields: ctx
+func (_m *MockComputeRepository) ListAllSSHPublicKeys(ctx context.Context) ([]*armcompute.SSHPublicKeyResource, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armcompute.SSHPublicKeyResource
-	if rf, ok := ret.Get(0).(func() []*armcompute.SSHPublicKeyResource); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armcompute.SSHPublicKeyResource); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armcompute.SSHPublicKeyResource)
@@ -49,8 +51,8 @@ func (_m *MockComputeRepository) ListAllSSHPublicKeys() ([]*armcompute.SSHPublic
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockContainerRegistryRepository struct {
 	mock.Mock
 }
 
-// ListAllContainerRegistries provides a mock function with given fields:
-func (_m *MockContainerRegistryRepository) ListAllContainerRegistries() ([]*armcontainerregistry.Registry, error) {
-	ret := _m.Called()
+// ListAllContainerRegistries provides a mock function with given fields: ctx
+func (_m *MockContainerRegistryRepository) ListAllContainerRegistries(ctx context.Context) ([]*armcontainerregistry.Registry, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armcontainerregistry.Registry
-	if rf, ok := ret.Get(0).(func() []*armcontainerregistry.Registry); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armcontainerregistry.Registry); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armcontainerregistry.Registry)
@@ -26,8 +28,8 @@ func (_m *MockContainerRegistryRepository) ListAllContainerRegistries() ([]*armc
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockNetworkRepository struct {
 	mock.Mock
 }
 
-// ListAllFirewalls provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall, error) {
-	ret := _m.Called()
+// ListAllFirewalls provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllFirewalls(ctx context.Context) ([]*armnetwork.AzureFirewall, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.AzureFirewall
-	if rf, ok := ret.Get(0).(func() []*armnetwork.AzureFirewall); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.AzureFirewall); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.AzureFirewall)
@@ -26,8 +28,8 @@ func (_m *MockNetworkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockNetworkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall
 	return r0, r1
 }
 
-// ListAllLoadBalancers provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalancer, error) {
-	ret := _m.Called()
+// ListAllLoadBalancers provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllLoadBalancers(ctx context.Context) ([]*armnetwork.LoadBalancer, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.LoadBalancer
-	if rf, ok := ret.Get(0).(func() []*armnetwork.LoadBalancer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.LoadBalancer); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.LoadBalancer)
@@ -49,8 +51,8 @@ func (_m *MockNetworkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalan
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockNetworkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalan
 	return r0, r1
 }
 
-// ListAllPublicIPAddresses provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAddress, error) {
-	ret := _m.Called()
+// ListAllPublicIPAddresses provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllPublicIPAddresses(ctx context.Context) ([]*armnetwork.PublicIPAddress, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.PublicIPAddress
-	if rf, ok := ret.Get(0).(func() []*armnetwork.PublicIPAddress); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.PublicIPAddress); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.PublicIPAddress)
@@ -72,8 +74,8 @@ func (_m *MockNetworkRepository) ListAllPublicIPAddresses() ([]*armnetwork.Publi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockNetworkRepository) ListAllPublicIPAddresses() ([]*armnetwork.Publi
 	return r0, r1
 }
 
-// ListAllRouteTables provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable, error) {
-	ret := _m.Called()
+// ListAllRouteTables provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllRouteTables(ctx context.Context) ([]*armnetwork.RouteTable, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.RouteTable
-	if rf, ok := ret.Get(0).(func() []*armnetwork.RouteTable); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.RouteTable); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.RouteTable)
@@ -95,8 +97,8 @@ func (_m *MockNetworkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockNetworkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable,
 	return r0, r1
 }
 
-// ListAllSecurityGroups provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkSecurityGroup, error) {
-	ret := _m.Called()
+// ListAllSecurityGroups provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllSecurityGroups(ctx context.Context) ([]*armnetwork.NetworkSecurityGroup, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.NetworkSecurityGroup
-	if rf, ok := ret.Get(0).(func() []*armnetwork.NetworkSecurityGroup); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.NetworkSecurityGroup); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.NetworkSecurityGroup)
@@ -118,8 +120,8 @@ func (_m *MockNetworkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkS
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockNetworkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkS
 	return r0, r1
 }
 
-// ListAllSubnets provides a mock function with given fields: virtualNetwork
-func (_m *MockNetworkRepository) ListAllSubnets(virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
-	ret := _m.Called(virtualNetwork)
+// ListAllSubnets provides a mock function with given fields: ctx, virtualNetwork
+func (_m *MockNetworkRepository) ListAllSubnets(ctx context.Context, virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
+	ret := _m.Called(ctx, virtualNetwork)
 
 	var r0 []*armnetwork.Subnet
-	if rf, ok := ret.Get(0).(func(*armnetwork.VirtualNetwork) []*armnetwork.Subnet); ok {
-		r0 = rf(virtualNetwork)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.VirtualNetwork) []*armnetwork.Subnet); ok {
+		r0 = rf(ctx, virtualNetwork)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.Subnet)
@@ -141,8 +143,8 @@ func (_m *MockNetworkRepository) ListAllSubnets(virtualNetwork *armnetwork.Virtu
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armnetwork.VirtualNetwork) error); ok {
-		r1 = rf(virtualNetwork)
+	if rf, ok := ret.Get(1).(func(context.Context, *armnetwork.VirtualNetwork) error); ok {
+		r1 = rf(ctx, virtualNetwork)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockNetworkRepository) ListAllSubnets(virtualNetwork *armnetwork.Virtu
 	return r0, r1
 }
 
-// ListAllVirtualNetworks provides a mock function with given fields:
-func (_m *MockNetworkRepository) ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwork, error) {
-	ret := _m.Called()
+// ListAllVirtualNetworks provides a mock function with given fields: ctx
+func (_m *MockNetworkRepository) ListAllVirtualNetworks(ctx context.Context) ([]*armnetwork.VirtualNetwork, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armnetwork.VirtualNetwork
-	if rf, ok := ret.Get(0).(func() []*armnetwork.VirtualNetwork); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armnetwork.VirtualNetwork); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.VirtualNetwork)
@@ -164,8 +166,8 @@ func (_m *MockNetworkRepository) ListAllVirtualNetworks() ([]*armnetwork.Virtual
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockNetworkRepository) ListAllVirtualNetworks() ([]*armnetwork.Virtual
 	return r0, r1
 }
 
-// ListLoadBalancerRules provides a mock function with given fields: _a0
-func (_m *MockNetworkRepository) ListLoadBalancerRules(_a0 *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
-	ret := _m.Called(_a0)
+// ListLoadBalancerRules provides a mock function with given fields: _a0, _a1
+func (_m *MockNetworkRepository) ListLoadBalancerRules(_a0 context.Context, _a1 *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []*armnetwork.LoadBalancingRule
-	if rf, ok := ret.Get(0).(func(*armnetwork.LoadBalancer) []*armnetwork.LoadBalancingRule); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *armnetwork.LoadBalancer) []*armnetwork.LoadBalancingRule); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armnetwork.LoadBalancingRule)
@@ -187,8 +189,8 @@ func (_m *MockNetworkRepository) ListLoadBalancerRules(_a0 *armnetwork.LoadBalan
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armnetwork.LoadBalancer) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *armnetwork.LoadBalancer) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	armprivatedns "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockPrivateDNSRepository struct {
 	mock.Mock
 }
 
-// ListAllAAAARecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllAAAARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllAAAARecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllAAAARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -26,8 +28,8 @@ func (_m *MockPrivateDNSRepository) ListAllAAAARecords(zone *armprivatedns.Priva
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockPrivateDNSRepository) ListAllAAAARecords(zone *armprivatedns.Priva
 	return r0, r1
 }
 
-// ListAllARecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllARecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -49,8 +51,8 @@ func (_m *MockPrivateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZ
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockPrivateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZ
 	return r0, r1
 }
 
-// ListAllCNAMERecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllCNAMERecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -72,8 +74,8 @@ func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.Priv
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockPrivateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.Priv
 	return r0, r1
 }
 
-// ListAllMXRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllMXRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllMXRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllMXRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -95,8 +97,8 @@ func (_m *MockPrivateDNSRepository) ListAllMXRecords(zone *armprivatedns.Private
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockPrivateDNSRepository) ListAllMXRecords(zone *armprivatedns.Private
 	return r0, r1
 }
 
-// ListAllPTRRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllPTRRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllPTRRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllPTRRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -118,8 +120,8 @@ func (_m *MockPrivateDNSRepository) ListAllPTRRecords(zone *armprivatedns.Privat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockPrivateDNSRepository) ListAllPTRRecords(zone *armprivatedns.Privat
 	return r0, r1
 }
 
-// ListAllPrivateZones provides a mock function with given fields:
-func (_m *MockPrivateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.PrivateZone, error) {
-	ret := _m.Called()
+// ListAllPrivateZones provides a mock function with given fields: ctx
+func (_m *MockPrivateDNSRepository) ListAllPrivateZones(ctx context.Context) ([]*armprivatedns.PrivateZone, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*armprivatedns.PrivateZone
-	if rf, ok := ret.Get(0).(func() []*armprivatedns.PrivateZone); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*armprivatedns.PrivateZone); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.PrivateZone)
@@ -141,8 +143,8 @@ func (_m *MockPrivateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.Priv
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockPrivateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.Priv
 	return r0, r1
 }
 
-// ListAllSRVRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllSRVRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllSRVRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllSRVRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -164,8 +166,8 @@ func (_m *MockPrivateDNSRepository) ListAllSRVRecords(zone *armprivatedns.Privat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockPrivateDNSRepository) ListAllSRVRecords(zone *armprivatedns.Privat
 	return r0, r1
 }
 
-// ListAllTXTRecords provides a mock function with given fields: zone
-func (_m *MockPrivateDNSRepository) ListAllTXTRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	ret := _m.Called(zone)
+// ListAllTXTRecords provides a mock function with given fields: ctx, zone
+func (_m *MockPrivateDNSRepository) ListAllTXTRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	ret := _m.Called(ctx, zone)
 
 	var r0 []*armprivatedns.RecordSet
-	if rf, ok := ret.Get(0).(func(*armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
-		r0 = rf(zone)
+	if rf, ok := ret.Get(0).(func(context.Context, *armprivatedns.PrivateZone) []*armprivatedns.RecordSet); ok {
+		r0 = rf(ctx, zone)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*armprivatedns.RecordSet)
@@ -187,8 +189,8 @@ func (_m *MockPrivateDNSRepository) ListAllTXTRecords(zone *armprivatedns.Privat
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*armprivatedns.PrivateZone) error); ok {
-		r1 = rf(zone)
+	if rf, ok := ret.Get(1).(func(context.Context, *armprivatedns.PrivateZone) error); ok {
+		r1 = rf(ctx, zone)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/snyk/driftctl/enumeration/remote/azurerm/common"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+)
+
+// Activity log events are retained for 90 days
+const activityLogsLookback = 90 * 24 * time.Hour
+
+type LocalizableString struct {
+	Value          *string `json:"value,omitempty"`
+	LocalizedValue *string `json:"localizedValue,omitempty"`
+}
+
+// ActivityLogEvent is a subset of the EventData model of the Azure Monitor REST API
+type ActivityLogEvent struct {
+	Caller         *string            `json:"caller,omitempty"`
+	Category       *LocalizableString `json:"category,omitempty"`
+	EventTimestamp *time.Time         `json:"eventTimestamp,omitempty"`
+	OperationName  *LocalizableString `json:"operationName,omitempty"`
+	ResourceID     *string            `json:"resourceId,omitempty"`
+	Status         *LocalizableString `json:"status,omitempty"`
+}
+
+type ActivityLogEventCollection struct {
+	Value    []*ActivityLogEvent `json:"value,omitempty"`
+	NextLink *string             `json:"nextLink,omitempty"`
+}
+
+type ActivityLogsListResponse struct {
+	EventDataCollection ActivityLogEventCollection
+}
+
+type MonitorRepository interface {
+	ListActivityLogEvents(ctx context.Context) ([]*ActivityLogEvent, error)
+}
+
+type activityLogsListPager interface {
+	pager
+	PageResponse() ActivityLogsListResponse
+}
+
+type activityLogsClient interface {
+	List(filter string) activityLogsListPager
+}
+
+// There is no monitor SDK matching our azcore version, so we query the activity log REST API through an ARM pipeline.
+// https://docs.microsoft.com/en-us/rest/api/monitor/activity-logs/list
+type activityLogsClientImpl struct {
+	subscriptionID string
+	ep             string
+	pl             runtime.Pipeline
+}
+
+func (c activityLogsClientImpl) List(filter string) activityLogsListPager {
+	return &activityLogsListPagerImpl{
+		client: c,
+		filter: filter,
+	}
+}
+
+type activityLogsListPagerImpl struct {
+	client  activityLogsClientImpl
+	filter  string
+	current ActivityLogsListResponse
+	err     error
+}
+
+func (p *activityLogsListPagerImpl) Err() error {
+	return p.err
+}
+
+func (p *activityLogsListPagerImpl) NextPage(ctx context.Context) bool {
+	endpoint := ""
+	if !reflect.ValueOf(p.current).IsZero() {
+		if p.current.EventDataCollection.NextLink == nil || len(*p.current.EventDataCollection.NextLink) == 0 {
+			return false
+		}
+		endpoint = *p.current.EventDataCollection.NextLink
+	} else {
+		urlPath := strings.ReplaceAll(
+			"/subscriptions/{subscriptionId}/providers/Microsoft.Insights/eventtypes/management/values",
+			"{subscriptionId}",
+			url.PathEscape(p.client.subscriptionID),
+		)
+		query := url.Values{}
+		query.Set("$filter", p.filter)
+		query.Set("$select", "caller,category,eventTimestamp,operationName,resourceId,status")
+		query.Set("api-version", "2015-04-01")
+		endpoint = fmt.Sprintf("%s?%s", runtime.JoinPaths(p.client.ep, urlPath), query.Encode())
+	}
+
+	req, err := runtime.NewRequest(ctx, http.MethodGet, endpoint)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := p.client.pl.Do(req)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		body, _ := runtime.Payload(resp)
+		p.err = runtime.NewResponseError(fmt.Errorf("%s", string(body)), resp)
+		return false
+	}
+
+	result := ActivityLogsListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.EventDataCollection); err != nil {
+		p.err = runtime.NewResponseError(err, resp)
+		return false
+	}
+	p.current = result
+	return true
+}
+
+func (p *activityLogsListPagerImpl) PageResponse() ActivityLogsListResponse {
+	return p.current
+}
+
+type monitorRepository struct {
+	client activityLogsClient
+	cache  cache.Cache
+}
+
+func NewMonitorRepository(cred azcore.TokenCredential, options *arm.ClientOptions, config common.AzureProviderConfig, cache cache.Cache) *monitorRepository {
+	cp := arm.ClientOptions{}
+	if options != nil {
+		cp = *options
+	}
+	if len(cp.Host) == 0 {
+		cp.Host = arm.AzurePublicCloud
+	}
+	return &monitorRepository{
+		&activityLogsClientImpl{
+			subscriptionID: config.SubscriptionID,
+			ep:             string(cp.Host),
+			pl:             armruntime.NewPipeline("driftctl", "v0.0.0", cred, &cp),
+		},
+		cache,
+	}
+}
+
+// ListActivityLogEvents returns administrative events of the subscription activity log
+func (s *monitorRepository) ListActivityLogEvents(ctx context.Context) ([]*ActivityLogEvent, error) {
+	cacheKey := "monitorListActivityLogEvents"
+	v := s.cache.GetAndLock(cacheKey)
+	defer s.cache.Unlock(cacheKey)
+	if v != nil {
+		return v.([]*ActivityLogEvent), nil
+	}
+
+	now := time.Now().UTC()
+	filter := fmt.Sprintf(
+		"eventTimestamp ge '%s' and eventTimestamp le '%s'",
+		now.Add(-activityLogsLookback).Format(time.RFC3339),
+		now.Format(time.RFC3339),
+	)
+
+	pager := s.client.List(filter)
+	results := make([]*ActivityLogEvent, 0)
+	for pager.NextPage(ctx) {
+		resp := pager.PageResponse()
+		if err := pager.Err(); err != nil {
+			return nil, err
+		}
+		for _, event := range resp.EventDataCollection.Value {
+			if event.Category != nil && event.Category.Value != nil && *event.Category.Value != "Administrative" {
+				continue
+			}
+			results = append(results, event)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+
+	return results, nil
+}
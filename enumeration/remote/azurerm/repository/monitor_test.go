@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Monitor_ListActivityLogEvents(t *testing.T) {
+	expectedResults := []*ActivityLogEvent{
+		{
+			Caller:     to.StringPtr("alice@example.com"),
+			Category:   &LocalizableString{Value: to.StringPtr("Administrative")},
+			ResourceID: to.StringPtr("/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/elie-dev"),
+		},
+		{
+			Caller:     to.StringPtr("bob@example.com"),
+			ResourceID: to.StringPtr("/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/william-dev"),
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		mocks    func(*mockActivityLogsListPager, *cache.MockCache)
+		expected []*ActivityLogEvent
+		wantErr  string
+	}{
+		{
+			name: "should return administrative events",
+			mocks: func(mockPager *mockActivityLogsListPager, mockCache *cache.MockCache) {
+				mockPager.On("Err").Return(nil).Times(3)
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(2)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(ActivityLogsListResponse{
+					EventDataCollection: ActivityLogEventCollection{
+						Value: []*ActivityLogEvent{
+							expectedResults[0],
+							{
+								Caller:     to.StringPtr("policy"),
+								Category:   &LocalizableString{Value: to.StringPtr("Policy")},
+								ResourceID: to.StringPtr("/subscriptions/008b5f48-1b66-4d92-a6b6-d215b4c9b473/resourceGroups/elie-dev"),
+							},
+						},
+					},
+				}).Times(1)
+				mockPager.On("PageResponse").Return(ActivityLogsListResponse{
+					EventDataCollection: ActivityLogEventCollection{
+						Value: []*ActivityLogEvent{
+							expectedResults[1],
+						},
+					},
+				}).Times(1)
+
+				mockCache.On("GetAndLock", "monitorListActivityLogEvents").Return(nil).Times(1)
+				mockCache.On("Unlock", "monitorListActivityLogEvents").Times(1)
+				mockCache.On("Put", "monitorListActivityLogEvents", expectedResults).Return(true).Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should hit cache and return events",
+			mocks: func(mockPager *mockActivityLogsListPager, mockCache *cache.MockCache) {
+				mockCache.On("GetAndLock", "monitorListActivityLogEvents").Return(expectedResults).Times(1)
+				mockCache.On("Unlock", "monitorListActivityLogEvents").Times(1)
+			},
+			expected: expectedResults,
+		},
+		{
+			name: "should return remote error",
+			mocks: func(mockPager *mockActivityLogsListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("PageResponse").Return(ActivityLogsListResponse{}).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("GetAndLock", "monitorListActivityLogEvents").Return(nil).Times(1)
+				mockCache.On("Unlock", "monitorListActivityLogEvents").Times(1)
+			},
+			wantErr: "remote error",
+		},
+		{
+			name: "should return remote error after fetching all pages",
+			mocks: func(mockPager *mockActivityLogsListPager, mockCache *cache.MockCache) {
+				mockPager.On("NextPage", mock.Anything).Return(true).Times(1)
+				mockPager.On("NextPage", mock.Anything).Return(false).Times(1)
+				mockPager.On("PageResponse").Return(ActivityLogsListResponse{}).Times(1)
+				mockPager.On("Err").Return(nil).Times(1)
+				mockPager.On("Err").Return(errors.New("remote error")).Times(1)
+
+				mockCache.On("GetAndLock", "monitorListActivityLogEvents").Return(nil).Times(1)
+				mockCache.On("Unlock", "monitorListActivityLogEvents").Times(1)
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &mockActivityLogsClient{}
+			mockPager := &mockActivityLogsListPager{}
+			mockCache := &cache.MockCache{}
+
+			fakeClient.On("List", mock.Anything).Maybe().Return(mockPager)
+
+			tt.mocks(mockPager, mockCache)
+
+			s := &monitorRepository{
+				client: fakeClient,
+				cache:  mockCache,
+			}
+			got, err := s.ListActivityLogEvents(context.TODO())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			fakeClient.AssertExpectations(t)
+			mockPager.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ListActivityLogEvents() got = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -13,14 +13,14 @@ import (
 )
 
 type NetworkRepository interface {
-	ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwork, error)
-	ListAllRouteTables() ([]*armnetwork.RouteTable, error)
-	ListAllSubnets(virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error)
-	ListAllFirewalls() ([]*armnetwork.AzureFirewall, error)
-	ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAddress, error)
-	ListAllSecurityGroups() ([]*armnetwork.NetworkSecurityGroup, error)
-	ListAllLoadBalancers() ([]*armnetwork.LoadBalancer, error)
-	ListLoadBalancerRules(*armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error)
+	ListAllVirtualNetworks(ctx context.Context) ([]*armnetwork.VirtualNetwork, error)
+	ListAllRouteTables(ctx context.Context) ([]*armnetwork.RouteTable, error)
+	ListAllSubnets(ctx context.Context, virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error)
+	ListAllFirewalls(ctx context.Context) ([]*armnetwork.AzureFirewall, error)
+	ListAllPublicIPAddresses(ctx context.Context) ([]*armnetwork.PublicIPAddress, error)
+	ListAllSecurityGroups(ctx context.Context) ([]*armnetwork.NetworkSecurityGroup, error)
+	ListAllLoadBalancers(ctx context.Context) ([]*armnetwork.LoadBalancer, error)
+	ListLoadBalancerRules(context.Context, *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error)
 }
 
 type publicIPAddressesClient interface {
@@ -185,7 +185,7 @@ func NewNetworkRepository(cred azcore.TokenCredential, options *arm.ClientOption
 	}
 }
 
-func (s *networkRepository) ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwork, error) {
+func (s *networkRepository) ListAllVirtualNetworks(ctx context.Context) ([]*armnetwork.VirtualNetwork, error) {
 
 	cacheKey := "ListAllVirtualNetworks"
 	v := s.cache.GetAndLock(cacheKey)
@@ -196,7 +196,7 @@ func (s *networkRepository) ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwo
 
 	pager := s.virtualNetworksClient.ListAll(nil)
 	results := make([]*armnetwork.VirtualNetwork, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -213,7 +213,7 @@ func (s *networkRepository) ListAllVirtualNetworks() ([]*armnetwork.VirtualNetwo
 	return results, nil
 }
 
-func (s *networkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable, error) {
+func (s *networkRepository) ListAllRouteTables(ctx context.Context) ([]*armnetwork.RouteTable, error) {
 	cacheKey := "ListAllRouteTables"
 	v := s.cache.GetAndLock(cacheKey)
 	defer s.cache.Unlock(cacheKey)
@@ -223,7 +223,7 @@ func (s *networkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable, erro
 
 	pager := s.routeTableClient.ListAll(nil)
 	results := make([]*armnetwork.RouteTable, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -240,7 +240,7 @@ func (s *networkRepository) ListAllRouteTables() ([]*armnetwork.RouteTable, erro
 	return results, nil
 }
 
-func (s *networkRepository) ListAllSubnets(virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
+func (s *networkRepository) ListAllSubnets(ctx context.Context, virtualNetwork *armnetwork.VirtualNetwork) ([]*armnetwork.Subnet, error) {
 
 	cacheKey := fmt.Sprintf("ListAllSubnets_%s", *virtualNetwork.ID)
 
@@ -255,7 +255,7 @@ func (s *networkRepository) ListAllSubnets(virtualNetwork *armnetwork.VirtualNet
 
 	pager := s.subnetsClient.List(res.ResourceGroup, *virtualNetwork.Name, nil)
 	results := make([]*armnetwork.Subnet, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -272,7 +272,7 @@ func (s *networkRepository) ListAllSubnets(virtualNetwork *armnetwork.VirtualNet
 	return results, nil
 }
 
-func (s *networkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall, error) {
+func (s *networkRepository) ListAllFirewalls(ctx context.Context) ([]*armnetwork.AzureFirewall, error) {
 
 	cacheKey := "ListAllFirewalls"
 
@@ -282,7 +282,7 @@ func (s *networkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall, err
 
 	pager := s.firewallsClient.ListAll(nil)
 	results := make([]*armnetwork.AzureFirewall, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -299,7 +299,7 @@ func (s *networkRepository) ListAllFirewalls() ([]*armnetwork.AzureFirewall, err
 	return results, nil
 }
 
-func (s *networkRepository) ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAddress, error) {
+func (s *networkRepository) ListAllPublicIPAddresses(ctx context.Context) ([]*armnetwork.PublicIPAddress, error) {
 	cacheKey := "ListAllPublicIPAddresses"
 
 	if v := s.cache.Get(cacheKey); v != nil {
@@ -308,7 +308,7 @@ func (s *networkRepository) ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAd
 
 	pager := s.publicIPAddressesClient.ListAll(nil)
 	results := make([]*armnetwork.PublicIPAddress, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -325,7 +325,7 @@ func (s *networkRepository) ListAllPublicIPAddresses() ([]*armnetwork.PublicIPAd
 	return results, nil
 }
 
-func (s *networkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkSecurityGroup, error) {
+func (s *networkRepository) ListAllSecurityGroups(ctx context.Context) ([]*armnetwork.NetworkSecurityGroup, error) {
 	cacheKey := "networkListAllSecurityGroups"
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armnetwork.NetworkSecurityGroup), nil
@@ -333,7 +333,7 @@ func (s *networkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkSecuri
 
 	pager := s.networkSecurityGroupsClient.ListAll(nil)
 	results := make([]*armnetwork.NetworkSecurityGroup, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -350,7 +350,7 @@ func (s *networkRepository) ListAllSecurityGroups() ([]*armnetwork.NetworkSecuri
 	return results, nil
 }
 
-func (s *networkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalancer, error) {
+func (s *networkRepository) ListAllLoadBalancers(ctx context.Context) ([]*armnetwork.LoadBalancer, error) {
 	cacheKey := "networkListAllLoadBalancers"
 	defer s.cache.Unlock(cacheKey)
 	if v := s.cache.GetAndLock(cacheKey); v != nil {
@@ -359,7 +359,7 @@ func (s *networkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalancer,
 
 	pager := s.loadBalancersClient.ListAll(nil)
 	results := make([]*armnetwork.LoadBalancer, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -375,7 +375,7 @@ func (s *networkRepository) ListAllLoadBalancers() ([]*armnetwork.LoadBalancer,
 	return results, nil
 }
 
-func (s *networkRepository) ListLoadBalancerRules(loadBalancer *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
+func (s *networkRepository) ListLoadBalancerRules(ctx context.Context, loadBalancer *armnetwork.LoadBalancer) ([]*armnetwork.LoadBalancingRule, error) {
 	cacheKey := fmt.Sprintf("networkListLoadBalancerRules_%s", *loadBalancer.ID)
 	if v := s.cache.Get(cacheKey); v != nil {
 		return v.([]*armnetwork.LoadBalancingRule), nil
@@ -388,7 +388,7 @@ func (s *networkRepository) ListLoadBalancerRules(loadBalancer *armnetwork.LoadB
 
 	pager := s.loadBalancerRulesClient.List(loadBalancerResource.ResourceGroup, loadBalancerResource.ResourceName, &armnetwork.LoadBalancerLoadBalancingRulesListOptions{})
 	results := make([]*armnetwork.LoadBalancingRule, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
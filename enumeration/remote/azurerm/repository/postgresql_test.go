@@ -40,7 +40,7 @@ func Test_Postgresql_ListAllServers(t *testing.T) {
 		{
 			name: "should return postgres servers",
 			mocks: func(client *mockPostgresqlServersClient, mockCache *cache.MockCache) {
-				client.On("List", context.Background(), mock.Anything).Return(armpostgresql.ServersListResponse{
+				client.On("List", mock.Anything, mock.Anything).Return(armpostgresql.ServersListResponse{
 					ServersListResult: armpostgresql.ServersListResult{
 						ServerListResult: armpostgresql.ServerListResult{
 							Value: expectedResults,
@@ -65,7 +65,7 @@ func Test_Postgresql_ListAllServers(t *testing.T) {
 		{
 			name: "should return remote error",
 			mocks: func(client *mockPostgresqlServersClient, mockCache *cache.MockCache) {
-				client.On("List", context.Background(), mock.Anything).Return(armpostgresql.ServersListResponse{}, errors.New("remote error")).Times(1)
+				client.On("List", mock.Anything, mock.Anything).Return(armpostgresql.ServersListResponse{}, errors.New("remote error")).Times(1)
 
 				mockCache.On("GetAndLock", "postgresqlListAllServers").Return(nil).Times(1)
 				mockCache.On("Unlock", "postgresqlListAllServers").Return().Times(1)
@@ -85,7 +85,7 @@ func Test_Postgresql_ListAllServers(t *testing.T) {
 				serversClient: fakeClient,
 				cache:         mockCache,
 			}
-			got, err := s.ListAllServers()
+			got, err := s.ListAllServers(context.TODO())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -129,7 +129,7 @@ func Test_Postgresql_ListAllDatabases(t *testing.T) {
 		{
 			name: "should return postgres servers",
 			mocks: func(client *mockPostgresqlDatabaseClient, mockCache *cache.MockCache) {
-				client.On("ListByServer", context.Background(), "res-group", "postgresql-server-1", (*armpostgresql.DatabasesListByServerOptions)(nil)).Return(armpostgresql.DatabasesListByServerResponse{
+				client.On("ListByServer", mock.Anything, "res-group", "postgresql-server-1", (*armpostgresql.DatabasesListByServerOptions)(nil)).Return(armpostgresql.DatabasesListByServerResponse{
 					DatabasesListByServerResult: armpostgresql.DatabasesListByServerResult{
 						DatabaseListResult: armpostgresql.DatabaseListResult{
 							Value: expectedResults,
@@ -154,7 +154,7 @@ func Test_Postgresql_ListAllDatabases(t *testing.T) {
 			mocks: func(client *mockPostgresqlDatabaseClient, mockCache *cache.MockCache) {
 				mockCache.On("Get", "postgresqlListAllDatabases_res-group_postgresql-server-1").Return(nil).Times(1)
 
-				client.On("ListByServer", context.Background(), "res-group", "postgresql-server-1", (*armpostgresql.DatabasesListByServerOptions)(nil)).Return(armpostgresql.DatabasesListByServerResponse{}, errors.New("remote error")).Times(1)
+				client.On("ListByServer", mock.Anything, "res-group", "postgresql-server-1", (*armpostgresql.DatabasesListByServerOptions)(nil)).Return(armpostgresql.DatabasesListByServerResponse{}, errors.New("remote error")).Times(1)
 			},
 			wantErr: "remote error",
 		},
@@ -171,7 +171,7 @@ func Test_Postgresql_ListAllDatabases(t *testing.T) {
 				databaseClient: fakeClient,
 				cache:          mockCache,
 			}
-			got, err := s.ListAllDatabasesByServer(&armpostgresql.Server{
+			got, err := s.ListAllDatabasesByServer(context.TODO(), &armpostgresql.Server{
 				TrackedResource: armpostgresql.TrackedResource{
 					Resource: armpostgresql.Resource{
 						ID:   to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res-group/providers/Microsoft.DBforPostgreSQL/servers/postgresql-server-1"),
@@ -13,14 +13,14 @@ import (
 )
 
 type PrivateDNSRepository interface {
-	ListAllPrivateZones() ([]*armprivatedns.PrivateZone, error)
-	ListAllARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllAAAARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllCNAMERecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllPTRRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllMXRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllSRVRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
-	ListAllTXTRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllPrivateZones(ctx context.Context) ([]*armprivatedns.PrivateZone, error)
+	ListAllARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllAAAARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllCNAMERecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllPTRRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllMXRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllSRVRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
+	ListAllTXTRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error)
 }
 
 type privateDNSZoneListPager interface {
@@ -71,7 +71,7 @@ func NewPrivateDNSRepository(cred azcore.TokenCredential, options *arm.ClientOpt
 	}
 }
 
-func (s *privateDNSRepository) listAllRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+func (s *privateDNSRepository) listAllRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
 	cacheKey := fmt.Sprintf("privateDNSlistAllRecords-%s", *zone.ID)
 	v := s.cache.GetAndLock(cacheKey)
 	defer s.cache.Unlock(cacheKey)
@@ -86,7 +86,7 @@ func (s *privateDNSRepository) listAllRecords(zone *armprivatedns.PrivateZone) (
 
 	pager := s.recordClient.List(res.ResourceGroup, *zone.Name, nil)
 	results := make([]*armprivatedns.RecordSet, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -103,8 +103,8 @@ func (s *privateDNSRepository) listAllRecords(zone *armprivatedns.PrivateZone) (
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +119,8 @@ func (s *privateDNSRepository) ListAllARecords(zone *armprivatedns.PrivateZone)
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllAAAARecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllAAAARecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -135,8 +135,8 @@ func (s *privateDNSRepository) ListAllAAAARecords(zone *armprivatedns.PrivateZon
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllPTRRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllPTRRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -151,8 +151,8 @@ func (s *privateDNSRepository) ListAllPTRRecords(zone *armprivatedns.PrivateZone
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllCNAMERecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +167,8 @@ func (s *privateDNSRepository) ListAllCNAMERecords(zone *armprivatedns.PrivateZo
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllMXRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllMXRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -183,8 +183,8 @@ func (s *privateDNSRepository) ListAllMXRecords(zone *armprivatedns.PrivateZone)
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllSRVRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllSRVRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +199,8 @@ func (s *privateDNSRepository) ListAllSRVRecords(zone *armprivatedns.PrivateZone
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllTXTRecords(zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
-	records, err := s.listAllRecords(zone)
+func (s *privateDNSRepository) ListAllTXTRecords(ctx context.Context, zone *armprivatedns.PrivateZone) ([]*armprivatedns.RecordSet, error) {
+	records, err := s.listAllRecords(ctx, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -215,7 +215,7 @@ func (s *privateDNSRepository) ListAllTXTRecords(zone *armprivatedns.PrivateZone
 	return results, nil
 }
 
-func (s *privateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.PrivateZone, error) {
+func (s *privateDNSRepository) ListAllPrivateZones(ctx context.Context) ([]*armprivatedns.PrivateZone, error) {
 	cacheKey := "privateDNSListAllPrivateZones"
 	v := s.cache.GetAndLock(cacheKey)
 	defer s.cache.Unlock(cacheKey)
@@ -225,7 +225,7 @@ func (s *privateDNSRepository) ListAllPrivateZones() ([]*armprivatedns.PrivateZo
 
 	pager := s.zoneClient.List(nil)
 	results := make([]*armprivatedns.PrivateZone, 0)
-	for pager.NextPage(context.Background()) {
+	for pager.NextPage(ctx) {
 		resp := pager.PageResponse()
 		if err := pager.Err(); err != nil {
 			return nil, err
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"reflect"
 	"testing"
@@ -133,7 +135,7 @@ func Test_Resources_ListAllResourceGroups(t *testing.T) {
 				client: fakeClient,
 				cache:  mockCache,
 			}
-			got, err := s.ListAllResourceGroups()
+			got, err := s.ListAllResourceGroups(context.TODO())
 			if tt.wantErr != "" {
 				assert.EqualError(t, err, tt.wantErr)
 			} else {
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -107,7 +108,7 @@ func Test_ListAllStorageAccount_MultiplesResults(t *testing.T) {
 		storageAccountsClient: fakeClient,
 		cache:                 c,
 	}
-	got, err := s.ListAllStorageAccount()
+	got, err := s.ListAllStorageAccount(context.TODO())
 	if err != nil {
 		t.Errorf("ListAllStorageAccount() error = %v", err)
 		return
@@ -143,7 +144,7 @@ func Test_ListAllStorageAccount_MultiplesResults_WithCache(t *testing.T) {
 		storageAccountsClient: fakeClient,
 		cache:                 c,
 	}
-	got, err := s.ListAllStorageAccount()
+	got, err := s.ListAllStorageAccount(context.TODO())
 	if err != nil {
 		t.Errorf("ListAllStorageAccount() error = %v", err)
 		return
@@ -174,7 +175,7 @@ func Test_ListAllStorageAccount_Error(t *testing.T) {
 		storageAccountsClient: fakeClient,
 		cache:                 cache.New(0),
 	}
-	got, err := s.ListAllStorageAccount()
+	got, err := s.ListAllStorageAccount(context.TODO())
 
 	mockPager.AssertExpectations(t)
 	fakeClient.AssertExpectations(t)
@@ -266,7 +267,7 @@ func Test_ListAllStorageContainer_MultiplesResults(t *testing.T) {
 		blobContainerClient: fakeClient,
 		cache:               c,
 	}
-	got, err := s.ListAllStorageContainer(&account)
+	got, err := s.ListAllStorageContainer(context.TODO(), &account)
 	if err != nil {
 		t.Errorf("ListAllStorageAccount() error = %v", err)
 		return
@@ -302,7 +303,7 @@ func Test_ListAllStorageContainer_MultiplesResults_WithCache(t *testing.T) {
 		blobContainerClient: fakeClient,
 		cache:               c,
 	}
-	got, err := s.ListAllStorageContainer(&account)
+	got, err := s.ListAllStorageContainer(context.TODO(), &account)
 	if err != nil {
 		t.Errorf("ListAllStorageAccount() error = %v", err)
 		return
@@ -331,7 +332,7 @@ func Test_ListAllStorageContainer_InvalidStorageAccountResourceID(t *testing.T)
 		blobContainerClient: fakeClient,
 		cache:               cache.New(0),
 	}
-	got, err := s.ListAllStorageContainer(&account)
+	got, err := s.ListAllStorageContainer(context.TODO(), &account)
 
 	fakeClient.AssertExpectations(t)
 
@@ -364,7 +365,7 @@ func Test_ListAllStorageContainer_Error(t *testing.T) {
 		blobContainerClient: fakeClient,
 		cache:               cache.New(0),
 	}
-	got, err := s.ListAllStorageContainer(&account)
+	got, err := s.ListAllStorageContainer(context.TODO(), &account)
 
 	fakeClient.AssertExpectations(t)
 	mockPager.AssertExpectations(t)
@@ -398,7 +399,7 @@ func Test_ListAllStorageContainer_IgnoredError(t *testing.T) {
 		blobContainerClient: fakeClient,
 		cache:               cache.New(0),
 	}
-	got, err := s.ListAllStorageContainer(&account)
+	got, err := s.ListAllStorageContainer(context.TODO(), &account)
 
 	fakeClient.AssertExpectations(t)
 	mockPager.AssertExpectations(t)
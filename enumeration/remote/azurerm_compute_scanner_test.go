@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -40,7 +41,7 @@ func TestAzurermCompute_Image(t *testing.T) {
 		{
 			test: "no images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{}, nil)
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -49,14 +50,14 @@ func TestAzurermCompute_Image(t *testing.T) {
 		{
 			test: "error listing images",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return(nil, dummyError)
+				repository.On("ListAllImages", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzureImageResourceType),
 		},
 		{
 			test: "multiple images including an invalid ID",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllImages").Return([]*armcompute.Image{
+				repository.On("ListAllImages", mock.Anything).Return([]*armcompute.Image{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/4e411884-65b0-4911-bc80-52f9a21942a2/resourceGroups/testgroup/providers/Microsoft.Compute/images/image1"),
@@ -106,7 +107,7 @@ func TestAzurermCompute_Image(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -134,14 +135,14 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "no public key",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{}, nil)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{}, nil)
 			},
 		},
 		{
 			test:    "error listing public keys",
 			dirName: "azurerm_ssh_public_key_empty",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return(nil, dummyError)
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzureSSHPublicKeyResourceType),
 		},
@@ -149,7 +150,7 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			test:    "multiple public keys",
 			dirName: "azurerm_ssh_public_key_multiple",
 			mocks: func(repository *repository.MockComputeRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllSSHPublicKeys").Return([]*armcompute.SSHPublicKeyResource{
+				repository.On("ListAllSSHPublicKeys", mock.Anything).Return([]*armcompute.SSHPublicKeyResource{
 					{
 						Resource: armcompute.Resource{
 							ID:   to.StringPtr("/subscriptions/7bfb2c5c-7308-46ed-8ae4-fffa356eb406/resourceGroups/TESTRESGROUP/providers/Microsoft.Compute/sshPublicKeys/example-key"),
@@ -213,7 +214,7 @@ func TestAzurermCompute_SSHPublicKey(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
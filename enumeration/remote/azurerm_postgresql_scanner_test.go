@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -34,7 +35,7 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		{
 			test: "no postgres server",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +44,14 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "multiple postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -101,7 +102,7 @@ func TestAzurermPostgresqlServer(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -128,7 +129,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		{
 			test: "no postgres database",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{}, nil)
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -137,14 +138,14 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 		{
 			test: "error listing postgres servers",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return(nil, dummyError)
+				repository.On("ListAllServers", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType, resourceazure.AzurePostgresqlServerResourceType),
 		},
 		{
 			test: "error listing postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -155,14 +156,14 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return(nil, dummyError).Once()
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePostgresqlDatabaseResourceType),
 		},
 		{
 			test: "multiple postgres databases",
 			mocks: func(repository *repository.MockPostgresqlRespository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllServers").Return([]*armpostgresql.Server{
+				repository.On("ListAllServers", mock.Anything).Return([]*armpostgresql.Server{
 					{
 						TrackedResource: armpostgresql.TrackedResource{
 							Resource: armpostgresql.Resource{
@@ -173,7 +174,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 					},
 				}, nil).Once()
 
-				repository.On("ListAllDatabasesByServer", mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
+				repository.On("ListAllDatabasesByServer", mock.Anything, mock.IsType(&armpostgresql.Server{})).Return([]*armpostgresql.Database{
 					{
 						ProxyResource: armpostgresql.ProxyResource{
 							Resource: armpostgresql.Resource{
@@ -224,7 +225,7 @@ func TestAzurermPostgresqlDatabase(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
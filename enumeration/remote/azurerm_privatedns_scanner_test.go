@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -42,14 +43,14 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "no private zone",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zones",
 			dirName: "azurerm_private_dns_private_zone_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -57,7 +58,7 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			test:    "multiple private zones",
 			dirName: "azurerm_private_dns_private_zone_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -133,7 +134,7 @@ func TestAzurermPrivateDNSZone(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -161,14 +162,14 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "no private a record",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -176,7 +177,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "error listing private a records",
 			dirName: "azurerm_private_dns_a_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -186,7 +187,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSARecordResourceType),
 		},
@@ -194,7 +195,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			test:    "multiple private a records",
 			dirName: "azurerm_private_dns_a_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -205,7 +206,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -284,7 +285,7 @@ func TestAzurermPrivateDNSARecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -312,14 +313,14 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "no private aaaa record",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -327,7 +328,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "error listing private aaaa records",
 			dirName: "azurerm_private_dns_aaaa_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -337,7 +338,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllAAAARecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSAAAARecordResourceType),
 		},
@@ -345,7 +346,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			test:    "multiple private aaaaa records",
 			dirName: "azurerm_private_dns_aaaaa_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -356,7 +357,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllAAAARecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllAAAARecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -436,7 +437,7 @@ func TestAzurermPrivateDNSAAAARecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -464,14 +465,14 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "no private cname record",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -479,7 +480,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "error listing private cname records",
 			dirName: "azurerm_private_dns_cname_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -489,7 +490,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllCNAMERecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSCNameRecordResourceType),
 		},
@@ -497,7 +498,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			test:    "multiple private cname records",
 			dirName: "azurerm_private_dns_cname_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -508,7 +509,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllCNAMERecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllCNAMERecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -576,7 +577,7 @@ func TestAzurermPrivateDNSCNAMERecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -604,14 +605,14 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "no private ptr record",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -619,7 +620,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "error listing private ptr records",
 			dirName: "azurerm_private_dns_ptr_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -629,7 +630,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllPTRRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSPTRRecordResourceType),
 		},
@@ -637,7 +638,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			test:    "multiple private ptra records",
 			dirName: "azurerm_private_dns_ptr_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -648,7 +649,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllPTRRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllPTRRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -727,7 +728,7 @@ func TestAzurermPrivateDNSPTRRecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -755,14 +756,14 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "no private mx record",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -770,7 +771,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "error listing private mx records",
 			dirName: "azurerm_private_dns_mx_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -780,7 +781,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllMXRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSMXRecordResourceType),
 		},
@@ -788,7 +789,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			test:    "multiple private mx records",
 			dirName: "azurerm_private_dns_mx_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -799,7 +800,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllMXRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllMXRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -879,7 +880,7 @@ func TestAzurermPrivateDNSMXRecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -907,14 +908,14 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "no private srv record",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -922,7 +923,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "error listing private srv records",
 			dirName: "azurerm_private_dns_srv_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -932,7 +933,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllSRVRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSSRVRecordResourceType),
 		},
@@ -940,7 +941,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			test:    "multiple private srv records",
 			dirName: "azurerm_private_dns_srv_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -951,7 +952,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllSRVRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllSRVRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1030,7 +1031,7 @@ func TestAzurermPrivateDNSSRVRecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -1058,14 +1059,14 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "no private txt record",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{}, nil)
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{}, nil)
 			},
 		},
 		{
 			test:    "error listing private zone",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return(nil, dummyError)
+				repository.On("ListAllPrivateZones", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingErrorWithType(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType, resourceazure.AzurePrivateDNSZoneResourceType),
 		},
@@ -1073,7 +1074,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "error listing private txt records",
 			dirName: "azurerm_private_dns_txt_record_empty",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1083,7 +1084,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 						},
 					},
 				}, nil)
-				repository.On("ListAllTXTRecords", mock.Anything).Return(nil, dummyError)
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: remoteerr.NewResourceListingError(dummyError, resourceazure.AzurePrivateDNSTXTRecordResourceType),
 		},
@@ -1091,7 +1092,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			test:    "multiple private txt records",
 			dirName: "azurerm_private_dns_txt_record_multiple",
 			mocks: func(repository *repository.MockPrivateDNSRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllPrivateZones").Return([]*armprivatedns.PrivateZone{
+				repository.On("ListAllPrivateZones", mock.Anything).Return([]*armprivatedns.PrivateZone{
 					{
 						TrackedResource: armprivatedns.TrackedResource{
 							Resource: armprivatedns.Resource{
@@ -1102,7 +1103,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 					},
 				}, nil)
 
-				repository.On("ListAllTXTRecords", mock.Anything).Return([]*armprivatedns.RecordSet{
+				repository.On("ListAllTXTRecords", mock.Anything, mock.Anything).Return([]*armprivatedns.RecordSet{
 					{
 						ProxyResource: armprivatedns.ProxyResource{
 							Resource: armprivatedns.Resource{
@@ -1181,7 +1182,7 @@ func TestAzurermPrivateDNSTXTRecord(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -34,7 +35,7 @@ func TestAzurermResourceGroups(t *testing.T) {
 		{
 			test: "no resource group",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return([]*armresources.ResourceGroup{}, nil)
+				repository.On("ListAllResourceGroups", mock.Anything).Return([]*armresources.ResourceGroup{}, nil)
 			},
 			assertExpected: func(t *testing.T, got []*resource.Resource) {
 				assert.Len(t, got, 0)
@@ -43,14 +44,14 @@ func TestAzurermResourceGroups(t *testing.T) {
 		{
 			test: "error listing resource groups",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return(nil, dummyError)
+				repository.On("ListAllResourceGroups", mock.Anything).Return(nil, dummyError)
 			},
 			wantErr: error2.NewResourceListingError(dummyError, resourceazure.AzureResourceGroupResourceType),
 		},
 		{
 			test: "multiple resource groups",
 			mocks: func(repository *repository.MockResourcesRepository, alerter *mocks.AlerterInterface) {
-				repository.On("ListAllResourceGroups").Return([]*armresources.ResourceGroup{
+				repository.On("ListAllResourceGroups", mock.Anything).Return([]*armresources.ResourceGroup{
 					{
 						ID:   to.StringPtr("group1"),
 						Name: to.StringPtr("group1"),
@@ -93,7 +94,7 @@ func TestAzurermResourceGroups(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
@@ -0,0 +1,257 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TTLConfig defines how long persisted values stay valid.
+// ByType overrides Default for values registered with given resource types.
+type TTLConfig struct {
+	Default time.Duration
+	ByType  map[string]time.Duration
+}
+
+// Enabled returns true if at least one value may be persisted
+func (c TTLConfig) Enabled() bool {
+	if c.Default > 0 {
+		return true
+	}
+	for _, ttl := range c.ByType {
+		if ttl > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c TTLConfig) get(resourceTypes []string) time.Duration {
+	ttl := time.Duration(-1)
+	for _, ty := range resourceTypes {
+		if typeTTL, exist := c.ByType[ty]; exist && (ttl < 0 || typeTTL < ttl) {
+			ttl = typeTTL
+		}
+	}
+	if ttl < 0 {
+		return c.Default
+	}
+	return ttl
+}
+
+type persistentType struct {
+	typ           reflect.Type
+	resourceTypes []string
+}
+
+var (
+	persistentTypesMu sync.RWMutex
+	persistentTypes   = map[string]persistentType{}
+	persistentKeys    = map[string]persistentType{}
+	// Types of values that are not persisted, to only log them once
+	ignoredTypes sync.Map
+)
+
+// RegisterPersistentType allows values of the same type as value to be stored on disk.
+// Since cached values are retrieved as interface{}, the concrete type must be known
+// to decode them back. resourceTypes are the resource types enumerated using those values,
+// they are used to compute the TTL of an entry.
+func RegisterPersistentType(value interface{}, resourceTypes ...string) {
+	typ := reflect.TypeOf(value)
+	persistentTypesMu.Lock()
+	defer persistentTypesMu.Unlock()
+	persistentTypes[typ.String()] = persistentType{typ, resourceTypes}
+}
+
+// RegisterPersistentKey allows values of the same type as value to be stored on disk when their key starts with prefix.
+// It is meant for generic values like []*string that are shared by several repositories, and thus cannot be
+// registered by type since we would not know which resource types they belong to.
+func RegisterPersistentKey(prefix string, value interface{}, resourceTypes ...string) {
+	typ := reflect.TypeOf(value)
+	persistentTypesMu.Lock()
+	defer persistentTypesMu.Unlock()
+	persistentKeys[prefix] = persistentType{typ, resourceTypes}
+}
+
+// PersistentType returns the type values stored under key with a given type name are decoded to.
+// Values registered with a key prefix take precedence over values registered by type, the longest prefix wins.
+func PersistentType(key, name string) (reflect.Type, bool) {
+	t, exist := getPersistentType(key, name)
+	return t.typ, exist
+}
+
+func getPersistentType(key, name string) (persistentType, bool) {
+	persistentTypesMu.RLock()
+	defer persistentTypesMu.RUnlock()
+	var (
+		match  persistentType
+		prefix string
+		found  bool
+	)
+	for p, t := range persistentKeys {
+		if strings.HasPrefix(key, p) && len(p) > len(prefix) && t.typ.String() == name {
+			match, prefix, found = t, p, true
+		}
+	}
+	if found {
+		return match, true
+	}
+	t, exist := persistentTypes[name]
+	return t, exist
+}
+
+type persistentEntry struct {
+	Key       string          `json:"key"`
+	Type      string          `json:"type"`
+	ExpiresAt time.Time       `json:"expires_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// PersistentCache keeps values in memory and writes values of registered types to disk,
+// so they can be reused by the next scans until they expire.
+type PersistentCache struct {
+	memory    Cache
+	directory string
+	ttl       TTLConfig
+}
+
+func NewPersistentCache(memory Cache, directory string, ttl TTLConfig) Cache {
+	return &PersistentCache{
+		memory:    memory,
+		directory: directory,
+		ttl:       ttl,
+	}
+}
+
+func (c *PersistentCache) Put(key string, value interface{}) bool {
+	c.persist(key, value)
+	return c.memory.Put(key, value)
+}
+
+func (c *PersistentCache) Get(key string) interface{} {
+	if v := c.memory.Get(key); v != nil {
+		return v
+	}
+	return c.restore(key)
+}
+
+func (c *PersistentCache) GetAndLock(key string) interface{} {
+	if v := c.memory.GetAndLock(key); v != nil {
+		return v
+	}
+	return c.restore(key)
+}
+
+func (c *PersistentCache) Unlock(key string) {
+	c.memory.Unlock(key)
+}
+
+func (c *PersistentCache) Len() int {
+	return c.memory.Len()
+}
+
+func (c *PersistentCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.directory, hex.EncodeToString(hash[:])+".json")
+}
+
+func (c *PersistentCache) persist(key string, value interface{}) {
+	if value == nil {
+		return
+	}
+	typ, registered := getPersistentType(key, reflect.TypeOf(value).String())
+	if !registered {
+		if _, logged := ignoredTypes.LoadOrStore(reflect.TypeOf(value).String(), struct{}{}); !logged {
+			logrus.WithFields(logrus.Fields{"key": key, "type": reflect.TypeOf(value).String()}).Debug("Cache entries of this type are not persisted")
+		}
+		return
+	}
+	ttl := c.ttl.get(typ.resourceTypes)
+	if ttl <= 0 {
+		return
+	}
+
+	logger := logrus.WithFields(logrus.Fields{"key": key, "type": typ.typ.String()})
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		logger.WithField("error", err).Debug("Unable to serialize cache entry")
+		return
+	}
+	content, err := json.Marshal(persistentEntry{
+		Key:       key,
+		Type:      typ.typ.String(),
+		ExpiresAt: time.Now().Add(ttl),
+		Value:     raw,
+	})
+	if err != nil {
+		logger.WithField("error", err).Debug("Unable to serialize cache entry")
+		return
+	}
+
+	if err := os.MkdirAll(c.directory, 0700); err != nil {
+		logger.WithField("error", err).Debug("Unable to create cache directory")
+		return
+	}
+
+	// Write to a temporary file first so concurrent scans never read a partial entry
+	tmp, err := os.CreateTemp(c.directory, "entry-*")
+	if err != nil {
+		logger.WithField("error", err).Debug("Unable to write cache entry")
+		return
+	}
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		logger.WithField("error", err).Debug("Unable to write cache entry")
+	}
+}
+
+func (c *PersistentCache) restore(key string) interface{} {
+	path := c.path(key)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	entry := persistentEntry{}
+	if err := json.Unmarshal(content, &entry); err != nil || entry.Key != key {
+		return nil
+	}
+
+	if time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(path)
+		return nil
+	}
+
+	typ, registered := getPersistentType(key, entry.Type)
+	if !registered {
+		return nil
+	}
+
+	value := reflect.New(typ.typ)
+	if err := json.Unmarshal(entry.Value, value.Interface()); err != nil {
+		logrus.WithFields(logrus.Fields{"key": key, "error": err}).Debug("Unable to deserialize cache entry")
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{"key": key, "expires_at": entry.ExpiresAt}).Debug("Using persisted cache entry")
+
+	v := value.Elem().Interface()
+	c.memory.Put(key, v)
+	return v
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type persistentTestValue struct {
+	Name string
+}
+
+type unregisteredTestValue struct {
+	Name string
+}
+
+func init() {
+	RegisterPersistentType([]*persistentTestValue{}, "test_resource", "other_test_resource")
+	RegisterPersistentKey("testListAllNames_", []string{}, "test_resource")
+}
+
+func TestPersistentCache(t *testing.T) {
+	t.Run("should restore registered values from disk", func(t *testing.T) {
+		dir := t.TempDir()
+		value := []*persistentTestValue{{Name: "foo"}}
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		assert.Equal(t, false, cache.Put("key", value))
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 1)
+
+		cache = NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		assert.Equal(t, 0, cache.Len())
+		assert.Equal(t, value, cache.Get("key"))
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("should restore registered values from disk with lock", func(t *testing.T) {
+		dir := t.TempDir()
+		value := []*persistentTestValue{{Name: "foo"}}
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		cache.Put("key", value)
+
+		cache = NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		assert.Equal(t, value, cache.GetAndLock("key"))
+		cache.Unlock("key")
+	})
+
+	t.Run("should not persist unregistered values", func(t *testing.T) {
+		dir := t.TempDir()
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		cache.Put("key", []*unregisteredTestValue{{Name: "foo"}})
+		cache.Put("nil", nil)
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 0)
+	})
+
+	t.Run("should persist generic values registered by key", func(t *testing.T) {
+		dir := t.TempDir()
+		value := []string{"foo", "bar"}
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		cache.Put("testListAllNames_foo", value)
+		cache.Put("otherKey", []string{"baz"})
+		cache.Put("testListAllNames_bar", []*unregisteredTestValue{{Name: "foo"}})
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 1)
+
+		cache = NewPersistentCache(New(5), dir, TTLConfig{Default: time.Hour})
+		assert.Equal(t, value, cache.Get("testListAllNames_foo"))
+		assert.Nil(t, cache.Get("otherKey"))
+	})
+
+	t.Run("should not return expired values", func(t *testing.T) {
+		dir := t.TempDir()
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{Default: time.Millisecond})
+		cache.Put("key", []*persistentTestValue{{Name: "foo"}})
+		time.Sleep(5 * time.Millisecond)
+
+		cache = NewPersistentCache(New(5), dir, TTLConfig{Default: time.Millisecond})
+		assert.Nil(t, cache.Get("key"))
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 0)
+	})
+
+	t.Run("should use the lowest TTL of resource types", func(t *testing.T) {
+		ttl := TTLConfig{
+			Default: time.Hour,
+			ByType: map[string]time.Duration{
+				"test_resource":       time.Minute,
+				"other_test_resource": time.Second,
+			},
+		}
+		assert.Equal(t, time.Second, ttl.get([]string{"test_resource", "other_test_resource"}))
+		assert.Equal(t, time.Minute, ttl.get([]string{"test_resource"}))
+		assert.Equal(t, time.Hour, ttl.get([]string{"unknown_resource"}))
+	})
+
+	t.Run("should not persist values with resource type disabled", func(t *testing.T) {
+		dir := t.TempDir()
+
+		cache := NewPersistentCache(New(5), dir, TTLConfig{
+			Default: time.Hour,
+			ByType:  map[string]time.Duration{"other_test_resource": 0},
+		})
+		cache.Put("key", []*persistentTestValue{{Name: "foo"}})
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 0)
+		assert.NotNil(t, cache.Get("key"))
+	})
+
+	t.Run("should be disabled without positive TTL", func(t *testing.T) {
+		assert.False(t, TTLConfig{}.Enabled())
+		assert.False(t, TTLConfig{ByType: map[string]time.Duration{"test_resource": 0}}.Enabled())
+		assert.True(t, TTLConfig{ByType: map[string]time.Duration{"test_resource": time.Minute}}.Enabled())
+		assert.True(t, TTLConfig{Default: time.Minute}.Enabled())
+	})
+}
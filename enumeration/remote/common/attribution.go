@@ -0,0 +1,20 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+)
+
+// Attribution describes the last change made to a remote resource
+type Attribution struct {
+	Principal string    `json:"principal"`
+	Date      time.Time `json:"date"`
+}
+
+// Attributor retrieves the last modification of a resource from the provider's audit trail.
+// A nil attribution without error means that no matching event was found.
+type Attributor interface {
+	Attribute(ctx context.Context, res *resource.Resource) (*Attribution, error)
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/hashicorp/terraform/flatmap"
@@ -11,7 +12,7 @@ import (
 )
 
 type DetailsFetcher interface {
-	ReadDetails(*resource.Resource) (*resource.Resource, error)
+	ReadDetails(context.Context, *resource.Resource) (*resource.Resource, error)
 }
 
 type GenericDetailsFetcher struct {
@@ -28,7 +29,7 @@ func NewGenericDetailsFetcher(resType resource.ResourceType, provider terraform.
 	}
 }
 
-func (f *GenericDetailsFetcher) ReadDetails(res *resource.Resource) (*resource.Resource, error) {
+func (f *GenericDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
 	attributes := map[string]string{}
 	if res.Attributes() != nil {
 		for k, v := range *res.Attributes() {
@@ -52,7 +53,7 @@ func (f *GenericDetailsFetcher) ReadDetails(res *resource.Resource) (*resource.R
 			}
 		}
 	}
-	ctyVal, err := f.reader.ReadResource(terraform.ReadResourceArgs{
+	ctyVal, err := f.reader.ReadResource(ctx, terraform.ReadResourceArgs{
 		Ty:         f.resType,
 		ID:         res.ResourceId(),
 		Attributes: attributes,
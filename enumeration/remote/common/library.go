@@ -1,23 +1,39 @@
 package common
 
 import (
+	"context"
+
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/resource"
 )
 
 type Enumerator interface {
 	SupportedType() resource.ResourceType
-	Enumerate() ([]*resource.Resource, error)
+	Enumerate(ctx context.Context) ([]*resource.Resource, error)
 }
 
+// AliasResolver returns the provider alias to read the details of a resource found in IaC with, or an empty string for the default one.
+// Resources are given by type for those located like another one, e.g. in the region of their parent.
+type AliasResolver func(res *resource.Resource, resources map[string][]*resource.Resource) string
+
 type RemoteLibrary struct {
 	enumerators     []Enumerator
 	detailsFetchers map[resource.ResourceType]DetailsFetcher
+	attributor      Attributor
+	rateLimiter     *ratelimit.Limiter
+	aliasResolver   AliasResolver
+	// Remotes only set up audit log clients when attribution is requested
+	attribution bool
 }
 
 func NewRemoteLibrary() *RemoteLibrary {
 	return &RemoteLibrary{
 		make([]Enumerator, 0),
 		make(map[resource.ResourceType]DetailsFetcher),
+		nil,
+		nil,
+		nil,
+		false,
 	}
 }
 
@@ -36,3 +52,36 @@ func (r *RemoteLibrary) AddDetailsFetcher(ty resource.ResourceType, detailsFetch
 func (r *RemoteLibrary) GetDetailsFetcher(ty resource.ResourceType) DetailsFetcher {
 	return r.detailsFetchers[ty]
 }
+
+func (r *RemoteLibrary) SetAttributor(attributor Attributor) {
+	r.attributor = attributor
+}
+
+func (r *RemoteLibrary) Attributor() Attributor {
+	return r.attributor
+}
+
+func (r *RemoteLibrary) EnableAttribution() {
+	r.attribution = true
+}
+
+func (r *RemoteLibrary) AttributionEnabled() bool {
+	return r.attribution
+}
+
+func (r *RemoteLibrary) SetRateLimiter(limiter *ratelimit.Limiter) {
+	r.rateLimiter = limiter
+}
+
+func (r *RemoteLibrary) RateLimiter() *ratelimit.Limiter {
+	return r.rateLimiter
+}
+
+// SetAliasResolver sets how refreshed resources are read with the same provider alias as their enumerator would use
+func (r *RemoteLibrary) SetAliasResolver(resolver AliasResolver) {
+	r.aliasResolver = resolver
+}
+
+func (r *RemoteLibrary) AliasResolver() AliasResolver {
+	return r.aliasResolver
+}
@@ -3,6 +3,8 @@
 package common
 
 import (
+	context "context"
+
 	resource "github.com/snyk/driftctl/enumeration/resource"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,13 +14,13 @@ type MockEnumerator struct {
 	mock.Mock
 }
 
-// Enumerate provides a mock function with given fields:
-func (_m *MockEnumerator) Enumerate() ([]*resource.Resource, error) {
-	ret := _m.Called()
+// Enumerate provides a mock function with given fields: ctx
+func (_m *MockEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*resource.Resource
-	if rf, ok := ret.Get(0).(func() []*resource.Resource); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*resource.Resource); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*resource.Resource)
@@ -26,8 +28,8 @@ func (_m *MockEnumerator) Enumerate() ([]*resource.Resource, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
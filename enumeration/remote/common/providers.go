@@ -14,17 +14,15 @@ const (
 	RemoteAzureTerraform  = "azure+tf"
 )
 
-var remoteParameterMapping = map[RemoteParameter]string{
-	RemoteAWSTerraform:    tf.AWS,
-	RemoteGithubTerraform: tf.GITHUB,
-	RemoteGoogleTerraform: tf.GOOGLE,
-	RemoteAzureTerraform:  tf.AZURE,
+// remoteParameterMapping holds the registry address of the terraform provider backing each remote
+var remoteParameterMapping = map[RemoteParameter]lock.ProviderAddress{
+	RemoteAWSTerraform:    {Hostname: tf.DefaultRegistryHost, Namespace: tf.DefaultNamespace, Type: tf.AWS},
+	RemoteGithubTerraform: {Hostname: tf.DefaultRegistryHost, Namespace: tf.DefaultNamespace, Type: tf.GITHUB},
+	RemoteGoogleTerraform: {Hostname: tf.DefaultRegistryHost, Namespace: tf.DefaultNamespace, Type: tf.GOOGLE},
+	RemoteAzureTerraform:  {Hostname: tf.DefaultRegistryHost, Namespace: tf.DefaultNamespace, Type: tf.AZURE},
 }
 
 func (p RemoteParameter) GetProviderAddress() *lock.ProviderAddress {
-	return &lock.ProviderAddress{
-		Hostname:  "registry.terraform.io",
-		Namespace: "hashicorp",
-		Type:      remoteParameterMapping[p],
-	}
+	address := remoteParameterMapping[p]
+	return &address
 }
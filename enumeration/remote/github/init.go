@@ -15,9 +15,9 @@ import (
  * Required to use Scanner
  */
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, hashes []string, source terraform.ProviderSource, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, mirror terraform.ProviderMirror) error {
 
-	provider, err := NewGithubTerraformProvider(version, progress, configDir)
+	provider, err := NewGithubTerraformProvider(version, hashes, source, progress, configDir, mirror)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,9 @@ import (
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 )
 
+// DefaultProviderVersion is the provider version used when none is configured
+const DefaultProviderVersion = "4.4.0"
+
 type GithubTerraformProvider struct {
 	*terraform.TerraformProvider
 	name    string
@@ -20,18 +23,22 @@ type githubConfig struct {
 	Organization string
 }
 
-func NewGithubTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*GithubTerraformProvider, error) {
+func NewGithubTerraformProvider(version string, hashes []string, source tf.ProviderSource, progress enumeration.ProgressCounter, configDir string, mirror tf.ProviderMirror) (*GithubTerraformProvider, error) {
 	if version == "" {
-		version = "4.4.0"
+		version = DefaultProviderVersion
 	}
 	p := &GithubTerraformProvider{
 		version: version,
 		name:    "github",
 	}
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Hostname:  source.Hostname,
+		Namespace: source.Namespace,
 		Key:       p.name,
 		Version:   version,
 		ConfigDir: configDir,
+		Mirror:    mirror,
+		Hashes:    hashes,
 	})
 	if err != nil {
 		return nil, err
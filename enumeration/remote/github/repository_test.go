@@ -23,7 +23,7 @@ func TestListRepositoriesForUser_WithError(t *testing.T) {
 		cache:  cache.New(1),
 	}
 
-	_, err := r.ListRepositories()
+	_, err := r.ListRepositories(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -81,12 +81,11 @@ func TestListRepositoriesForUser(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{},
 		cache:  store,
 	}
 
-	repos, err := r.ListRepositories()
+	repos, err := r.ListRepositories(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -99,7 +98,7 @@ func TestListRepositoriesForUser(t *testing.T) {
 	}, repos)
 
 	// Check that results were cached
-	cachedData, err := r.ListRepositories()
+	cachedData, err := r.ListRepositories(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, repos, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListRepositories"))
@@ -118,7 +117,7 @@ func TestListRepositoriesForOrganization_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListRepositories()
+	_, err := r.ListRepositories(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -182,14 +181,13 @@ func TestListRepositoriesForOrganization(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	repos, err := r.ListRepositories()
+	repos, err := r.ListRepositories(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -202,7 +200,7 @@ func TestListRepositoriesForOrganization(t *testing.T) {
 	}, repos)
 
 	// Check that results were cached
-	cachedData, err := r.ListRepositories()
+	cachedData, err := r.ListRepositories(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, repos, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListRepositories"))
@@ -221,14 +219,14 @@ func TestListTeams_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeams()
+	_, err := r.ListTeams(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListTeams_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListTeams()
+	teams, err := r.ListTeams(context.TODO())
 	assert.Nil(t, err)
 	assert.Equal(t, []Team{}, teams)
 }
@@ -299,14 +297,13 @@ func TestListTeams(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListTeams()
+	teams, err := r.ListTeams(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -319,7 +316,7 @@ func TestListTeams(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListTeams()
+	cachedData, err := r.ListTeams(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []Team{}, store.Get("githubListTeams"))
@@ -338,7 +335,7 @@ func TestListTeamMemberships_WithTeamListingError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeamMemberships()
+	_, err := r.ListTeamMemberships(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -382,14 +379,14 @@ func TestListTeamMemberships_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListTeamMemberships()
+	_, err := r.ListTeamMemberships(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListTeamMemberships_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListTeamMemberships()
+	teams, err := r.ListTeamMemberships(context.TODO())
 	assert.Nil(t, err)
 	assert.Equal(t, []string{}, teams)
 }
@@ -514,14 +511,13 @@ func TestListTeamMemberships(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	memberships, err := r.ListTeamMemberships()
+	memberships, err := r.ListTeamMemberships(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -536,7 +532,7 @@ func TestListTeamMemberships(t *testing.T) {
 	}, memberships)
 
 	// Check that results were cached
-	cachedData, err := r.ListTeamMemberships()
+	cachedData, err := r.ListTeamMemberships(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, memberships, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListTeamMemberships"))
@@ -555,14 +551,14 @@ func TestListMembership_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListMembership()
+	_, err := r.ListMembership(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
 func TestListMembership_WithoutOrganization(t *testing.T) {
 	r := githubRepository{cache: cache.New(1)}
 
-	teams, err := r.ListMembership()
+	teams, err := r.ListMembership(context.TODO())
 	assert.Nil(t, err)
 	assert.Equal(t, []string{}, teams)
 }
@@ -627,14 +623,13 @@ func TestListMembership(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "testorg",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListMembership()
+	teams, err := r.ListMembership(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -647,7 +642,7 @@ func TestListMembership(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListMembership()
+	cachedData, err := r.ListMembership(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListMembership"))
@@ -692,7 +687,7 @@ func TestListBranchProtection_WithRepoListingError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListBranchProtection()
+	_, err := r.ListBranchProtection(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -736,7 +731,7 @@ func TestListBranchProtection_WithError(t *testing.T) {
 		cache: cache.New(1),
 	}
 
-	_, err := r.ListBranchProtection()
+	_, err := r.ListBranchProtection(context.TODO())
 	assert.Equal(t, expectedError, err)
 }
 
@@ -889,14 +884,13 @@ func TestListBranchProtection(t *testing.T) {
 	store := cache.New(1)
 	r := githubRepository{
 		client: &mockedClient,
-		ctx:    context.TODO(),
 		config: githubConfig{
 			Organization: "my-organization",
 		},
 		cache: store,
 	}
 
-	teams, err := r.ListBranchProtection()
+	teams, err := r.ListBranchProtection(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -913,7 +907,7 @@ func TestListBranchProtection(t *testing.T) {
 	}, teams)
 
 	// Check that results were cached
-	cachedData, err := r.ListBranchProtection()
+	cachedData, err := r.ListBranchProtection(context.TODO())
 	assert.NoError(t, err)
 	assert.Equal(t, teams, cachedData)
 	assert.IsType(t, []string{}, store.Get("githubListBranchProtection"))
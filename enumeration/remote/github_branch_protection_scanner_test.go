@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -36,7 +37,7 @@ func TestScanGithubBranchProtection(t *testing.T) {
 			test:    "no branch protection",
 			dirName: "github_branch_protection_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return([]string{}, nil)
+				client.On("ListBranchProtection", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +45,7 @@ func TestScanGithubBranchProtection(t *testing.T) {
 			test:    "Multiple branch protections",
 			dirName: "github_branch_protection_multiples",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return([]string{
+				client.On("ListBranchProtection", mock.Anything).Return([]string{
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzI=", // "repo0:main"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0Nzg=", // "repo0:toto"
 					"MDIwOkJyYW5jaFByb3RlY3Rpb25SdWxlMTk1NDg0NzQ=", // "repo1:main"
@@ -59,7 +60,7 @@ func TestScanGithubBranchProtection(t *testing.T) {
 			test:    "cannot list branch protections",
 			dirName: "github_branch_protection_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListBranchProtection").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListBranchProtection", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubBranchProtectionResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubBranchProtectionResourceType, githubres.GithubBranchProtectionResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -108,7 +109,7 @@ func TestScanGithubBranchProtection(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
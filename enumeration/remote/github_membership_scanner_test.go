@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "no members",
 			dirName: "github_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return([]string{}, nil)
+				client.On("ListMembership", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +45,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "Multiple membership with admin and member roles",
 			dirName: "github_membership_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return([]string{
+				client.On("ListMembership", mock.Anything).Return([]string{
 					"driftctl-test:driftctl-acceptance-tester",
 					"driftctl-test:eliecharra",
 				}, nil)
@@ -55,7 +56,7 @@ func TestScanGithubMembership(t *testing.T) {
 			test:    "cannot list membership",
 			dirName: "github_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListMembership").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListMembership", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubMembershipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubMembershipResourceType, githubres.GithubMembershipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -104,7 +105,7 @@ func TestScanGithubMembership(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "no github repos",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{}, nil)
+				client.On("ListRepositories", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +45,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "Multiple github repos Table",
 			dirName: "github_repository_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return([]string{
+				client.On("ListRepositories", mock.Anything).Return([]string{
 					"driftctl",
 					"driftctl-demos",
 				}, nil)
@@ -55,7 +56,7 @@ func TestScanGithubRepository(t *testing.T) {
 			test:    "cannot list repositories",
 			dirName: "github_repository_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListRepositories").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListRepositories", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubRepositoryResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubRepositoryResourceType, githubres.GithubRepositoryResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -104,7 +105,7 @@ func TestScanGithubRepository(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
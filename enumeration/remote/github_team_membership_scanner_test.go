@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "no github team memberships",
 			dirName: "github_team_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return([]string{}, nil)
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +45,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "multiple github team memberships",
 			dirName: "github_team_membership_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return([]string{
+				client.On("ListTeamMemberships", mock.Anything).Return([]string{
 					"4570529:driftctl-acceptance-tester",
 					"4570529:wbeuil",
 				}, nil)
@@ -55,7 +56,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			test:    "cannot list team membership",
 			dirName: "github_team_membership_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeamMemberships").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListTeamMemberships", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubTeamMembershipResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubTeamMembershipResourceType, githubres.GithubTeamMembershipResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -104,7 +105,7 @@ func TestScanGithubTeamMembership(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
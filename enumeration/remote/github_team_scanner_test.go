@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -36,7 +37,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "no github teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{}, nil)
+				client.On("ListTeams", mock.Anything).Return([]github.Team{}, nil)
 			},
 			err: nil,
 		},
@@ -44,7 +45,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "Multiple github teams with parent",
 			dirName: "github_teams_multiple",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return([]github.Team{
+				client.On("ListTeams", mock.Anything).Return([]github.Team{
 					{DatabaseId: 4556811}, // github_team.team1
 					{DatabaseId: 4556812}, // github_team.team2
 					{DatabaseId: 4556814}, // github_team.with_parent
@@ -56,7 +57,7 @@ func TestScanGithubTeam(t *testing.T) {
 			test:    "cannot list teams",
 			dirName: "github_teams_empty",
 			mocks: func(client *github.MockGithubRepository, alerter *mocks.AlerterInterface) {
-				client.On("ListTeams").Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
+				client.On("ListTeams", mock.Anything).Return(nil, errors.New("Your token has not been granted the required scopes to execute this query."))
 
 				alerter.On("SendAlert", githubres.GithubTeamResourceType, alerts.NewRemoteAccessDeniedAlert(common.RemoteGithubTerraform, remoteerr.NewResourceListingErrorWithType(errors.New("Your token has not been granted the required scopes to execute this query."), githubres.GithubTeamResourceType, githubres.GithubTeamResourceType), alerts.EnumerationPhase)).Return()
 			},
@@ -105,7 +106,7 @@ func TestScanGithubTeam(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.err)
 			if err != nil {
@@ -0,0 +1,134 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/google/config"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/resource/google"
+)
+
+// auditLogResourceNames builds the audit log resource name of types whose ids are not a full resource path
+var auditLogResourceNames = map[string]func(project, id string) string{
+	google.GoogleStorageBucketResourceType: func(_, id string) string {
+		return fmt.Sprintf("projects/_/buckets/%s", id)
+	},
+	google.GoogleSQLDatabaseInstanceResourceType: func(project, id string) string {
+		return fmt.Sprintf("projects/%s/instances/%s", project, id)
+	},
+}
+
+type auditLogPayload struct {
+	ResourceName       string `json:"resourceName"`
+	AuthenticationInfo struct {
+		PrincipalEmail string `json:"principalEmail"`
+	} `json:"authenticationInfo"`
+	Status *struct {
+		Code int `json:"code"`
+	} `json:"status"`
+}
+
+// GoogleAuditLogAttributor finds the last principal that modified a resource using Cloud Audit Logs (Admin Activity)
+type GoogleAuditLogAttributor struct {
+	repository   repository.LoggingRepository
+	config       config.GCPTerraformConfig
+	once         sync.Once
+	attributions map[string]common.Attribution
+	err          error
+}
+
+func NewGoogleAuditLogAttributor(repo repository.LoggingRepository, config config.GCPTerraformConfig) *GoogleAuditLogAttributor {
+	return &GoogleAuditLogAttributor{
+		repository:   repo,
+		config:       config,
+		attributions: make(map[string]common.Attribution),
+	}
+}
+
+func (a *GoogleAuditLogAttributor) Attribute(ctx context.Context, res *resource.Resource) (*common.Attribution, error) {
+	a.once.Do(func() { a.load(ctx) })
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	name := a.auditLogResourceName(res)
+	if name == "" {
+		return nil, nil
+	}
+
+	attribution, exist := a.attributions[name]
+	if !exist {
+		return nil, nil
+	}
+	return &attribution, nil
+}
+
+// auditLogResourceName returns the full resource path used in audit logs for a given resource.
+// An empty name is returned when it cannot be known, we'd rather not attribute than attribute a change
+// made on another resource with the same short name.
+func (a *GoogleAuditLogAttributor) auditLogResourceName(res *resource.Resource) string {
+	if build, exist := auditLogResourceNames[res.ResourceType()]; exist {
+		return build(a.config.Project, res.ResourceId())
+	}
+	name := normalizeGoogleResourceName(res.ResourceId())
+	if !strings.HasPrefix(name, "projects/") {
+		return ""
+	}
+	return name
+}
+
+func (a *GoogleAuditLogAttributor) load(ctx context.Context) {
+	entries, err := a.repository.ListAdminActivityLogEntries(ctx)
+	if err != nil {
+		a.err = err
+		return
+	}
+
+	for _, entry := range entries {
+		payload := auditLogPayload{}
+		if err := json.Unmarshal(entry.ProtoPayload, &payload); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"insert_id": entry.InsertId,
+				"error":     err,
+			}).Debug("Unable to decode audit log entry payload")
+			continue
+		}
+		// Skip failed operations, they did not modify anything
+		if payload.Status != nil && payload.Status.Code != 0 {
+			continue
+		}
+		if payload.ResourceName == "" || payload.AuthenticationInfo.PrincipalEmail == "" {
+			continue
+		}
+		date, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+		if err != nil {
+			continue
+		}
+		name := normalizeGoogleResourceName(payload.ResourceName)
+		// Entries are sorted from the most recent one, so the first one is the last change
+		if _, exist := a.attributions[name]; exist {
+			continue
+		}
+		a.attributions[name] = common.Attribution{
+			Principal: payload.AuthenticationInfo.PrincipalEmail,
+			Date:      date,
+		}
+	}
+}
+
+// normalizeGoogleResourceName strips self link prefixes (e.g. https://www.googleapis.com/compute/v1/)
+// so that resource ids and audit log resource names can be compared
+func normalizeGoogleResourceName(name string) string {
+	if i := strings.Index(name, "projects/"); i > 0 {
+		return name[i:]
+	}
+	return name
+}
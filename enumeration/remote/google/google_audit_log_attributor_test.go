@@ -0,0 +1,166 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/remote/google/config"
+	"github.com/snyk/driftctl/enumeration/remote/google/repository"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/logging/v2"
+)
+
+func TestGoogleAuditLogAttributor_Attribute(t *testing.T) {
+	entries := []*logging.LogEntry{
+		{
+			InsertId:     "failed",
+			Timestamp:    "2022-01-12T10:00:00.123456Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/my-project/global/networks/my-network","authenticationInfo":{"principalEmail":"mallory@example.com"},"status":{"code":7}}`),
+		},
+		{
+			InsertId:     "network",
+			Timestamp:    "2022-01-11T10:00:00.123456Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/my-project/global/networks/my-network","authenticationInfo":{"principalEmail":"alice@example.com"}}`),
+		},
+		{
+			InsertId:     "bucket",
+			Timestamp:    "2022-01-10T10:00:00Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/_/buckets/my-bucket","authenticationInfo":{"principalEmail":"bob@example.com"},"status":{}}`),
+		},
+		{
+			InsertId:     "instance",
+			Timestamp:    "2022-01-09T10:00:00Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/my-project/zones/europe-west1-b/instances/my-other-bucket","authenticationInfo":{"principalEmail":"mallory@example.com"}}`),
+		},
+		{
+			InsertId:     "sql",
+			Timestamp:    "2022-01-08T10:00:00Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/my-project/instances/my-database","authenticationInfo":{"principalEmail":"carol@example.com"}}`),
+		},
+		{
+			InsertId:     "old-network",
+			Timestamp:    "2022-01-01T10:00:00Z",
+			ProtoPayload: []byte(`{"resourceName":"projects/my-project/global/networks/my-network","authenticationInfo":{"principalEmail":"bob@example.com"}}`),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		res      *resource.Resource
+		mocks    func(*repository.MockLoggingRepository)
+		expected *common.Attribution
+		wantErr  string
+	}{
+		{
+			name: "should match last successful change on a self link",
+			res: &resource.Resource{
+				Id:   "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network",
+				Type: "google_compute_network",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: &common.Attribution{
+				Principal: "alice@example.com",
+				Date:      time.Date(2022, 1, 11, 10, 0, 0, 123456000, time.UTC),
+			},
+		},
+		{
+			name: "should match bucket resource name",
+			res: &resource.Resource{
+				Id:   "my-bucket",
+				Type: "google_storage_bucket",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: &common.Attribution{
+				Principal: "bob@example.com",
+				Date:      time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "should match sql instance resource name",
+			res: &resource.Resource{
+				Id:   "my-database",
+				Type: "google_sql_database_instance",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: &common.Attribution{
+				Principal: "carol@example.com",
+				Date:      time.Date(2022, 1, 8, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "should not match a resource of another type with the same name",
+			res: &resource.Resource{
+				Id:   "my-other-bucket",
+				Type: "google_storage_bucket",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: nil,
+		},
+		{
+			name: "should not match a short id without a known resource path",
+			res: &resource.Resource{
+				Id:   "my-network",
+				Type: "google_compute_network",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: nil,
+		},
+		{
+			name: "should not find any attribution",
+			res: &resource.Resource{
+				Id:   "my-unknown-bucket",
+				Type: "google_storage_bucket",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(entries, nil).Once()
+			},
+			expected: nil,
+		},
+		{
+			name: "should return remote error",
+			res: &resource.Resource{
+				Id:   "my-bucket",
+				Type: "google_storage_bucket",
+			},
+			mocks: func(repo *repository.MockLoggingRepository) {
+				repo.On("ListAdminActivityLogEntries", mock.Anything).Return(nil, errors.New("remote error")).Once()
+			},
+			wantErr: "remote error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &repository.MockLoggingRepository{}
+			tt.mocks(repo)
+
+			attributor := NewGoogleAuditLogAttributor(repo, config.GCPTerraformConfig{Project: "my-project"})
+			got, err := attributor.Attribute(context.TODO(), tt.res)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+
+			// Entries should only be fetched once
+			_, _ = attributor.Attribute(context.TODO(), tt.res)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	"github.com/sirupsen/logrus"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -24,8 +26,8 @@ func (e *GoogleBigtableTableEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleBigtableTableResourceType
 }
 
-func (e *GoogleBigtableTableEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllBigtableTables()
+func (e *GoogleBigtableTableEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllBigtableTables(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
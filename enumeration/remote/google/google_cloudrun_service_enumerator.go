@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"strings"
@@ -26,8 +28,8 @@ func (e *GoogleCloudRunServiceEnumerator) SupportedType() resource.ResourceType
 	return google.GoogleCloudRunServiceResourceType
 }
 
-func (e *GoogleCloudRunServiceEnumerator) Enumerate() ([]*resource.Resource, error) {
-	subnets, err := e.repository.SearchAllCloudRunServices()
+func (e *GoogleCloudRunServiceEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	subnets, err := e.repository.SearchAllCloudRunServices(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
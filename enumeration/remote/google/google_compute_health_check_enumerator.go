@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeHealthCheckEnumerator) SupportedType() resource.ResourceTy
 	return google.GoogleComputeHealthCheckResourceType
 }
 
-func (e *GoogleComputeHealthCheckEnumerator) Enumerate() ([]*resource.Resource, error) {
-	checks, err := e.repository.SearchAllHealthChecks()
+func (e *GoogleComputeHealthCheckEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	checks, err := e.repository.SearchAllHealthChecks(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
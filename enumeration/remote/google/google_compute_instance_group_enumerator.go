@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"strings"
 
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
@@ -27,8 +28,8 @@ func (e *GoogleComputeInstanceGroupEnumerator) SupportedType() resource.Resource
 	return google.GoogleComputeInstanceGroupResourceType
 }
 
-func (e *GoogleComputeInstanceGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	groups, err := e.repository.SearchAllInstanceGroups()
+func (e *GoogleComputeInstanceGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	groups, err := e.repository.SearchAllInstanceGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"strings"
@@ -26,8 +28,8 @@ func (e *GoogleComputeInstanceGroupManagerEnumerator) SupportedType() resource.R
 	return google.GoogleComputeInstanceGroupManagerResourceType
 }
 
-func (e *GoogleComputeInstanceGroupManagerEnumerator) Enumerate() ([]*resource.Resource, error) {
-	items, err := e.repository.SearchAllInstanceGroupManagers()
+func (e *GoogleComputeInstanceGroupManagerEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	items, err := e.repository.SearchAllInstanceGroupManagers(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleComputeNodeGroupEnumerator) SupportedType() resource.ResourceType
 	return google.GoogleComputeNodeGroupResourceType
 }
 
-func (e *GoogleComputeNodeGroupEnumerator) Enumerate() ([]*resource.Resource, error) {
-	nodeGroups, err := e.repository.SearchAllNodeGroups()
+func (e *GoogleComputeNodeGroupEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	nodeGroups, err := e.repository.SearchAllNodeGroups(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 	}
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"context"
+
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -23,8 +25,8 @@ func (e *GoogleStorageBucketEnumerator) SupportedType() resource.ResourceType {
 	return google.GoogleStorageBucketResourceType
 }
 
-func (e *GoogleStorageBucketEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllBuckets()
+func (e *GoogleStorageBucketEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllBuckets(ctx)
 
 	if err != nil {
 		return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
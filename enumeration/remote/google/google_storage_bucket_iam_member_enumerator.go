@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	remoteerror "github.com/snyk/driftctl/enumeration/remote/error"
 	"github.com/snyk/driftctl/enumeration/remote/google/repository"
@@ -27,8 +28,8 @@ func (e *GoogleStorageBucketIamMemberEnumerator) SupportedType() resource.Resour
 	return google.GoogleStorageBucketIamMemberResourceType
 }
 
-func (e *GoogleStorageBucketIamMemberEnumerator) Enumerate() ([]*resource.Resource, error) {
-	resources, err := e.repository.SearchAllBuckets()
+func (e *GoogleStorageBucketIamMemberEnumerator) Enumerate(ctx context.Context) ([]*resource.Resource, error) {
+	resources, err := e.repository.SearchAllBuckets(ctx)
 	if err != nil {
 		return nil, remoteerror.NewResourceListingErrorWithType(err, string(e.SupportedType()), google.GoogleStorageBucketResourceType)
 	}
@@ -36,7 +37,7 @@ func (e *GoogleStorageBucketIamMemberEnumerator) Enumerate() ([]*resource.Resour
 	results := make([]*resource.Resource, 0, len(resources))
 
 	for _, bucket := range resources {
-		bindings, err := e.storageRepository.ListAllBindings(bucket.DisplayName)
+		bindings, err := e.storageRepository.ListAllBindings(ctx, bucket.DisplayName)
 		if err != nil {
 			return nil, remoteerror.NewResourceListingError(err, string(e.SupportedType()))
 		}
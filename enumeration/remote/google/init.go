@@ -16,11 +16,12 @@ import (
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/enumeration/resource/google"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/logging/v2"
 )
 
-func Init(version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Init(version string, hashes []string, source terraform.ProviderSource, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, mirror terraform.ProviderMirror) error {
 
-	provider, err := NewGCPTerraformProvider(version, progress, configDir)
+	provider, err := NewGCPTerraformProvider(version, hashes, source, progress, configDir, mirror)
 	if err != nil {
 		return err
 	}
@@ -60,6 +61,15 @@ func Init(version string, alerter alerter.AlerterInterface, providerLibrary *ter
 	providerLibrary.AddProvider(terraform.GOOGLE, provider)
 	deserializer := resource.NewDeserializer(factory)
 
+	if remoteLibrary.AttributionEnabled() {
+		loggingService, err := logging.NewService(ctx)
+		if err != nil {
+			return err
+		}
+		loggingRepository := repository.NewLoggingRepository(loggingService, provider.GetConfig(), repositoryCache)
+		remoteLibrary.SetAttributor(NewGoogleAuditLogAttributor(loggingRepository, provider.GetConfig()))
+	}
+
 	remoteLibrary.AddEnumerator(NewGoogleStorageBucketEnumerator(assetRepository, factory))
 	remoteLibrary.AddDetailsFetcher(google.GoogleStorageBucketResourceType, common.NewGenericDetailsFetcher(google.GoogleStorageBucketResourceType, provider, deserializer))
 
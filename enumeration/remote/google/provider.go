@@ -13,24 +13,31 @@ import (
 	asset "cloud.google.com/go/asset/apiv1"
 )
 
+// DefaultProviderVersion is the provider version used when none is configured
+const DefaultProviderVersion = "3.78.0"
+
 type GCPTerraformProvider struct {
 	*terraform.TerraformProvider
 	name    string
 	version string
 }
 
-func NewGCPTerraformProvider(version string, progress enumeration.ProgressCounter, configDir string) (*GCPTerraformProvider, error) {
+func NewGCPTerraformProvider(version string, hashes []string, source tf.ProviderSource, progress enumeration.ProgressCounter, configDir string, mirror tf.ProviderMirror) (*GCPTerraformProvider, error) {
 	if version == "" {
-		version = "3.78.0"
+		version = DefaultProviderVersion
 	}
 	p := &GCPTerraformProvider{
 		version: version,
 		name:    tf.GOOGLE,
 	}
 	installer, err := tf.NewProviderInstaller(tf.ProviderConfig{
+		Hostname:  source.Hostname,
+		Namespace: source.Namespace,
 		Key:       p.name,
 		Version:   version,
 		ConfigDir: configDir,
+		Mirror:    mirror,
+		Hashes:    hashes,
 	})
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration/remote/cache"
@@ -29,7 +30,7 @@ func Test_assetRepository_searchAllResources_CacheHit(t *testing.T) {
 	c.On("Unlock", "SearchAllResources").Times(1)
 	repo := NewAssetRepository(nil, config.GCPTerraformConfig{Project: ""}, c)
 
-	got, err := repo.searchAllResources("google_fake_type")
+	got, err := repo.searchAllResources(context.TODO(), "google_fake_type")
 	c.AssertExpectations(t)
 	assert.Nil(t, err)
 	assert.Len(t, got, 1)
@@ -57,7 +58,7 @@ func Test_assetRepository_searchAllResources_CacheMiss(t *testing.T) {
 	c.On("Put", "SearchAllResources", mock.IsType([]*assetpb.ResourceSearchResult{})).Return(false).Times(1)
 	repo := NewAssetRepository(assetClient, config.GCPTerraformConfig{Project: ""}, c)
 
-	got, err := repo.searchAllResources("google_fake_type")
+	got, err := repo.searchAllResources(context.TODO(), "google_fake_type")
 	c.AssertExpectations(t)
 	assert.Nil(t, err)
 	assert.Len(t, got, 1)
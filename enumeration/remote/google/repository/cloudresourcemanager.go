@@ -1,13 +1,15 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/google/config"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 type CloudResourceManagerRepository interface {
-	ListProjectsBindings() (map[string]map[string][]string, error)
+	ListProjectsBindings(ctx context.Context) (map[string]map[string][]string, error)
 }
 
 type cloudResourceManagerRepository struct {
@@ -24,7 +26,7 @@ func NewCloudResourceManagerRepository(service *cloudresourcemanager.Service, co
 	}
 }
 
-func (s *cloudResourceManagerRepository) ListProjectsBindings() (map[string]map[string][]string, error) {
+func (s *cloudResourceManagerRepository) ListProjectsBindings(ctx context.Context) (map[string]map[string][]string, error) {
 	if cachedResults := s.cache.Get("ListProjectsBindings"); cachedResults != nil {
 		return cachedResults.(map[string]map[string][]string), nil
 	}
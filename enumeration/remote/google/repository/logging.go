@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/google/config"
+	"google.golang.org/api/logging/v2"
+)
+
+// Admin Activity audit logs are retained for 400 days, we only look at recent changes
+// to keep the amount of fetched entries reasonable
+const auditLogsLookback = 90 * 24 * time.Hour
+
+type LoggingRepository interface {
+	ListAdminActivityLogEntries(ctx context.Context) ([]*logging.LogEntry, error)
+}
+
+type loggingRepository struct {
+	service *logging.Service
+	config  config.GCPTerraformConfig
+	cache   cache.Cache
+}
+
+func NewLoggingRepository(service *logging.Service, config config.GCPTerraformConfig, cache cache.Cache) LoggingRepository {
+	return &loggingRepository{
+		service: service,
+		config:  config,
+		cache:   cache,
+	}
+}
+
+// ListAdminActivityLogEntries returns Admin Activity audit log entries of the configured project, most recent first
+func (s *loggingRepository) ListAdminActivityLogEntries(ctx context.Context) ([]*logging.LogEntry, error) {
+	cacheKey := "ListAdminActivityLogEntries"
+	cachedResults := s.cache.GetAndLock(cacheKey)
+	defer s.cache.Unlock(cacheKey)
+	if cachedResults != nil {
+		return cachedResults.([]*logging.LogEntry), nil
+	}
+
+	project := fmt.Sprintf("projects/%s", s.config.Project)
+	req := &logging.ListLogEntriesRequest{
+		ResourceNames: []string{project},
+		Filter: fmt.Sprintf(
+			`logName="%s/logs/cloudaudit.googleapis.com%%2Factivity" AND timestamp>="%s"`,
+			project,
+			time.Now().Add(-auditLogsLookback).UTC().Format(time.RFC3339),
+		),
+		OrderBy:  "timestamp desc",
+		PageSize: 1000,
+	}
+
+	results := make([]*logging.LogEntry, 0)
+	err := s.service.Entries.List(req).Pages(ctx, func(resp *logging.ListLogEntriesResponse) error {
+		results = append(results, resp.Entries...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Put(cacheKey, results)
+
+	return results, nil
+}
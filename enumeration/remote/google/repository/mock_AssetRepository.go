@@ -3,6 +3,8 @@
 package repository
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 	asset "google.golang.org/genproto/googleapis/cloud/asset/v1"
 )
@@ -12,13 +14,13 @@ type MockAssetRepository struct {
 	mock.Mock
 }
 
-// SearchAllAddresses provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllAddresses provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllAddresses(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -26,8 +28,8 @@ func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResu
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,13 +37,13 @@ func (_m *MockAssetRepository) SearchAllAddresses() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllBigtableInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBigtableInstances() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllBigtableInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBigtableInstances(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -49,8 +51,8 @@ func (_m *MockAssetRepository) SearchAllBigtableInstances() ([]*asset.Asset, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,13 +60,13 @@ func (_m *MockAssetRepository) SearchAllBigtableInstances() ([]*asset.Asset, err
 	return r0, r1
 }
 
-// SearchAllBigtableTables provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBigtableTables() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllBigtableTables provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBigtableTables(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -72,8 +74,8 @@ func (_m *MockAssetRepository) SearchAllBigtableTables() ([]*asset.Asset, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +83,13 @@ func (_m *MockAssetRepository) SearchAllBigtableTables() ([]*asset.Asset, error)
 	return r0, r1
 }
 
-// SearchAllBuckets provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllBuckets() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllBuckets provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllBuckets(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -95,8 +97,8 @@ func (_m *MockAssetRepository) SearchAllBuckets() ([]*asset.ResourceSearchResult
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +106,13 @@ func (_m *MockAssetRepository) SearchAllBuckets() ([]*asset.ResourceSearchResult
 	return r0, r1
 }
 
-// SearchAllCloudRunServices provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllCloudRunServices provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllCloudRunServices(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -118,8 +120,8 @@ func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSea
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +129,13 @@ func (_m *MockAssetRepository) SearchAllCloudRunServices() ([]*asset.ResourceSea
 	return r0, r1
 }
 
-// SearchAllDNSManagedZones provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllDNSManagedZones provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDNSManagedZones(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -141,8 +143,8 @@ func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSear
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -150,13 +152,13 @@ func (_m *MockAssetRepository) SearchAllDNSManagedZones() ([]*asset.ResourceSear
 	return r0, r1
 }
 
-// SearchAllDatasets provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDatasets() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllDatasets provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDatasets(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -164,8 +166,8 @@ func (_m *MockAssetRepository) SearchAllDatasets() ([]*asset.ResourceSearchResul
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,13 +175,13 @@ func (_m *MockAssetRepository) SearchAllDatasets() ([]*asset.ResourceSearchResul
 	return r0, r1
 }
 
-// SearchAllDisks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllDisks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllDisks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllDisks(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -187,8 +189,8 @@ func (_m *MockAssetRepository) SearchAllDisks() ([]*asset.ResourceSearchResult,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,13 +198,13 @@ func (_m *MockAssetRepository) SearchAllDisks() ([]*asset.ResourceSearchResult,
 	return r0, r1
 }
 
-// SearchAllFirewalls provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllFirewalls() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllFirewalls provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllFirewalls(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -210,8 +212,8 @@ func (_m *MockAssetRepository) SearchAllFirewalls() ([]*asset.ResourceSearchResu
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,13 +221,13 @@ func (_m *MockAssetRepository) SearchAllFirewalls() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllForwardingRules provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllForwardingRules() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllForwardingRules provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllForwardingRules(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -233,8 +235,8 @@ func (_m *MockAssetRepository) SearchAllForwardingRules() ([]*asset.Asset, error
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -242,13 +244,13 @@ func (_m *MockAssetRepository) SearchAllForwardingRules() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllFunctions provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllFunctions() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllFunctions provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllFunctions(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -256,8 +258,8 @@ func (_m *MockAssetRepository) SearchAllFunctions() ([]*asset.Asset, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -265,13 +267,13 @@ func (_m *MockAssetRepository) SearchAllFunctions() ([]*asset.Asset, error) {
 	return r0, r1
 }
 
-// SearchAllGlobalAddresses provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllGlobalAddresses() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllGlobalAddresses provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllGlobalAddresses(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -279,8 +281,8 @@ func (_m *MockAssetRepository) SearchAllGlobalAddresses() ([]*asset.Asset, error
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -288,13 +290,13 @@ func (_m *MockAssetRepository) SearchAllGlobalAddresses() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllGlobalForwardingRules provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllGlobalForwardingRules() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllGlobalForwardingRules provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllGlobalForwardingRules(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -302,8 +304,8 @@ func (_m *MockAssetRepository) SearchAllGlobalForwardingRules() ([]*asset.Asset,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -311,13 +313,13 @@ func (_m *MockAssetRepository) SearchAllGlobalForwardingRules() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllHealthChecks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllHealthChecks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllHealthChecks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllHealthChecks(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -325,8 +327,8 @@ func (_m *MockAssetRepository) SearchAllHealthChecks() ([]*asset.ResourceSearchR
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -334,13 +336,13 @@ func (_m *MockAssetRepository) SearchAllHealthChecks() ([]*asset.ResourceSearchR
 	return r0, r1
 }
 
-// SearchAllImages provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllImages() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllImages provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllImages(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -348,8 +350,8 @@ func (_m *MockAssetRepository) SearchAllImages() ([]*asset.ResourceSearchResult,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -357,13 +359,13 @@ func (_m *MockAssetRepository) SearchAllImages() ([]*asset.ResourceSearchResult,
 	return r0, r1
 }
 
-// SearchAllInstanceGroupManagers provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstanceGroupManagers() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllInstanceGroupManagers provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstanceGroupManagers(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -371,8 +373,8 @@ func (_m *MockAssetRepository) SearchAllInstanceGroupManagers() ([]*asset.Asset,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -380,13 +382,13 @@ func (_m *MockAssetRepository) SearchAllInstanceGroupManagers() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllInstanceGroups provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstanceGroups() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllInstanceGroups provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstanceGroups(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -394,8 +396,8 @@ func (_m *MockAssetRepository) SearchAllInstanceGroups() ([]*asset.ResourceSearc
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -403,13 +405,13 @@ func (_m *MockAssetRepository) SearchAllInstanceGroups() ([]*asset.ResourceSearc
 	return r0, r1
 }
 
-// SearchAllInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllInstances(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -417,8 +419,8 @@ func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResu
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -426,13 +428,13 @@ func (_m *MockAssetRepository) SearchAllInstances() ([]*asset.ResourceSearchResu
 	return r0, r1
 }
 
-// SearchAllNetworks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllNetworks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllNetworks(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -440,8 +442,8 @@ func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResul
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -449,13 +451,13 @@ func (_m *MockAssetRepository) SearchAllNetworks() ([]*asset.ResourceSearchResul
 	return r0, r1
 }
 
-// SearchAllNodeGroups provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllNodeGroups provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllNodeGroups(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -463,8 +465,8 @@ func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -472,13 +474,13 @@ func (_m *MockAssetRepository) SearchAllNodeGroups() ([]*asset.Asset, error) {
 	return r0, r1
 }
 
-// SearchAllRouters provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllRouters provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllRouters(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -486,8 +488,8 @@ func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -495,13 +497,13 @@ func (_m *MockAssetRepository) SearchAllRouters() ([]*asset.ResourceSearchResult
 	return r0, r1
 }
 
-// SearchAllSQLDatabaseInstances provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllSQLDatabaseInstances provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -509,8 +511,8 @@ func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances() ([]*asset.Asset,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -518,13 +520,13 @@ func (_m *MockAssetRepository) SearchAllSQLDatabaseInstances() ([]*asset.Asset,
 	return r0, r1
 }
 
-// SearchAllSslCertificates provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSslCertificates() ([]*asset.Asset, error) {
-	ret := _m.Called()
+// SearchAllSslCertificates provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSslCertificates(ctx context.Context) ([]*asset.Asset, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.Asset
-	if rf, ok := ret.Get(0).(func() []*asset.Asset); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.Asset); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.Asset)
@@ -532,8 +534,8 @@ func (_m *MockAssetRepository) SearchAllSslCertificates() ([]*asset.Asset, error
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -541,13 +543,13 @@ func (_m *MockAssetRepository) SearchAllSslCertificates() ([]*asset.Asset, error
 	return r0, r1
 }
 
-// SearchAllSubnetworks provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllSubnetworks() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllSubnetworks provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllSubnetworks(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -555,8 +557,8 @@ func (_m *MockAssetRepository) SearchAllSubnetworks() ([]*asset.ResourceSearchRe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -564,13 +566,13 @@ func (_m *MockAssetRepository) SearchAllSubnetworks() ([]*asset.ResourceSearchRe
 	return r0, r1
 }
 
-// SearchAllTables provides a mock function with given fields:
-func (_m *MockAssetRepository) SearchAllTables() ([]*asset.ResourceSearchResult, error) {
-	ret := _m.Called()
+// SearchAllTables provides a mock function with given fields: ctx
+func (_m *MockAssetRepository) SearchAllTables(ctx context.Context) ([]*asset.ResourceSearchResult, error) {
+	ret := _m.Called(ctx)
 
 	var r0 []*asset.ResourceSearchResult
-	if rf, ok := ret.Get(0).(func() []*asset.ResourceSearchResult); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*asset.ResourceSearchResult); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*asset.ResourceSearchResult)
@@ -578,8 +580,8 @@ func (_m *MockAssetRepository) SearchAllTables() ([]*asset.ResourceSearchResult,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
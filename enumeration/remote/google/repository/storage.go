@@ -10,7 +10,7 @@ import (
 )
 
 type StorageRepository interface {
-	ListAllBindings(bucketName string) (map[string][]string, error)
+	ListAllBindings(ctx context.Context, bucketName string) (map[string][]string, error)
 }
 
 type storageRepository struct {
@@ -27,7 +27,7 @@ func NewStorageRepository(client *storage.Client, cache cache.Cache) *storageRep
 	}
 }
 
-func (s storageRepository) ListAllBindings(bucketName string) (map[string][]string, error) {
+func (s storageRepository) ListAllBindings(ctx context.Context, bucketName string) (map[string][]string, error) {
 
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -36,7 +36,7 @@ func (s storageRepository) ListAllBindings(bucketName string) (map[string][]stri
 	}
 
 	bucket := s.client.Bucket(bucketName)
-	policy, err := bucket.IAM().Policy(context.Background())
+	policy, err := bucket.IAM().Policy(ctx)
 	if err != nil {
 		return nil, err
 	}
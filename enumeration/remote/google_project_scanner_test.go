@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration"
@@ -37,7 +38,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "no bindings",
 			dirName: "google_project_member_empty",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{}, nil)
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -45,7 +46,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "Cannot list bindings",
 			dirName: "google_project_member_listing_error",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(
+				repository.On("ListProjectsBindings", mock.Anything).Return(
 					map[string]map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have project.getIamPolicy access., forbidden"))
 			},
@@ -69,7 +70,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_project_member_listing_multiple",
 			repositoryMock: func(repository *repository.MockCloudResourceManagerRepository) {
-				repository.On("ListProjectsBindings").Return(map[string]map[string][]string{
+				repository.On("ListProjectsBindings", mock.Anything).Return(map[string]map[string][]string{
 					"": {
 						"roles/editor": {
 							"user:martin.guibert@cloudskiff.com",
@@ -122,7 +123,7 @@ func TestGoogleProjectIAMMember(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
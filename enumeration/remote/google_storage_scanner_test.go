@@ -144,7 +144,7 @@ func TestGoogleStorageBucket(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, err, c.wantErr)
 			if err != nil {
@@ -172,7 +172,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "no storage buckets",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{}, nil)
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -180,7 +180,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, no bindings",
 			dirName: "google_storage_bucket_member_empty",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -192,8 +192,8 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{}, nil)
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{}, nil)
 			},
 			wantErr: nil,
 		},
@@ -201,7 +201,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "Cannot list bindings",
 			dirName: "google_storage_bucket_member_listing_error",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -209,7 +209,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(
 					map[string][]string{},
 					errors.New("googleapi: Error 403: driftctl-acc-circle@driftctl-qa-1.iam.gserviceaccount.com does not have storage.buckets.getIamPolicy access to the Google Cloud Storage bucket., forbidden"))
 			},
@@ -233,7 +233,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			test:    "multiples storage buckets, multiple bindings",
 			dirName: "google_storage_bucket_member_listing_multiple",
 			assetRepositoryMock: func(assetRepository *repository.MockAssetRepository) {
-				assetRepository.On("SearchAllBuckets").Return([]*assetpb.ResourceSearchResult{
+				assetRepository.On("SearchAllBuckets", mock.Anything).Return([]*assetpb.ResourceSearchResult{
 					{
 						AssetType:   "storage.googleapis.com/Bucket",
 						DisplayName: "dctlgstoragebucketiambinding-1",
@@ -245,12 +245,12 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 				}, nil)
 			},
 			storageRepositoryMock: func(storageRepository *repository.MockStorageRepository) {
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-1").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-1").Return(map[string][]string{
 					"roles/storage.admin":        {"user:elie.charra@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:william.beuil@cloudskiff.com"},
 				}, nil)
 
-				storageRepository.On("ListAllBindings", "dctlgstoragebucketiambinding-2").Return(map[string][]string{
+				storageRepository.On("ListAllBindings", mock.Anything, "dctlgstoragebucketiambinding-2").Return(map[string][]string{
 					"roles/storage.admin":        {"user:william.beuil@cloudskiff.com"},
 					"roles/storage.objectViewer": {"user:elie.charra@cloudskiff.com"},
 				}, nil)
@@ -310,7 +310,7 @@ func TestGoogleStorageBucketIAMMember(t *testing.T) {
 			testFilter := &enumeration.MockFilter{}
 			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
 
-			s := NewScanner(remoteLibrary, alerter, scanOptions, testFilter)
+			s := NewScanner(context.TODO(), remoteLibrary, alerter, scanOptions, testFilter)
 			got, err := s.Resources()
 			assert.Equal(tt, c.wantErr, err)
 			if err != nil {
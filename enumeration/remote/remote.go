@@ -6,6 +6,7 @@ import (
 	"github.com/snyk/driftctl/enumeration/alerter"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/azurerm"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/remote/github"
 	"github.com/snyk/driftctl/enumeration/remote/google"
@@ -29,16 +30,16 @@ func IsSupported(remote string) bool {
 	return false
 }
 
-func Activate(remote, version string, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string) error {
+func Activate(remote, version string, hashes []string, source terraform.ProviderSource, alerter alerter.AlerterInterface, providerLibrary *terraform.ProviderLibrary, remoteLibrary *common.RemoteLibrary, progress enumeration.ProgressCounter, factory resource.ResourceFactory, configDir string, mirror terraform.ProviderMirror, cacheTTL cache.TTLConfig, rateLimit float64) error {
 	switch remote {
 	case common.RemoteAWSTerraform:
-		return aws.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return aws.Init(version, hashes, source, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, mirror, cacheTTL, rateLimit)
 	case common.RemoteGithubTerraform:
-		return github.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return github.Init(version, hashes, source, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, mirror)
 	case common.RemoteGoogleTerraform:
-		return google.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return google.Init(version, hashes, source, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, mirror)
 	case common.RemoteAzureTerraform:
-		return azurerm.Init(version, alerter, providerLibrary, remoteLibrary, progress, factory, configDir)
+		return azurerm.Init(version, hashes, source, alerter, providerLibrary, remoteLibrary, progress, factory, configDir, mirror)
 
 	default:
 		return errors.Errorf("unsupported remote '%s'", remote)
@@ -48,3 +49,18 @@ func Activate(remote, version string, alerter alerter.AlerterInterface, provider
 func GetSupportedRemotes() []string {
 	return supportedRemotes
 }
+
+// GetDefaultProviderVersion returns the terraform provider version used by a remote when none is configured
+func GetDefaultProviderVersion(remote string) string {
+	switch remote {
+	case common.RemoteAWSTerraform:
+		return aws.DefaultProviderVersion
+	case common.RemoteGithubTerraform:
+		return github.DefaultProviderVersion
+	case common.RemoteGoogleTerraform:
+		return google.DefaultProviderVersion
+	case common.RemoteAzureTerraform:
+		return azurerm.DefaultProviderVersion
+	}
+	return ""
+}
@@ -0,0 +1,38 @@
+package remote
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivate_ProviderSource(t *testing.T) {
+	mirror := terraform.ProviderMirror{Directory: t.TempDir()}
+	source := terraform.ProviderSource{Hostname: "registry.example.com", Namespace: "integrations"}
+	platform := (&terraform.ProviderConfig{}).GetPlatform()
+
+	err := Activate(
+		common.RemoteGithubTerraform,
+		"5.3.0",
+		nil,
+		source,
+		alerter.NewAlerter(),
+		terraform.NewProviderLibrary(),
+		common.NewRemoteLibrary(),
+		&output.MockProgress{},
+		terraform.NewTerraformResourceFactory(),
+		t.TempDir(),
+		mirror,
+		cache.TTLConfig{},
+		0,
+	)
+
+	// The provider is looked up in the mirror using its source address
+	assert.EqualError(t, err, fmt.Sprintf("provider registry.example.com/integrations/github 5.3.0 (%s) not found in mirror directory %s", platform, mirror.Directory))
+}
@@ -2,22 +2,43 @@ package remote
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/events"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/tracing"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+var errTimeout = errors.New("timed out")
+
+// DefaultConcurrency is the number of enumerators or details fetchers run in parallel by default
+const DefaultConcurrency = 10
+
 type ScannerOptions struct {
 	Deep bool
+	// EnumerationConcurrency is the number of enumerators run in parallel, DefaultConcurrency if not set
+	EnumerationConcurrency int
+	// DetailsFetchingConcurrency is the number of details fetchers run in parallel, DefaultConcurrency if not set
+	DetailsFetchingConcurrency int
+	// EnumeratorTimeout is the maximum duration of a single enumerator or details fetcher, no limit if not set
+	EnumeratorTimeout time.Duration
+	// Profiler records the cost of each enumerator and details fetcher, profiling is disabled if not set
+	Profiler *profile.Profiler
 }
 
 type Scanner struct {
+	ctx                  context.Context
 	enumeratorRunner     *parallel.ParallelRunner
 	detailsFetcherRunner *parallel.ParallelRunner
 	remoteLibrary        *common.RemoteLibrary
@@ -26,10 +47,11 @@ type Scanner struct {
 	filter               enumeration.Filter
 }
 
-func NewScanner(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface, options ScannerOptions, filter enumeration.Filter) *Scanner {
+func NewScanner(ctx context.Context, remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInterface, options ScannerOptions, filter enumeration.Filter) *Scanner {
 	return &Scanner{
-		enumeratorRunner:     parallel.NewParallelRunner(context.TODO(), 10),
-		detailsFetcherRunner: parallel.NewParallelRunner(context.TODO(), 10),
+		ctx:                  ctx,
+		enumeratorRunner:     parallel.NewParallelRunner(ctx, concurrency(options.EnumerationConcurrency)),
+		detailsFetcherRunner: parallel.NewParallelRunner(ctx, concurrency(options.DetailsFetchingConcurrency)),
 		remoteLibrary:        remoteLibrary,
 		alerter:              alerter,
 		options:              options,
@@ -37,7 +59,14 @@ func NewScanner(remoteLibrary *common.RemoteLibrary, alerter alerter.AlerterInte
 	}
 }
 
-func (s *Scanner) retrieveRunnerResults(runner *parallel.ParallelRunner) ([]*resource.Resource, error) {
+func concurrency(value int) int64 {
+	if value <= 0 {
+		return DefaultConcurrency
+	}
+	return int64(value)
+}
+
+func (s *Scanner) retrieveRunnerResults(ctx context.Context, runner *parallel.ParallelRunner) ([]*resource.Resource, error) {
 	results := make([]*resource.Resource, 0)
 loop:
 	for {
@@ -56,11 +85,78 @@ loop:
 			break loop
 		}
 	}
-	return results, runner.Err()
+	if runner.Err() != nil {
+		return results, runner.Err()
+	}
+	return results, ctx.Err()
 }
 
-func (s *Scanner) scan() ([]*resource.Resource, error) {
-	for _, enumerator := range s.remoteLibrary.Enumerators() {
+// withTimeout runs fn with a context derived from the scan context and limited to EnumeratorTimeout.
+// The boolean result is true if fn did not complete in time while the scan itself is still running.
+// Since fn may not honor its context, it is not awaited once the timeout is reached.
+func (s *Scanner) withTimeout(scanCtx context.Context, fn func(ctx context.Context) ([]*resource.Resource, error)) ([]*resource.Resource, bool, error) {
+	if s.options.EnumeratorTimeout <= 0 {
+		resources, err := fn(scanCtx)
+		return resources, false, err
+	}
+
+	ctx, cancel := context.WithTimeout(scanCtx, s.options.EnumeratorTimeout)
+	defer cancel()
+
+	type result struct {
+		resources []*resource.Resource
+		err       error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		resources, err := fn(ctx)
+		resultChan <- result{resources, err}
+	}()
+
+	select {
+	case res := <-resultChan:
+		if res.err != nil && ctx.Err() == context.DeadlineExceeded && scanCtx.Err() == nil {
+			return nil, true, nil
+		}
+		return res.resources, false, res.err
+	case <-ctx.Done():
+		if scanCtx.Err() != nil {
+			return nil, false, scanCtx.Err()
+		}
+		return nil, true, nil
+	}
+}
+
+// profile makes fn count its API calls when profiling is enabled, the returned func records the profile entry of the call
+func (s *Scanner) profile(kind profile.Kind, resourceType string, fn func(ctx context.Context) ([]*resource.Resource, error)) (func(ctx context.Context) ([]*resource.Resource, error), func(err error)) {
+	if s.options.Profiler == nil {
+		return fn, func(error) {}
+	}
+	counter := &profile.Counter{}
+	start := time.Now()
+	return func(ctx context.Context) ([]*resource.Resource, error) {
+			return fn(profile.WithCounter(ctx, counter))
+		}, func(err error) {
+			s.options.Profiler.Record(kind, resourceType, time.Since(start), counter.ApiCalls(), err)
+		}
+}
+
+func (s *Scanner) scan(ctx context.Context) ([]*resource.Resource, error) {
+	enumerationResult, err := s.enumerate(ctx, s.remoteLibrary.Enumerators())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.options.Deep {
+		return enumerationResult, nil
+	}
+
+	return s.readDetails(ctx, enumerationResult)
+}
+
+// enumerate lists the resources of the given enumerators, except the ones whose type is ignored by the filter
+func (s *Scanner) enumerate(ctx context.Context, enumerators []common.Enumerator) ([]*resource.Resource, error) {
+	for _, enumerator := range enumerators {
 		if s.filter.IsTypeIgnored(enumerator.SupportedType()) {
 			logrus.WithFields(logrus.Fields{
 				"type": enumerator.SupportedType(),
@@ -69,37 +165,58 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 		}
 		enumerator := enumerator
 		s.enumeratorRunner.Run(func() (interface{}, error) {
-			resources, err := enumerator.Enumerate()
+			resourceType := string(enumerator.SupportedType())
+			events.Emit(events.NewEnumerationStarted(resourceType))
+			start := time.Now()
+			enumerate, record := s.profile(profile.Enumeration, resourceType, func(ctx context.Context) ([]*resource.Resource, error) {
+				ctx, span := tracing.Start(ctx, "Enumerator.Enumerate", tracing.ResourceTypeKey.String(resourceType))
+				resources, err := enumerator.Enumerate(ctx)
+				span.SetAttributes(tracing.ResourceCountKey.Int(len(resources)))
+				tracing.End(span, err)
+				return resources, err
+			})
+			resources, timedOut, err := s.withTimeout(ctx, enumerate)
+			if timedOut {
+				record(errTimeout)
+				events.Emit(events.NewEnumerationFinished(resourceType, 0, time.Since(start), errTimeout))
+				logrus.WithFields(logrus.Fields{
+					"type":    enumerator.SupportedType(),
+					"timeout": s.options.EnumeratorTimeout,
+				}).Debug("Enumeration timed out")
+				s.alerter.SendAlert(string(enumerator.SupportedType()), alerts.NewEnumerationTimeoutAlert(string(enumerator.SupportedType()), s.options.EnumeratorTimeout))
+				return []*resource.Resource{}, nil
+			}
+			record(err)
 			if err != nil {
+				events.Emit(events.NewEnumerationFinished(resourceType, 0, time.Since(start), err))
 				err := HandleResourceEnumerationError(err, s.alerter)
 				if err == nil {
 					return []*resource.Resource{}, nil
 				}
 				return nil, err
 			}
+			count := 0
 			for _, res := range resources {
 				if res == nil {
 					continue
 				}
+				count++
 				logrus.WithFields(logrus.Fields{
 					"id":   res.ResourceId(),
 					"type": res.ResourceType(),
 				}).Debug("Found cloud resource")
 			}
+			events.Emit(events.NewEnumerationFinished(resourceType, count, time.Since(start), nil))
 			return resources, nil
 		})
 	}
 
-	enumerationResult, err := s.retrieveRunnerResults(s.enumeratorRunner)
-	if err != nil {
-		return nil, err
-	}
-
-	if !s.options.Deep {
-		return enumerationResult, nil
-	}
+	return s.retrieveRunnerResults(ctx, s.enumeratorRunner)
+}
 
-	for _, res := range enumerationResult {
+// readDetails reads the details of resources with their details fetcher, resources without one are returned as is
+func (s *Scanner) readDetails(ctx context.Context, resources []*resource.Resource) ([]*resource.Resource, error) {
+	for _, res := range resources {
 		res := res
 		s.detailsFetcherRunner.Run(func() (interface{}, error) {
 			fetcher := s.remoteLibrary.GetDetailsFetcher(resource.ResourceType(res.ResourceType()))
@@ -107,28 +224,145 @@ func (s *Scanner) scan() ([]*resource.Resource, error) {
 				return []*resource.Resource{res}, nil
 			}
 
-			resourceWithDetails, err := fetcher.ReadDetails(res)
+			readDetails, record := s.profile(profile.DetailsFetching, res.ResourceType(), func(ctx context.Context) ([]*resource.Resource, error) {
+				ctx, span := tracing.Start(ctx, "DetailsFetcher.ReadDetails",
+					tracing.ResourceTypeKey.String(res.ResourceType()),
+					tracing.ResourceIdKey.String(res.ResourceId()),
+				)
+				resourceWithDetails, err := fetcher.ReadDetails(ctx, res)
+				tracing.End(span, err)
+				return []*resource.Resource{resourceWithDetails}, err
+			})
+			resources, timedOut, err := s.withTimeout(ctx, readDetails)
+			if timedOut {
+				record(errTimeout)
+				logrus.WithFields(logrus.Fields{
+					"id":      res.ResourceId(),
+					"type":    res.ResourceType(),
+					"timeout": s.options.EnumeratorTimeout,
+				}).Debug("Details fetching timed out")
+				s.alerter.SendAlert(fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId()), alerts.NewDetailsFetchingTimeoutAlert(res, s.options.EnumeratorTimeout))
+				return []*resource.Resource{}, nil
+			}
+			record(err)
 			if err != nil {
 				if err := HandleResourceDetailsFetchingError(err, s.alerter); err != nil {
 					return nil, err
 				}
 				return []*resource.Resource{}, nil
 			}
-			return []*resource.Resource{resourceWithDetails}, nil
+			return resources, nil
 		})
 	}
 
-	return s.retrieveRunnerResults(s.detailsFetcherRunner)
+	return s.retrieveRunnerResults(ctx, s.detailsFetcherRunner)
+}
+
+// Refresh reads the details of the given resources without enumerating the cloud,
+// resources that do not exist anymore are omitted from the output.
+// Types without details fetcher are enumerated instead, since their enumerator already reads all their attributes,
+// and types that can be neither read nor enumerated are ignored with an alert.
+func (s *Scanner) Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+	ctx, span := tracing.Start(ctx, "Scanner.Refresh")
+	var resources []*resource.Resource
+	var enumerators []common.Enumerator
+	for typ, resByType := range input.Resources {
+		if s.filter.IsTypeIgnored(resource.ResourceType(typ)) {
+			continue
+		}
+		if s.remoteLibrary.GetDetailsFetcher(resource.ResourceType(typ)) == nil {
+			if enumerator := s.enumeratorOf(typ); enumerator != nil {
+				enumerators = append(enumerators, enumerator)
+			} else {
+				s.alerter.SendAlert(typ, alerts.NewRefreshUnsupportedAlert(typ))
+			}
+			continue
+		}
+		for _, res := range resByType {
+			// Details fetchers must not alter the given resources
+			attrs := res.Attributes()
+			if attrs != nil {
+				attrs = attrs.Copy()
+			}
+			if alias := s.aliasOf(res, input.Resources); alias != "" {
+				if attrs == nil {
+					attrs = &resource.Attributes{}
+				}
+				(*attrs)["alias"] = alias
+			}
+			resources = append(resources, &resource.Resource{
+				Id:    res.ResourceId(),
+				Type:  res.ResourceType(),
+				Attrs: attrs,
+			})
+		}
+	}
+	enumerated, err := s.enumerate(ctx, enumerators)
+	var refreshed []*resource.Resource
+	if err == nil {
+		refreshed, err = s.readDetails(ctx, resources)
+	}
+	refreshed = append(refreshed, enumerated...)
+	span.SetAttributes(tracing.ResourceCountKey.Int(len(refreshed)))
+	tracing.End(span, err)
+	s.sendThrottlingAlerts()
+	if err != nil {
+		return nil, err
+	}
+
+	output := &enumeration.RefreshOutput{Resources: map[string][]*resource.Resource{}}
+	for _, res := range refreshed {
+		output.Resources[res.ResourceType()] = append(output.Resources[res.ResourceType()], res)
+	}
+	return output, nil
+}
+
+// aliasOf returns the provider alias the enumerator of a resource found in IaC would have set, if any
+func (s *Scanner) aliasOf(res *resource.Resource, resources map[string][]*resource.Resource) string {
+	resolver := s.remoteLibrary.AliasResolver()
+	if resolver == nil {
+		return ""
+	}
+	return resolver(res, resources)
+}
+
+func (s *Scanner) enumeratorOf(typ string) common.Enumerator {
+	for _, enumerator := range s.remoteLibrary.Enumerators() {
+		if string(enumerator.SupportedType()) == typ {
+			return enumerator
+		}
+	}
+	return nil
 }
 
 func (s *Scanner) Resources() ([]*resource.Resource, error) {
-	resources, err := s.scan()
+	return s.ResourcesWithContext(s.ctx)
+}
+
+// ResourcesWithContext scans the cloud within ctx, which must not outlive the context of the scanner
+func (s *Scanner) ResourcesWithContext(ctx context.Context) ([]*resource.Resource, error) {
+	ctx, span := tracing.Start(ctx, "Scanner.Resources")
+	resources, err := s.scan(ctx)
+	span.SetAttributes(tracing.ResourceCountKey.Int(len(resources)))
+	tracing.End(span, err)
+	s.sendThrottlingAlerts()
 	if err != nil {
 		return nil, err
 	}
 	return resources, err
 }
 
+func (s *Scanner) sendThrottlingAlerts() {
+	limiter := s.remoteLibrary.RateLimiter()
+	if limiter == nil {
+		return
+	}
+	throttles := limiter.FlushThrottles()
+	for _, service := range ratelimit.SortedServices(throttles) {
+		s.alerter.SendAlert("", alerts.NewThrottlingAlert(service, throttles[service]))
+	}
+}
+
 func (s *Scanner) Stop() {
 	logrus.Debug("Stopping scanner")
 	s.enumeratorRunner.Stop(errors.New("interrupted"))
@@ -1,15 +1,30 @@
 package remote
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/tracing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestScannerShouldIgnoreType(t *testing.T) {
@@ -18,7 +33,7 @@ func TestScannerShouldIgnoreType(t *testing.T) {
 	alerter := alerter.NewAlerter()
 	fakeEnumerator := &common.MockEnumerator{}
 	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
-	fakeEnumerator.AssertNotCalled(t, "Enumerate")
+	fakeEnumerator.AssertNotCalled(t, "Enumerate", mock.Anything)
 
 	remoteLibrary := common.NewRemoteLibrary()
 	remoteLibrary.AddEnumerator(fakeEnumerator)
@@ -26,8 +41,372 @@ func TestScannerShouldIgnoreType(t *testing.T) {
 	testFilter := &enumeration.MockFilter{}
 	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(true)
 
-	s := NewScanner(remoteLibrary, alerter, ScannerOptions{}, testFilter)
+	s := NewScanner(context.TODO(), remoteLibrary, alerter, ScannerOptions{}, testFilter)
 	_, err := s.Resources()
 	assert.Nil(t, err)
 	fakeEnumerator.AssertExpectations(t)
 }
+
+func TestScannerShouldSendThrottlingAlerts(t *testing.T) {
+	alertr := alerter.NewAlerter()
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{}, nil)
+
+	limiter := ratelimit.NewLimiter(10)
+	limiter.Throttled("iam")
+	limiter.Throttled("iam")
+	limiter.Throttled("route53")
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+	remoteLibrary.SetRateLimiter(limiter)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", resource.ResourceType("FakeType")).Return(false)
+
+	s := NewScanner(context.TODO(), remoteLibrary, alertr, ScannerOptions{EnumerationConcurrency: 1}, testFilter)
+	_, err := s.Resources()
+	assert.Nil(t, err)
+
+	assert.Equal(t, alerter.Alerts{
+		"": []alerter.Alert{
+			alerts.NewThrottlingAlert("iam", 2),
+			alerts.NewThrottlingAlert("route53", 1),
+		},
+	}, alertr.Retrieve())
+}
+
+func TestScannerShouldAlertOnEnumeratorTimeout(t *testing.T) {
+	alertr := alerter.NewAlerter()
+
+	hungEnumerator := &common.MockEnumerator{}
+	hungEnumerator.On("SupportedType").Return(resource.ResourceType("HungType"))
+	hungEnumerator.On("Enumerate", mock.Anything).Return(func(ctx context.Context) []*resource.Resource {
+		<-ctx.Done()
+		return nil
+	}, func(ctx context.Context) error {
+		return ctx.Err()
+	})
+
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "foo", Type: "FakeType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(hungEnumerator)
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(context.TODO(), remoteLibrary, alertr, ScannerOptions{EnumeratorTimeout: 10 * time.Millisecond}, testFilter)
+	got, err := s.Resources()
+	assert.Nil(t, err)
+	assert.Equal(t, []*resource.Resource{{Id: "foo", Type: "FakeType"}}, got)
+
+	assert.Equal(t, alerter.Alerts{
+		"HungType": []alerter.Alert{
+			alerts.NewEnumerationTimeoutAlert("HungType", 10*time.Millisecond),
+		},
+	}, alertr.Retrieve())
+}
+
+func TestScannerShouldFailWhenContextIsDone(t *testing.T) {
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{}, nil).Maybe()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := NewScanner(ctx, remoteLibrary, alerter.NewAlerter(), ScannerOptions{}, testFilter)
+	_, err := s.Resources()
+	assert.Equal(t, context.Canceled, err)
+}
+
+type failingDetailsFetcher struct {
+	id string
+}
+
+func (f failingDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	if res.ResourceId() == f.id {
+		return nil, errors.New("unexpected error")
+	}
+	return res, nil
+}
+
+func TestScannerShouldTraceEnumeration(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "foo", Type: "FakeType"}, {Id: "bar", Type: "FakeType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+	remoteLibrary.AddDetailsFetcher("FakeType", failingDetailsFetcher{id: "bar"})
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	ctx, root := otel.Tracer("test").Start(context.Background(), "root")
+	s := NewScanner(ctx, remoteLibrary, alerter.NewAlerter(), ScannerOptions{Deep: true, DetailsFetchingConcurrency: 1}, testFilter)
+	_, err := s.Resources()
+	root.End()
+	assert.EqualError(t, err, "unexpected error")
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		name := span.Name()
+		for _, attr := range span.Attributes() {
+			if attr.Key == tracing.ResourceIdKey {
+				name += "." + attr.Value.AsString()
+			}
+		}
+		spans[name] = span
+	}
+
+	scan := spans["Scanner.Resources"]
+	assert.Equal(t, root.SpanContext().SpanID(), scan.Parent().SpanID())
+	assert.Equal(t, codes.Error, scan.Status().Code)
+
+	enumerate := spans["Enumerator.Enumerate"]
+	assert.Equal(t, scan.SpanContext().TraceID(), enumerate.SpanContext().TraceID())
+	assert.Equal(t, []attribute.KeyValue{
+		tracing.ResourceTypeKey.String("FakeType"),
+		tracing.ResourceCountKey.Int(2),
+	}, enumerate.Attributes())
+	assert.Equal(t, codes.Unset, enumerate.Status().Code)
+
+	assert.Equal(t, codes.Unset, spans["DetailsFetcher.ReadDetails.foo"].Status().Code)
+	assert.Equal(t, codes.Error, spans["DetailsFetcher.ReadDetails.bar"].Status().Code)
+	assert.Equal(t, "unexpected error", spans["DetailsFetcher.ReadDetails.bar"].Status().Description)
+}
+
+func TestScannerResourcesWithContextShouldTraceWithinGivenContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{{Id: "foo", Type: "FakeType"}}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(context.Background(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{}, testFilter)
+	ctx, root := otel.Tracer("test").Start(context.Background(), "root")
+	_, err := s.ResourcesWithContext(ctx)
+	root.End()
+	assert.Nil(t, err)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	assert.Equal(t, root.SpanContext().SpanID(), spans["Scanner.Resources"].Parent().SpanID())
+	assert.Equal(t, spans["Scanner.Resources"].SpanContext().SpanID(), spans["Enumerator.Enumerate"].Parent().SpanID())
+}
+
+func TestScannerResourcesWithContextShouldStopWhenContextIsCancelled(t *testing.T) {
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return(func(ctx context.Context) []*resource.Resource {
+		<-ctx.Done()
+		return nil
+	}, func(ctx context.Context) error {
+		return ctx.Err()
+	})
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(context.Background(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{EnumeratorTimeout: time.Minute}, testFilter)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := s.ResourcesWithContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestScannerShouldProfileEnumeration(t *testing.T) {
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return(func(ctx context.Context) []*resource.Resource {
+		profile.CountApiCall(ctx)
+		profile.CountApiCall(ctx)
+		return []*resource.Resource{{Id: "foo", Type: "FakeType"}, {Id: "bar", Type: "FakeType"}}
+	}, nil)
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+	remoteLibrary.AddDetailsFetcher("FakeType", failingDetailsFetcher{})
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	profiler := profile.NewProfiler()
+	s := NewScanner(context.TODO(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{Deep: true, Profiler: profiler}, testFilter)
+	_, err := s.Resources()
+	assert.Nil(t, err)
+
+	report := profiler.Report()
+	assert.Len(t, report.Enumeration, 1)
+	assert.Equal(t, "FakeType", report.Enumeration[0].ResourceType)
+	assert.Equal(t, 1, report.Enumeration[0].Calls)
+	assert.Equal(t, 2, report.Enumeration[0].ApiCalls)
+	assert.Equal(t, 0, report.Enumeration[0].Errors)
+	assert.Len(t, report.DetailsFetching, 1)
+	assert.Equal(t, 2, report.DetailsFetching[0].Calls)
+}
+
+// refreshingDetailsFetcher reads resources as if they were deleted from the cloud when their id is gone
+type refreshingDetailsFetcher struct{}
+
+func (f refreshingDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	if res.ResourceId() == "gone" {
+		return nil, nil
+	}
+	(*res.Attributes())["refreshed"] = true
+	return res, nil
+}
+
+func TestScannerShouldRefreshWithoutEnumerating(t *testing.T) {
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+	remoteLibrary.AddDetailsFetcher("FakeType", refreshingDetailsFetcher{})
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", resource.ResourceType("IgnoredType")).Return(true)
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	managed := &resource.Resource{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{"name": "foo"}}
+	s := NewScanner(context.TODO(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{Deep: true}, testFilter)
+	output, err := s.Refresh(context.TODO(), &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{
+		"FakeType":    {managed, {Id: "gone", Type: "FakeType", Attrs: &resource.Attributes{}}},
+		"IgnoredType": {{Id: "baz", Type: "IgnoredType"}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]*resource.Resource{
+		"FakeType": {{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{"name": "foo", "refreshed": true}}},
+	}, output.Resources)
+	assert.Equal(t, &resource.Attributes{"name": "foo"}, managed.Attributes())
+	fakeEnumerator.AssertNotCalled(t, "Enumerate", mock.Anything)
+}
+
+// aliasDetailsFetcher records the alias resources are read with
+type aliasDetailsFetcher struct {
+	mu      sync.Mutex
+	aliases map[string]interface{}
+}
+
+func (f *aliasDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.aliases[res.ResourceId()] = (*res.Attributes())["alias"]
+	return res, nil
+}
+
+func TestScannerShouldRefreshWithResolvedAlias(t *testing.T) {
+	fetcher := &aliasDetailsFetcher{aliases: map[string]interface{}{}}
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddDetailsFetcher("aws_s3_bucket", fetcher)
+	remoteLibrary.SetAliasResolver(func(res *resource.Resource, resources map[string][]*resource.Resource) string {
+		if region := res.Attributes().GetString("region"); region != nil {
+			return *region
+		}
+		return ""
+	})
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	paris := &resource.Resource{Id: "paris-bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"region": "eu-west-3"}}
+	s := NewScanner(context.TODO(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{Deep: true}, testFilter)
+	_, err := s.Refresh(context.TODO(), &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{
+		"aws_s3_bucket": {paris, {Id: "default-bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{}}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"paris-bucket": "eu-west-3", "default-bucket": nil}, fetcher.aliases)
+	assert.Equal(t, &resource.Attributes{"region": "eu-west-3"}, paris.Attributes())
+}
+
+// blockingDetailsFetcher reads details until its context is done
+type blockingDetailsFetcher struct{}
+
+func (f blockingDetailsFetcher) ReadDetails(ctx context.Context, res *resource.Resource) (*resource.Resource, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestScannerShouldStopRefreshWhenContextIsCancelled(t *testing.T) {
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddDetailsFetcher("FakeType", blockingDetailsFetcher{})
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(context.Background(), remoteLibrary, alerter.NewAlerter(), ScannerOptions{Deep: true}, testFilter)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	output, err := s.Refresh(ctx, &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{
+		"FakeType": {{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{}}},
+	}})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, output)
+}
+
+func TestScannerShouldRefreshTypesWithoutDetailsFetcher(t *testing.T) {
+	alertr := alerter.NewAlerter()
+
+	fakeEnumerator := &common.MockEnumerator{}
+	fakeEnumerator.On("SupportedType").Return(resource.ResourceType("FakeType"))
+	fakeEnumerator.On("Enumerate", mock.Anything).Return([]*resource.Resource{
+		{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{"name": "renamed"}},
+		{Id: "unmanaged", Type: "FakeType", Attrs: &resource.Attributes{"name": "unmanaged"}},
+	}, nil).Once()
+
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(fakeEnumerator)
+
+	testFilter := &enumeration.MockFilter{}
+	testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+
+	s := NewScanner(context.TODO(), remoteLibrary, alertr, ScannerOptions{Deep: true}, testFilter)
+	output, err := s.Refresh(context.TODO(), &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{
+		// Without details fetcher, the type is enumerated instead of returning the IaC attributes as is
+		"FakeType": {{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{"name": "foo"}}},
+		// Without details fetcher nor enumerator, the type can not be read and is ignored
+		"OtherType": {{Id: "bar", Type: "OtherType", Attrs: &resource.Attributes{"name": "bar"}}},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]*resource.Resource{
+		"FakeType": {
+			{Id: "foo", Type: "FakeType", Attrs: &resource.Attributes{"name": "renamed"}},
+			{Id: "unmanaged", Type: "FakeType", Attrs: &resource.Attributes{"name": "unmanaged"}},
+		},
+	}, output.Resources)
+	assert.Equal(t, alerter.Alerts{
+		"OtherType": {alerts.NewRefreshUnsupportedAlert("OtherType")},
+	}, alertr.Retrieve())
+	assert.True(t, alertr.IsResourceIgnored(&resource.Resource{Id: "bar", Type: "OtherType"}))
+	fakeEnumerator.AssertExpectations(t)
+}
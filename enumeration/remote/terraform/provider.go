@@ -17,6 +17,8 @@ import (
 	"github.com/sirupsen/logrus"
 	progress2 "github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/parallel"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	tf "github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
@@ -42,6 +44,9 @@ type TerraformProvider struct {
 	Config            TerraformProviderConfig
 	runner            *parallel.ParallelRunner
 	progress          progress2.ProgressCounter
+	rateLimiter       *ratelimit.Limiter
+	// serviceOf returns the rate limiter key of the cloud service a resource type is read from
+	serviceOf func(resourceType string) string
 }
 
 func NewTerraformProvider(installer *tf.ProviderInstaller, config TerraformProviderConfig, progress progress2.ProgressCounter) (*TerraformProvider, error) {
@@ -55,6 +60,14 @@ func NewTerraformProvider(installer *tf.ProviderInstaller, config TerraformProvi
 	return &p, nil
 }
 
+// SetRateLimiter makes resource reads wait for the rate limiter of the service returned by serviceOf.
+// A read sends at least one request to the cloud service, and throttling errors returned by the provider
+// once its own retries are exhausted are reported to the limiter.
+func (p *TerraformProvider) SetRateLimiter(limiter *ratelimit.Limiter, serviceOf func(resourceType string) string) {
+	p.rateLimiter = limiter
+	p.serviceOf = serviceOf
+}
+
 func (p *TerraformProvider) Init() error {
 	stopCh := make(chan bool)
 	c := make(chan os.Signal, 1)
@@ -142,7 +155,7 @@ func (p *TerraformProvider) configure(alias string) error {
 	return nil
 }
 
-func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value, error) {
+func (p *TerraformProvider) ReadResource(ctx context.Context, args tf.ReadResourceArgs) (*cty.Value, error) {
 
 	logrus.WithFields(logrus.Fields{
 		"id":    args.ID,
@@ -189,15 +202,41 @@ func (p *TerraformProvider) ReadResource(args tf.ReadResourceArgs) (*cty.Value,
 	var newState cty.Value
 	r := retrier.New(retrier.ConstantBackoff(3, 100*time.Millisecond), nil)
 
-	err = r.Run(func() error {
-		resp := p.grpcProviders[alias].ReadResource(providers.ReadResourceRequest{
-			TypeName:     typ,
-			PriorState:   priorState,
-			Private:      []byte{},
-			ProviderMeta: cty.NullVal(cty.DynamicPseudoType),
-		})
+	err = r.RunCtx(ctx, func(ctx context.Context) error {
+		if p.rateLimiter != nil {
+			if err := p.rateLimiter.Wait(ctx, p.serviceOf(typ)); err != nil {
+				return err
+			}
+		}
+		profile.CountApiCall(ctx)
+		// gRPC calls to terraform providers cannot be cancelled, so we stop waiting for the response
+		// when the context is done and let the call finish in background
+		respChan := make(chan providers.ReadResourceResponse, 1)
+		go func() {
+			respChan <- p.grpcProviders[alias].ReadResource(providers.ReadResourceRequest{
+				TypeName:     typ,
+				PriorState:   priorState,
+				Private:      []byte{},
+				ProviderMeta: cty.NullVal(cty.DynamicPseudoType),
+			})
+		}()
+
+		var resp providers.ReadResourceResponse
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resp = <-respChan:
+		}
+
 		if resp.Diagnostics.HasErrors() {
-			return resp.Diagnostics.Err()
+			err := resp.Diagnostics.Err()
+			if p.rateLimiter != nil && ratelimit.IsThrottlingError(err) {
+				p.rateLimiter.Throttled(p.serviceOf(typ))
+			}
+			return err
+		}
+		if p.rateLimiter != nil {
+			p.rateLimiter.Succeeded(p.serviceOf(typ))
 		}
 		nonFatalErr := resp.Diagnostics.NonFatalErr()
 		if resp.NewState.IsNull() && nonFatalErr != nil {
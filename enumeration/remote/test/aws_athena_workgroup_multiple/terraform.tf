@@ -0,0 +1,35 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_athena_workgroup" "analysts" {
+  name = "analysts"
+
+  configuration {
+    enforce_workgroup_configuration    = true
+    publish_cloudwatch_metrics_enabled = true
+
+    result_configuration {
+      output_location = "s3://athena-results-526954929923/analysts/"
+    }
+  }
+}
+
+resource "aws_athena_workgroup" "reporting" {
+  name = "reporting"
+
+  configuration {
+    enforce_workgroup_configuration    = true
+    publish_cloudwatch_metrics_enabled = true
+
+    result_configuration {
+      output_location = "s3://athena-results-526954929923/reporting/"
+    }
+  }
+}
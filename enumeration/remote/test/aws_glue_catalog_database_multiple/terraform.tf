@@ -0,0 +1,17 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_glue_catalog_database" "analytics" {
+  name = "analytics"
+}
+
+resource "aws_glue_catalog_database" "raw" {
+  name = "raw"
+}
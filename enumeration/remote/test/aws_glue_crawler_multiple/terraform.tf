@@ -0,0 +1,29 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_glue_crawler" "orders" {
+  name          = "orders-crawler"
+  database_name = "analytics"
+  role          = "glue-crawlers"
+
+  s3_target {
+    path = "s3://analytics-data/orders/"
+  }
+}
+
+resource "aws_glue_crawler" "customers" {
+  name          = "customers-crawler"
+  database_name = "analytics"
+  role          = "glue-crawlers"
+
+  s3_target {
+    path = "s3://analytics-data/customers/"
+  }
+}
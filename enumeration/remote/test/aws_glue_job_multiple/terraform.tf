@@ -0,0 +1,41 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_glue_job" "orders" {
+  name         = "orders-etl"
+  role_arn     = "arn:aws:iam::526954929923:role/glue-jobs"
+  glue_version = "2.0"
+  max_capacity = 2
+
+  default_arguments = {
+    "--job-language" = "python"
+  }
+
+  command {
+    script_location = "s3://glue-scripts-526954929923/orders-etl.py"
+    python_version  = "3"
+  }
+}
+
+resource "aws_glue_job" "customers" {
+  name         = "customers-etl"
+  role_arn     = "arn:aws:iam::526954929923:role/glue-jobs"
+  glue_version = "2.0"
+  max_capacity = 2
+
+  default_arguments = {
+    "--job-language" = "python"
+  }
+
+  command {
+    script_location = "s3://glue-scripts-526954929923/customers-etl.py"
+    python_version  = "3"
+  }
+}
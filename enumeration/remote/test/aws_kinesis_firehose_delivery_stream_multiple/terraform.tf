@@ -0,0 +1,57 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_s3_bucket" "clickstream" {
+  bucket = "clickstream-archive-bucket"
+}
+
+resource "aws_s3_bucket" "orders" {
+  bucket = "orders-archive-bucket"
+}
+
+resource "aws_iam_role" "clickstream" {
+  name               = "firehose-clickstream-archive"
+  assume_role_policy = data.aws_iam_policy_document.firehose.json
+}
+
+resource "aws_iam_role" "orders" {
+  name               = "firehose-orders-archive"
+  assume_role_policy = data.aws_iam_policy_document.firehose.json
+}
+
+data "aws_iam_policy_document" "firehose" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    principals {
+      type        = "Service"
+      identifiers = ["firehose.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "clickstream" {
+  name        = "clickstream-archive"
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.clickstream.arn
+    bucket_arn = aws_s3_bucket.clickstream.arn
+  }
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "orders" {
+  name        = "orders-archive"
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.orders.arn
+    bucket_arn = aws_s3_bucket.orders.arn
+  }
+}
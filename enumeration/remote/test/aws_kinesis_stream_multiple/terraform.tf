@@ -0,0 +1,21 @@
+provider "aws" {
+  region = "us-east-1"
+}
+
+terraform {
+  required_providers {
+    aws = "3.62.0"
+  }
+}
+
+resource "aws_kinesis_stream" "clickstream" {
+  name             = "clickstream"
+  shard_count      = 1
+  retention_period = 24
+}
+
+resource "aws_kinesis_stream" "orders" {
+  name             = "orders"
+  shard_count      = 1
+  retention_period = 24
+}
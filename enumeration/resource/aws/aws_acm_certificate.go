@@ -0,0 +1,3 @@
+package aws
+
+const AwsAcmCertificateResourceType = "aws_acm_certificate"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsAthenaWorkgroupResourceType = "aws_athena_workgroup"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCloudwatchLogMetricFilterResourceType = "aws_cloudwatch_log_metric_filter"
@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+)
+
+const AwsCloudwatchLogSubscriptionFilterResourceType = "aws_cloudwatch_log_subscription_filter"
+
+// CreateCloudwatchLogSubscriptionFilterID composes the id the same way the terraform provider does,
+// it only depends on the log group name
+func CreateCloudwatchLogSubscriptionFilterID(logGroupName string) string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", logGroupName))
+	return fmt.Sprintf("cwlsf-%d", hashcode.String(buf.String()))
+}
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCognitoIdentityPoolResourceType = "aws_cognito_identity_pool"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsCognitoUserPoolResourceType = "aws_cognito_user_pool"
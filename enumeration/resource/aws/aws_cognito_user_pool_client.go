@@ -0,0 +1,3 @@
+package aws
+
+const AwsCognitoUserPoolClientResourceType = "aws_cognito_user_pool_client"
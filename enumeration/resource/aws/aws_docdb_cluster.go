@@ -0,0 +1,3 @@
+package aws
+
+const AwsDocDBClusterResourceType = "aws_docdb_cluster"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsDocDBClusterInstanceResourceType = "aws_docdb_cluster_instance"
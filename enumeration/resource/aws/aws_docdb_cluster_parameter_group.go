@@ -0,0 +1,3 @@
+package aws
+
+const AwsDocDBClusterParameterGroupResourceType = "aws_docdb_cluster_parameter_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsDocDBSubnetGroupResourceType = "aws_docdb_subnet_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsEc2TransitGatewayRouteTableResourceType = "aws_ec2_transit_gateway_route_table"
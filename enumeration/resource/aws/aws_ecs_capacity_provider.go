@@ -0,0 +1,3 @@
+package aws
+
+const AwsEcsCapacityProviderResourceType = "aws_ecs_capacity_provider"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsEcsClusterResourceType = "aws_ecs_cluster"
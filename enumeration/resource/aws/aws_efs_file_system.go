@@ -0,0 +1,3 @@
+package aws
+
+const AwsEfsFileSystemResourceType = "aws_efs_file_system"
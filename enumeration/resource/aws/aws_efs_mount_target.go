@@ -0,0 +1,3 @@
+package aws
+
+const AwsEfsMountTargetResourceType = "aws_efs_mount_target"
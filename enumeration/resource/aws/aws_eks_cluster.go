@@ -0,0 +1,3 @@
+package aws
+
+const AwsEksClusterResourceType = "aws_eks_cluster"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsEksFargateProfileResourceType = "aws_eks_fargate_profile"
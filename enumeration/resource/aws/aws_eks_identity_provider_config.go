@@ -0,0 +1,3 @@
+package aws
+
+const AwsEksIdentityProviderConfigResourceType = "aws_eks_identity_provider_config"
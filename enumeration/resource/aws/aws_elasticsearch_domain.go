@@ -0,0 +1,3 @@
+package aws
+
+const AwsElasticsearchDomainResourceType = "aws_elasticsearch_domain"
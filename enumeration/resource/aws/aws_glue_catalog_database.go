@@ -0,0 +1,3 @@
+package aws
+
+const AwsGlueCatalogDatabaseResourceType = "aws_glue_catalog_database"
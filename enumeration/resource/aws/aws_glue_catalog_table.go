@@ -0,0 +1,3 @@
+package aws
+
+const AwsGlueCatalogTableResourceType = "aws_glue_catalog_table"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsGlueCrawlerResourceType = "aws_glue_crawler"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsGlueJobResourceType = "aws_glue_job"
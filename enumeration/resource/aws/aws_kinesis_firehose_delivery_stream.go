@@ -0,0 +1,3 @@
+package aws
+
+const AwsKinesisFirehoseDeliveryStreamResourceType = "aws_kinesis_firehose_delivery_stream"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsKinesisStreamResourceType = "aws_kinesis_stream"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsLambdaAliasResourceType = "aws_lambda_alias"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsLambdaPermissionResourceType = "aws_lambda_permission"
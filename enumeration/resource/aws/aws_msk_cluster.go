@@ -0,0 +1,3 @@
+package aws
+
+const AwsMskClusterResourceType = "aws_msk_cluster"
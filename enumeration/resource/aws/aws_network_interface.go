@@ -0,0 +1,3 @@
+package aws
+
+const AwsNetworkInterfaceResourceType = "aws_network_interface"
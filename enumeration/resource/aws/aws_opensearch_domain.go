@@ -0,0 +1,3 @@
+package aws
+
+const AwsOpenSearchDomainResourceType = "aws_opensearch_domain"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsRedshiftClusterResourceType = "aws_redshift_cluster"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsRedshiftParameterGroupResourceType = "aws_redshift_parameter_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsRedshiftSubnetGroupResourceType = "aws_redshift_subnet_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSecretsManagerSecretPolicyResourceType = "aws_secretsmanager_secret_policy"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSecretsManagerSecretRotationResourceType = "aws_secretsmanager_secret_rotation"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSesDomainIdentityResourceType = "aws_ses_domain_identity"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSesEmailIdentityResourceType = "aws_ses_email_identity"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsSfnActivityResourceType = "aws_sfn_activity"
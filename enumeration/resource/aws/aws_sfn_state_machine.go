@@ -0,0 +1,3 @@
+package aws
+
+const AwsSfnStateMachineResourceType = "aws_sfn_state_machine"
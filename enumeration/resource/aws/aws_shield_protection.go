@@ -0,0 +1,3 @@
+package aws
+
+const AwsShieldProtectionResourceType = "aws_shield_protection"
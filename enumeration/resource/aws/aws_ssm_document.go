@@ -0,0 +1,3 @@
+package aws
+
+const AwsSsmDocumentResourceType = "aws_ssm_document"
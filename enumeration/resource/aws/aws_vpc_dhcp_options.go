@@ -0,0 +1,3 @@
+package aws
+
+const AwsVpcDhcpOptionsResourceType = "aws_vpc_dhcp_options"
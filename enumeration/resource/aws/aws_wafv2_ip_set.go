@@ -0,0 +1,3 @@
+package aws
+
+const AwsWafv2IpSetResourceType = "aws_wafv2_ip_set"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsWafv2RuleGroupResourceType = "aws_wafv2_rule_group"
@@ -0,0 +1,3 @@
+package aws
+
+const AwsWafv2WebAclResourceType = "aws_wafv2_web_acl"
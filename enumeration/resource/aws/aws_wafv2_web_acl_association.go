@@ -0,0 +1,3 @@
+package aws
+
+const AwsWafv2WebAclAssociationResourceType = "aws_wafv2_web_acl_association"
@@ -3,10 +3,26 @@ package resource
 type ResourceType string
 
 var supportedTypes = map[string]ResourceTypeMeta{
+	"aws_acm_certificate":         {},
 	"aws_ami":                     {},
 	"aws_cloudfront_distribution": {},
-	"aws_db_instance":             {},
-	"aws_db_subnet_group":         {},
+	"aws_cloudwatch_event_bus": {children: []ResourceType{
+		"aws_cloudwatch_event_rule",
+	}},
+	"aws_cloudwatch_event_rule": {children: []ResourceType{
+		"aws_cloudwatch_event_target",
+	}},
+	"aws_cloudwatch_event_target": {},
+	"aws_cloudwatch_log_group": {children: []ResourceType{
+		"aws_cloudwatch_log_metric_filter",
+		"aws_cloudwatch_log_subscription_filter",
+	}},
+	"aws_cloudwatch_log_metric_filter":       {},
+	"aws_cloudwatch_log_subscription_filter": {},
+	"aws_cloudwatch_metric_alarm":            {},
+	"aws_cloudwatch_dashboard":               {},
+	"aws_db_instance":                        {},
+	"aws_db_subnet_group":                    {},
 	"aws_default_network_acl": {children: []ResourceType{
 		"aws_network_acl_rule",
 	}},
@@ -35,6 +51,20 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_ebs_encryption_by_default": {},
 	"aws_ecr_repository":            {},
 	"aws_ecr_repository_policy":     {},
+	"aws_ecs_cluster":               {},
+	"aws_ecs_service":               {},
+	"aws_ecs_task_definition":       {},
+	"aws_ecs_capacity_provider":     {},
+	"aws_eks_cluster": {children: []ResourceType{
+		"aws_eks_node_group",
+		"aws_eks_addon",
+		"aws_eks_fargate_profile",
+		"aws_eks_identity_provider_config",
+	}},
+	"aws_eks_node_group":               {},
+	"aws_eks_addon":                    {},
+	"aws_eks_fargate_profile":          {},
+	"aws_eks_identity_provider_config": {},
 	"aws_eip": {children: []ResourceType{
 		"aws_eip_association",
 	}},
@@ -77,8 +107,13 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_kms_alias":                   {},
 	"aws_kms_key":                     {},
 	"aws_lambda_event_source_mapping": {},
-	"aws_lambda_function":             {},
-	"aws_nat_gateway":                 {},
+	"aws_lambda_function": {children: []ResourceType{
+		"aws_lambda_permission",
+		"aws_lambda_alias",
+	}},
+	"aws_lambda_permission": {},
+	"aws_lambda_alias":      {},
+	"aws_nat_gateway":       {},
 	"aws_network_acl": {children: []ResourceType{
 		"aws_network_acl_rule",
 	}},
@@ -105,6 +140,12 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	}},
 	"aws_s3_account_public_access_block": {},
 	"aws_security_group_rule":            {},
+	"aws_secretsmanager_secret": {children: []ResourceType{
+		"aws_secretsmanager_secret_policy",
+		"aws_secretsmanager_secret_rotation",
+	}},
+	"aws_secretsmanager_secret_policy":   {},
+	"aws_secretsmanager_secret_rotation": {},
 	"aws_sns_topic": {children: []ResourceType{
 		"aws_sns_topic_policy",
 	}},
@@ -113,11 +154,33 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_sqs_queue": {children: []ResourceType{
 		"aws_sqs_queue_policy",
 	}},
-	"aws_sqs_queue_policy":     {},
-	"aws_subnet":               {},
-	"aws_vpc":                  {},
-	"aws_rds_cluster":          {},
-	"aws_cloudformation_stack": {},
+	"aws_sqs_queue_policy":       {},
+	"aws_ssm_document":           {},
+	"aws_ssm_parameter":          {},
+	"aws_sfn_state_machine":      {},
+	"aws_sfn_activity":           {},
+	"aws_subnet":                 {},
+	"aws_vpc":                    {},
+	"aws_vpc_endpoint":           {},
+	"aws_vpc_peering_connection": {},
+	"aws_vpc_dhcp_options":       {},
+	"aws_ec2_transit_gateway": {children: []ResourceType{
+		"aws_ec2_transit_gateway_vpc_attachment",
+		"aws_ec2_transit_gateway_route_table",
+	}},
+	"aws_ec2_transit_gateway_vpc_attachment": {},
+	"aws_ec2_transit_gateway_route_table":    {},
+	"aws_flow_log":                           {},
+	"aws_network_interface":                  {},
+	"aws_wafv2_web_acl": {children: []ResourceType{
+		"aws_wafv2_web_acl_association",
+	}},
+	"aws_wafv2_web_acl_association": {},
+	"aws_wafv2_ip_set":              {},
+	"aws_wafv2_rule_group":          {},
+	"aws_shield_protection":         {},
+	"aws_rds_cluster":               {},
+	"aws_cloudformation_stack":      {},
 	"aws_api_gateway_rest_api": {children: []ResourceType{
 		"aws_api_gateway_resource",
 		"aws_api_gateway_rest_api_policy",
@@ -154,6 +217,39 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"aws_rds_cluster_instance": {children: []ResourceType{
 		"aws_db_instance",
 	}},
+	"aws_efs_file_system": {children: []ResourceType{
+		"aws_efs_mount_target",
+	}},
+	"aws_efs_mount_target":              {},
+	"aws_redshift_cluster":              {},
+	"aws_redshift_subnet_group":         {},
+	"aws_redshift_parameter_group":      {},
+	"aws_opensearch_domain":             {},
+	"aws_elasticsearch_domain":          {},
+	"aws_msk_cluster":                   {},
+	"aws_docdb_cluster":                 {},
+	"aws_docdb_cluster_parameter_group": {},
+	"aws_docdb_cluster_instance": {children: []ResourceType{
+		"aws_db_instance",
+	}},
+	"aws_docdb_subnet_group": {children: []ResourceType{
+		"aws_db_subnet_group",
+	}},
+	"aws_kinesis_stream":                   {},
+	"aws_kinesis_firehose_delivery_stream": {},
+	"aws_glue_catalog_database":            {},
+	"aws_glue_catalog_table":               {},
+	"aws_glue_job":                         {},
+	"aws_glue_crawler":                     {},
+	"aws_athena_workgroup":                 {},
+	"aws_cognito_user_pool": {children: []ResourceType{
+		"aws_cognito_user_pool_client",
+	}},
+	"aws_cognito_user_pool_client":        {},
+	"aws_cognito_identity_pool":           {},
+	"aws_ses_domain_identity":             {},
+	"aws_ses_email_identity":              {},
+	"aws_sesv2_configuration_set":         {},
 	"aws_appautoscaling_policy":           {},
 	"aws_appautoscaling_scheduled_action": {},
 	"aws_apigatewayv2_api": {children: []ResourceType{
@@ -254,6 +350,13 @@ var supportedTypes = map[string]ResourceTypeMeta{
 	"azurerm_ssh_public_key":           {},
 }
 
+// AddSupportedType registers a resource type enumerated outside of driftctl, e.g. by an enumerator plugin
+func AddSupportedType(ty string) {
+	if _, exists := supportedTypes[ty]; !exists {
+		supportedTypes[ty] = ResourceTypeMeta{}
+	}
+}
+
 func IsResourceTypeSupported(ty string) bool {
 	_, exist := supportedTypes[ty]
 	return exist
@@ -1,5 +1,7 @@
 package resource
 
+import "context"
+
 // Supplier supply the list of resource.Resource, it's the main interface to retrieve remote resources
 type Supplier interface {
 	Resources() ([]*Resource, error)
@@ -9,3 +11,18 @@ type StoppableSupplier interface {
 	Supplier
 	Stop()
 }
+
+// ContextSupplier is a Supplier able to retrieve resources within the context of the caller,
+// so that its reads are cancelled and traced with it
+type ContextSupplier interface {
+	Supplier
+	ResourcesWithContext(ctx context.Context) ([]*Resource, error)
+}
+
+// ResourcesOf retrieves the resources of the given supplier within ctx when the supplier supports it
+func ResourcesOf(ctx context.Context, supplier Supplier) ([]*Resource, error) {
+	if s, ok := supplier.(ContextSupplier); ok {
+		return s.ResourcesWithContext(ctx)
+	}
+	return supplier.Resources()
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/server/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toProtoResources(resources []*resource.Resource) ([]*pb.Resource, error) {
+	protoResources, err := pb.FromResources(resources)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return protoResources, nil
+}
+
+func toProtoDiagnostics(diagnostics diagnostic.Diagnostics) ([]*pb.Diagnostic, error) {
+	protoDiagnostics := make([]*pb.Diagnostic, 0, len(diagnostics))
+	for _, diag := range diagnostics {
+		protoDiag := &pb.Diagnostic{
+			Code:         diag.Code(),
+			Message:      diag.Message(),
+			ResourceType: diag.ResourceType(),
+		}
+		if diag.Resource() != nil {
+			protoRes, err := pb.FromResource(diag.Resource())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			protoDiag.Resource = protoRes
+		}
+		protoDiagnostics = append(protoDiagnostics, protoDiag)
+	}
+	return protoDiagnostics, nil
+}
+
+func toProtoSchema(schema *terraform.ProviderSchema) (*pb.GetSchemaResponse, error) {
+	types := make([]string, 0, len(schema.ResourceTypes))
+	for typ := range schema.ResourceTypes {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	response := &pb.GetSchemaResponse{Resources: make([]*pb.ResourceSchema, 0, len(types))}
+	for _, typ := range types {
+		block, err := toProtoBlock(schema.ResourceTypes[typ])
+		if err != nil {
+			return nil, err
+		}
+		response.Resources = append(response.Resources, &pb.ResourceSchema{
+			Type:    typ,
+			Version: schema.ResourceTypeSchemaVersions[typ],
+			Block:   block,
+		})
+	}
+	return response, nil
+}
+
+var nestingModes = map[configschema.NestingMode]pb.NestedBlock_NestingMode{
+	configschema.NestingSingle: pb.NestedBlock_SINGLE,
+	configschema.NestingGroup:  pb.NestedBlock_GROUP,
+	configschema.NestingList:   pb.NestedBlock_LIST,
+	configschema.NestingSet:    pb.NestedBlock_SET,
+	configschema.NestingMap:    pb.NestedBlock_MAP,
+}
+
+func toProtoBlock(block *configschema.Block) (*pb.Block, error) {
+	protoBlock := &pb.Block{}
+	if block == nil {
+		return protoBlock, nil
+	}
+	protoBlock.Description = block.Description
+	protoBlock.Deprecated = block.Deprecated
+
+	names := make([]string, 0, len(block.Attributes))
+	for name := range block.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attr := block.Attributes[name]
+		typ, err := attr.Type.MarshalJSON()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to serialize type of attribute %s: %s", name, err)
+		}
+		protoBlock.Attributes = append(protoBlock.Attributes, &pb.Attribute{
+			Name:        name,
+			Type:        typ,
+			Description: attr.Description,
+			Required:    attr.Required,
+			Optional:    attr.Optional,
+			Computed:    attr.Computed,
+			Sensitive:   attr.Sensitive,
+			Deprecated:  attr.Deprecated,
+		})
+	}
+
+	names = make([]string, 0, len(block.BlockTypes))
+	for name := range block.BlockTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		nested := block.BlockTypes[name]
+		nestedBlock, err := toProtoBlock(&nested.Block)
+		if err != nil {
+			return nil, err
+		}
+		protoBlock.BlockTypes = append(protoBlock.BlockTypes, &pb.NestedBlock{
+			TypeName: name,
+			Block:    nestedBlock,
+			Nesting:  nestingModes[nested.Nesting],
+			MinItems: int64(nested.MinItems),
+			MaxItems: int64(nested.MaxItems),
+		})
+	}
+	return protoBlock, nil
+}
@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: enumeration/server/pb/enumeration.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EnumeratorClient is the client API for Enumerator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EnumeratorClient interface {
+	// Enumerate lists the resources of the requested types, a response is streamed for each resource type as soon as it is listed
+	Enumerate(ctx context.Context, in *EnumerateRequest, opts ...grpc.CallOption) (Enumerator_EnumerateClient, error)
+	// Refresh reads the details of the given resources, a response is streamed for each resource type
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (Enumerator_RefreshClient, error)
+	// GetSchema returns the terraform schema of every resource type supported by the cloud provider
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
+}
+
+type enumeratorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnumeratorClient(cc grpc.ClientConnInterface) EnumeratorClient {
+	return &enumeratorClient{cc}
+}
+
+func (c *enumeratorClient) Enumerate(ctx context.Context, in *EnumerateRequest, opts ...grpc.CallOption) (Enumerator_EnumerateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Enumerator_ServiceDesc.Streams[0], "/driftctl.enumeration.v1.Enumerator/Enumerate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enumeratorEnumerateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Enumerator_EnumerateClient interface {
+	Recv() (*EnumerateResponse, error)
+	grpc.ClientStream
+}
+
+type enumeratorEnumerateClient struct {
+	grpc.ClientStream
+}
+
+func (x *enumeratorEnumerateClient) Recv() (*EnumerateResponse, error) {
+	m := new(EnumerateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enumeratorClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (Enumerator_RefreshClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Enumerator_ServiceDesc.Streams[1], "/driftctl.enumeration.v1.Enumerator/Refresh", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enumeratorRefreshClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Enumerator_RefreshClient interface {
+	Recv() (*RefreshResponse, error)
+	grpc.ClientStream
+}
+
+type enumeratorRefreshClient struct {
+	grpc.ClientStream
+}
+
+func (x *enumeratorRefreshClient) Recv() (*RefreshResponse, error) {
+	m := new(RefreshResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enumeratorClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/driftctl.enumeration.v1.Enumerator/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EnumeratorServer is the server API for Enumerator service.
+// All implementations must embed UnimplementedEnumeratorServer
+// for forward compatibility
+type EnumeratorServer interface {
+	// Enumerate lists the resources of the requested types, a response is streamed for each resource type as soon as it is listed
+	Enumerate(*EnumerateRequest, Enumerator_EnumerateServer) error
+	// Refresh reads the details of the given resources, a response is streamed for each resource type
+	Refresh(*RefreshRequest, Enumerator_RefreshServer) error
+	// GetSchema returns the terraform schema of every resource type supported by the cloud provider
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
+	mustEmbedUnimplementedEnumeratorServer()
+}
+
+// UnimplementedEnumeratorServer must be embedded to have forward compatible implementations.
+type UnimplementedEnumeratorServer struct {
+}
+
+func (UnimplementedEnumeratorServer) Enumerate(*EnumerateRequest, Enumerator_EnumerateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Enumerate not implemented")
+}
+func (UnimplementedEnumeratorServer) Refresh(*RefreshRequest, Enumerator_RefreshServer) error {
+	return status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedEnumeratorServer) GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedEnumeratorServer) mustEmbedUnimplementedEnumeratorServer() {}
+
+// UnsafeEnumeratorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EnumeratorServer will
+// result in compilation errors.
+type UnsafeEnumeratorServer interface {
+	mustEmbedUnimplementedEnumeratorServer()
+}
+
+func RegisterEnumeratorServer(s grpc.ServiceRegistrar, srv EnumeratorServer) {
+	s.RegisterService(&Enumerator_ServiceDesc, srv)
+}
+
+func _Enumerator_Enumerate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnumerateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnumeratorServer).Enumerate(m, &enumeratorEnumerateServer{stream})
+}
+
+type Enumerator_EnumerateServer interface {
+	Send(*EnumerateResponse) error
+	grpc.ServerStream
+}
+
+type enumeratorEnumerateServer struct {
+	grpc.ServerStream
+}
+
+func (x *enumeratorEnumerateServer) Send(m *EnumerateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Enumerator_Refresh_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RefreshRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnumeratorServer).Refresh(m, &enumeratorRefreshServer{stream})
+}
+
+type Enumerator_RefreshServer interface {
+	Send(*RefreshResponse) error
+	grpc.ServerStream
+}
+
+type enumeratorRefreshServer struct {
+	grpc.ServerStream
+}
+
+func (x *enumeratorRefreshServer) Send(m *RefreshResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Enumerator_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnumeratorServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/driftctl.enumeration.v1.Enumerator/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnumeratorServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Enumerator_ServiceDesc is the grpc.ServiceDesc for Enumerator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Enumerator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "driftctl.enumeration.v1.Enumerator",
+	HandlerType: (*EnumeratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchema",
+			Handler:    _Enumerator_GetSchema_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Enumerate",
+			Handler:       _Enumerator_Enumerate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Refresh",
+			Handler:       _Enumerator_Refresh_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "enumeration/server/pb/enumeration.proto",
+}
@@ -0,0 +1,49 @@
+package pb
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// FromResource converts a driftctl resource to its protobuf representation
+func FromResource(res *resource.Resource) (*Resource, error) {
+	protoRes := &Resource{Id: res.ResourceId(), Type: res.ResourceType()}
+	if res.Attributes() == nil {
+		return protoRes, nil
+	}
+	// Attributes may hold any JSON serializable value, so they are converted through JSON rather than with structpb.NewStruct
+	raw, err := json.Marshal(res.Attributes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to serialize attributes of %s.%s", res.ResourceType(), res.ResourceId())
+	}
+	protoRes.Attributes = &structpb.Struct{}
+	if err := protoRes.Attributes.UnmarshalJSON(raw); err != nil {
+		return nil, errors.Wrapf(err, "unable to serialize attributes of %s.%s", res.ResourceType(), res.ResourceId())
+	}
+	return protoRes, nil
+}
+
+// FromResources converts a list of driftctl resources to their protobuf representation
+func FromResources(resources []*resource.Resource) ([]*Resource, error) {
+	protoResources := make([]*Resource, 0, len(resources))
+	for _, res := range resources {
+		protoRes, err := FromResource(res)
+		if err != nil {
+			return nil, err
+		}
+		protoResources = append(protoResources, protoRes)
+	}
+	return protoResources, nil
+}
+
+// ToResource converts a protobuf resource back to a driftctl resource
+func (x *Resource) ToResource() *resource.Resource {
+	attrs := resource.Attributes{}
+	if x.Attributes != nil {
+		attrs = x.Attributes.AsMap()
+	}
+	return &resource.Resource{Id: x.Id, Type: x.Type, Attrs: &attrs}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/server/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CloudEnumerator lists and refreshes resources of a cloud provider, as implemented by enumerator.CloudEnumerator
+type CloudEnumerator interface {
+	enumeration.Enumerator
+	enumeration.Refresher
+}
+
+// Server exposes a CloudEnumerator as a gRPC service
+type Server struct {
+	pb.UnimplementedEnumeratorServer
+
+	// The enumerator keeps the diagnostics of the current call, so calls are handled one at a time
+	lock       sync.Mutex
+	enumerator CloudEnumerator
+}
+
+func NewServer(enumerator CloudEnumerator) *Server {
+	return &Server{enumerator: enumerator}
+}
+
+// Register adds the enumerator service to a gRPC server
+func (s *Server) Register(server *grpc.Server) {
+	pb.RegisterEnumeratorServer(server, s)
+}
+
+func (s *Server) Enumerate(req *pb.EnumerateRequest, stream pb.Enumerator_EnumerateServer) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var sendLock sync.Mutex
+	var sendErr error
+	output, err := s.enumerator.Enumerate(stream.Context(), &enumeration.EnumerateInput{
+		ResourceTypes: req.ResourceTypes,
+		OnResources: func(resourceType string, resources []*resource.Resource) {
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			if sendErr != nil {
+				return
+			}
+			response := &pb.EnumerateResponse{ResourceType: resourceType}
+			response.Resources, sendErr = toProtoResources(resources)
+			if sendErr == nil {
+				sendErr = stream.Send(response)
+			}
+		},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	diagnostics, err := toProtoDiagnostics(output.Diagnostics)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.EnumerateResponse{Diagnostics: diagnostics})
+}
+
+func (s *Server) Refresh(req *pb.RefreshRequest, stream pb.Enumerator_RefreshServer) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	input := &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{}}
+	for _, res := range req.Resources {
+		if res.Id == "" || res.Type == "" {
+			return status.Error(codes.InvalidArgument, "resources to refresh must have an id and a type")
+		}
+		r := res.ToResource()
+		input.Resources[r.ResourceType()] = append(input.Resources[r.ResourceType()], r)
+	}
+
+	output, err := s.enumerator.Refresh(stream.Context(), input)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	types := make([]string, 0, len(output.Resources))
+	for typ := range output.Resources {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		resources, err := toProtoResources(output.Resources[typ])
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&pb.RefreshResponse{ResourceType: typ, Resources: resources}); err != nil {
+			return err
+		}
+	}
+
+	diagnostics, err := toProtoDiagnostics(output.Diagnostics)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.RefreshResponse{Diagnostics: diagnostics})
+}
+
+func (s *Server) GetSchema(_ context.Context, _ *pb.GetSchemaRequest) (*pb.GetSchemaResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	output, err := s.enumerator.GetSchema()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toProtoSchema(output.Schema)
+}
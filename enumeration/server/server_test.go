@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/snyk/driftctl/enumeration"
+	"github.com/snyk/driftctl/enumeration/diagnostic"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/server/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeDiagnostic struct {
+	resourceType string
+}
+
+func (d fakeDiagnostic) Code() string                 { return "ACCESS_DENIED" }
+func (d fakeDiagnostic) Message() string              { return "access denied" }
+func (d fakeDiagnostic) ResourceType() string         { return d.resourceType }
+func (d fakeDiagnostic) Resource() *resource.Resource { return nil }
+
+type fakeEnumerator struct {
+	refreshInput *enumeration.RefreshInput
+	err          error
+}
+
+func (e *fakeEnumerator) Enumerate(ctx context.Context, input *enumeration.EnumerateInput) (*enumeration.EnumerateOutput, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	resources := map[string][]*resource.Resource{
+		"aws_s3_bucket": {{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"bucket": "bucket", "tags": map[string]interface{}{"env": "prod"}}}},
+		"aws_iam_user":  {},
+	}
+	for _, typ := range input.ResourceTypes {
+		input.OnResources(typ, resources[typ])
+	}
+	return &enumeration.EnumerateOutput{
+		Resources:   resources,
+		Diagnostics: diagnostic.Diagnostics{fakeDiagnostic{resourceType: "aws_iam_user"}},
+	}, nil
+}
+
+func (e *fakeEnumerator) Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+	e.refreshInput = input
+	return &enumeration.RefreshOutput{Resources: map[string][]*resource.Resource{
+		"aws_s3_bucket": {{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"bucket": "bucket", "acl": "private"}}},
+	}}, nil
+}
+
+func (e *fakeEnumerator) GetSchema() (*enumeration.GetSchemasOutput, error) {
+	return &enumeration.GetSchemasOutput{Schema: &terraform.ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"aws_s3_bucket": {
+				Attributes: map[string]*configschema.Attribute{
+					"bucket": {Type: cty.String, Required: true},
+				},
+				BlockTypes: map[string]*configschema.NestedBlock{
+					"logging": {
+						Nesting: configschema.NestingSet,
+						Block: configschema.Block{Attributes: map[string]*configschema.Attribute{
+							"target_bucket": {Type: cty.String, Optional: true},
+						}},
+					},
+				},
+			},
+		},
+		ResourceTypeSchemaVersions: map[string]uint64{"aws_s3_bucket": 1},
+	}}, nil
+}
+
+func newTestClient(t *testing.T, enumerator CloudEnumerator) pb.EnumeratorClient {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	NewServer(enumerator).Register(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewEnumeratorClient(conn)
+}
+
+func cmpDiff(expected, got interface{}) string {
+	return cmp.Diff(expected, got, protocmp.Transform())
+}
+
+func mustStruct(t *testing.T, m map[string]interface{}) *structpb.Struct {
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func TestServer_Enumerate(t *testing.T) {
+	client := newTestClient(t, &fakeEnumerator{})
+
+	stream, err := client.Enumerate(context.Background(), &pb.EnumerateRequest{ResourceTypes: []string{"aws_s3_bucket", "aws_iam_user"}})
+	require.NoError(t, err)
+
+	var responses []*pb.EnumerateResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		responses = append(responses, response)
+	}
+
+	expected := []*pb.EnumerateResponse{
+		{
+			ResourceType: "aws_s3_bucket",
+			Resources: []*pb.Resource{{
+				Id:         "bucket",
+				Type:       "aws_s3_bucket",
+				Attributes: mustStruct(t, map[string]interface{}{"bucket": "bucket", "tags": map[string]interface{}{"env": "prod"}}),
+			}},
+		},
+		{ResourceType: "aws_iam_user"},
+		{Diagnostics: []*pb.Diagnostic{{Code: "ACCESS_DENIED", Message: "access denied", ResourceType: "aws_iam_user"}}},
+	}
+	assert.Empty(t, cmpDiff(expected, responses))
+}
+
+func TestServer_EnumerateError(t *testing.T) {
+	client := newTestClient(t, &fakeEnumerator{err: errors.New("provider crashed")})
+
+	stream, err := client.Enumerate(context.Background(), &pb.EnumerateRequest{ResourceTypes: []string{"aws_s3_bucket"}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "provider crashed", status.Convert(err).Message())
+}
+
+func TestServer_Refresh(t *testing.T) {
+	enumerator := &fakeEnumerator{}
+	client := newTestClient(t, enumerator)
+
+	stream, err := client.Refresh(context.Background(), &pb.RefreshRequest{Resources: []*pb.Resource{
+		{Id: "bucket", Type: "aws_s3_bucket", Attributes: mustStruct(t, map[string]interface{}{"bucket": "bucket"})},
+	}})
+	require.NoError(t, err)
+
+	var responses []*pb.RefreshResponse
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		responses = append(responses, response)
+	}
+
+	assert.Equal(t, map[string][]*resource.Resource{
+		"aws_s3_bucket": {{Id: "bucket", Type: "aws_s3_bucket", Attrs: &resource.Attributes{"bucket": "bucket"}}},
+	}, enumerator.refreshInput.Resources)
+	expected := []*pb.RefreshResponse{
+		{
+			ResourceType: "aws_s3_bucket",
+			Resources: []*pb.Resource{{
+				Id:         "bucket",
+				Type:       "aws_s3_bucket",
+				Attributes: mustStruct(t, map[string]interface{}{"bucket": "bucket", "acl": "private"}),
+			}},
+		},
+		{Diagnostics: []*pb.Diagnostic{}},
+	}
+	assert.Empty(t, cmpDiff(expected, responses))
+}
+
+func TestServer_RefreshInvalidResource(t *testing.T) {
+	client := newTestClient(t, &fakeEnumerator{})
+
+	stream, err := client.Refresh(context.Background(), &pb.RefreshRequest{Resources: []*pb.Resource{{Id: "bucket"}}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetSchema(t *testing.T) {
+	client := newTestClient(t, &fakeEnumerator{})
+
+	response, err := client.GetSchema(context.Background(), &pb.GetSchemaRequest{})
+	require.NoError(t, err)
+
+	expected := &pb.GetSchemaResponse{Resources: []*pb.ResourceSchema{{
+		Type:    "aws_s3_bucket",
+		Version: 1,
+		Block: &pb.Block{
+			Attributes: []*pb.Attribute{{Name: "bucket", Type: []byte(`"string"`), Required: true}},
+			BlockTypes: []*pb.NestedBlock{{
+				TypeName: "logging",
+				Nesting:  pb.NestedBlock_SET,
+				Block: &pb.Block{
+					Attributes: []*pb.Attribute{{Name: "target_bucket", Type: []byte(`"string"`), Optional: true}},
+				},
+			}},
+		},
+	}}}
+	assert.Empty(t, cmpDiff(expected, response))
+}
@@ -1,10 +1,12 @@
 package lock
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/snyk/driftctl/enumeration/terraform"
 )
 
 type ProviderBlock struct {
@@ -14,8 +16,7 @@ type ProviderBlock struct {
 	Hashes      []string `hcl:"hashes,optional"`
 }
 
-// ProviderAddress encapsulates a single provider type. In the future this will be
-// extended to include additional fields including Namespace and SourceHost
+// ProviderAddress is the source address of a provider, e.g. registry.terraform.io/hashicorp/aws
 type ProviderAddress struct {
 	Type      string
 	Namespace string
@@ -26,6 +27,24 @@ func (p *ProviderAddress) String() string {
 	return strings.Join([]string{p.Hostname, p.Namespace, p.Type}, "/")
 }
 
+// ParseProviderAddress parses a source address using the terraform [HOSTNAME/]NAMESPACE/TYPE syntax,
+// the hostname defaults to the public terraform registry
+func ParseProviderAddress(source string) (*ProviderAddress, error) {
+	parts := strings.Split(source, "/")
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid provider source address %q, expected [HOSTNAME/]NAMESPACE/TYPE", source)
+		}
+	}
+	switch len(parts) {
+	case 2:
+		return &ProviderAddress{Hostname: terraform.DefaultRegistryHost, Namespace: parts[0], Type: parts[1]}, nil
+	case 3:
+		return &ProviderAddress{Hostname: parts[0], Namespace: parts[1], Type: parts[2]}, nil
+	}
+	return nil, fmt.Errorf("invalid provider source address %q, expected [HOSTNAME/]NAMESPACE/TYPE", source)
+}
+
 type Lockfile struct {
 	Providers []ProviderBlock `hcl:"provider,block"`
 }
@@ -39,6 +58,23 @@ func (l *Lockfile) GetProviderByAddress(addr *ProviderAddress) *ProviderBlock {
 	return nil
 }
 
+// GetProviderByType returns the only provider of the given type, whatever its hostname and namespace.
+// It returns nil if the lock file has no provider or several providers of that type.
+func (l *Lockfile) GetProviderByType(providerType string) *ProviderBlock {
+	var found *ProviderBlock
+	for i, p := range l.Providers {
+		addr, err := ParseProviderAddress(p.Address)
+		if err != nil || addr.Type != providerType {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = &l.Providers[i]
+	}
+	return found
+}
+
 func ReadLocksFromFile(filename string) (*Lockfile, error) {
 	var lock Lockfile
 
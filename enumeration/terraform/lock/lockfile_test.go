@@ -135,3 +135,58 @@ func Test_ReadLockFile(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetProviderByType(t *testing.T) {
+	locks, err := ReadLocksFromFile("testdata/lockfile_integrations.hcl")
+	assert.NoError(t, err)
+
+	provider := locks.GetProviderByType("github")
+	assert.NotNil(t, provider)
+	assert.Equal(t, "registry.terraform.io/integrations/github", provider.Address)
+	assert.Equal(t, "5.3.0", provider.Version)
+
+	// Several providers of the same type are ambiguous
+	assert.Nil(t, locks.GetProviderByType("aws"))
+	assert.Nil(t, locks.GetProviderByType("google"))
+}
+
+func Test_ParseProviderAddress(t *testing.T) {
+	cases := []struct {
+		source  string
+		want    *ProviderAddress
+		wantErr string
+	}{
+		{
+			source: "integrations/github",
+			want:   &ProviderAddress{Hostname: "registry.terraform.io", Namespace: "integrations", Type: "github"},
+		},
+		{
+			source: "registry.example.com/acme/aws",
+			want:   &ProviderAddress{Hostname: "registry.example.com", Namespace: "acme", Type: "aws"},
+		},
+		{
+			source:  "github",
+			wantErr: `invalid provider source address "github", expected [HOSTNAME/]NAMESPACE/TYPE`,
+		},
+		{
+			source:  "integrations/",
+			wantErr: `invalid provider source address "integrations/", expected [HOSTNAME/]NAMESPACE/TYPE`,
+		},
+		{
+			source:  "a/b/c/d",
+			wantErr: `invalid provider source address "a/b/c/d", expected [HOSTNAME/]NAMESPACE/TYPE`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.source, func(t *testing.T) {
+			got, err := ParseProviderAddress(c.source)
+			if c.wantErr != "" {
+				assert.EqualError(t, err, c.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+provider "registry.terraform.io/integrations/github" {
+    version     = "5.3.0"
+    constraints = "~> 5.3.0"
+    hashes = [
+        "h1:pFsKVGjnvAUu9Scqkk3M3Ke3sDf0SC4u3kOnc8/L8Kk=",
+    ]
+}
+
+provider "example.com/integrations/aws" {
+    version     = "4.0.0"
+}
+
+provider "registry.terraform.io/hashicorp/aws" {
+    version     = "3.47.0"
+}
@@ -5,28 +5,70 @@ import (
 	"runtime"
 )
 
+const (
+	// DefaultRegistryHost is the hostname of the public terraform registry
+	DefaultRegistryHost = "registry.terraform.io"
+	// DefaultNamespace is the namespace of providers published by HashiCorp
+	DefaultNamespace = "hashicorp"
+)
+
+// ProviderSource is the registry a provider is installed from, the hashicorp namespace of the public terraform registry if empty
+type ProviderSource struct {
+	Hostname  string
+	Namespace string
+}
+
 type ProviderConfig struct {
+	// Hostname is the registry host the provider is resolved from, the public terraform registry if empty
+	Hostname string
+	// Namespace is the registry namespace of the provider, hashicorp if empty
+	Namespace string
 	Key       string
 	Version   string
 	ConfigDir string
+	Mirror    ProviderMirror
+	// Hashes are the h1: and zh: hashes of the provider from the terraform lock file, if any
+	Hashes []string
 }
 
-func (c *ProviderConfig) GetDownloadUrl() string {
+// GetPlatform returns the os_arch pair of the provider build to install on this machine
+func (c *ProviderConfig) GetPlatform() string {
 	arch := runtime.GOARCH
 	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
 		arch = "amd64"
 	}
-	return fmt.Sprintf(
-		"https://releases.hashicorp.com/terraform-provider-%s/%s/terraform-provider-%s_%s_%s_%s.zip",
-		c.Key,
-		c.Version,
-		c.Key,
-		c.Version,
-		runtime.GOOS,
-		arch,
-	)
+	return fmt.Sprintf("%s_%s", runtime.GOOS, arch)
+}
+
+// GetArchiveName returns the name of the zip archive of the provider for the given os_arch platform
+func (c *ProviderConfig) GetArchiveName(platform string) string {
+	return fmt.Sprintf("terraform-provider-%s_%s_%s.zip", c.Key, c.Version, platform)
 }
 
 func (c *ProviderConfig) GetBinaryName() string {
 	return fmt.Sprintf("terraform-provider-%s_v%s", c.Key, c.Version)
 }
+
+func (c *ProviderConfig) GetHostname() string {
+	if c.Hostname == "" {
+		return DefaultRegistryHost
+	}
+	return c.Hostname
+}
+
+func (c *ProviderConfig) GetNamespace() string {
+	if c.Namespace == "" {
+		return DefaultNamespace
+	}
+	return c.Namespace
+}
+
+// GetAddress returns the source address of the provider, e.g. registry.terraform.io/hashicorp/aws
+func (c *ProviderConfig) GetAddress() string {
+	return fmt.Sprintf("%s/%s/%s", c.GetHostname(), c.GetNamespace(), c.Key)
+}
+
+// isOfficial returns true for providers published by HashiCorp on the public registry
+func (c *ProviderConfig) isOfficial() bool {
+	return c.GetHostname() == DefaultRegistryHost && c.GetNamespace() == DefaultNamespace
+}
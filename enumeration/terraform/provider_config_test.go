@@ -1,8 +1,6 @@
 package terraform
 
 import (
-	"fmt"
-	"runtime"
 	"testing"
 )
 
@@ -46,42 +44,32 @@ func TestProviderConfig_GetBinaryName(t *testing.T) {
 	}
 }
 
-func TestProviderConfig_GetDownloadUrl(t *testing.T) {
-	arch := runtime.GOARCH
-	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
-		arch = "amd64"
-	}
-	type fields struct {
-		Key     string
-		Version string
-		Postfix string
-	}
+func TestProviderConfig_GetAddress(t *testing.T) {
 	tests := []struct {
 		name   string
-		fields fields
+		config ProviderConfig
 		want   string
 	}{
 		{
-			name: "test for aws provider",
-			fields: fields{
-				Key:     "aws",
-				Version: "3.24.1",
-			},
-			want: fmt.Sprintf(
-				"https://releases.hashicorp.com/terraform-provider-aws/3.24.1/terraform-provider-aws_3.24.1_%s_%s.zip",
-				runtime.GOOS,
-				arch,
-			),
+			name:   "test for hashicorp provider",
+			config: ProviderConfig{Key: "aws"},
+			want:   "registry.terraform.io/hashicorp/aws",
+		},
+		{
+			name:   "test for partner provider",
+			config: ProviderConfig{Namespace: "integrations", Key: "github"},
+			want:   "registry.terraform.io/integrations/github",
+		},
+		{
+			name:   "test for private registry",
+			config: ProviderConfig{Hostname: "registry.example.com", Namespace: "acme", Key: "cloud"},
+			want:   "registry.example.com/acme/cloud",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := &ProviderConfig{
-				Key:     tt.fields.Key,
-				Version: tt.fields.Version,
-			}
-			if got := c.GetDownloadUrl(); got != tt.want {
-				t.Errorf("GetDownloadUrl() = %v, want %v", got, tt.want)
+			if got := tt.config.GetAddress(); got != tt.want {
+				t.Errorf("GetAddress() = %v, want %v", got, tt.want)
 			}
 		})
 	}
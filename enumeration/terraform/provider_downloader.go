@@ -14,7 +14,7 @@ import (
 )
 
 type ProviderDownloaderInterface interface {
-	Download(url, path string) error
+	Download(url, path string, verify func(archivePath string) error) error
 }
 
 type ProviderDownloader struct {
@@ -31,7 +31,8 @@ func NewProviderDownloader() *ProviderDownloader {
 	}
 }
 
-func (p *ProviderDownloader) Download(url, path string) error {
+// Download fetches the provider archive at url, checks it using verify and decompresses it into path
+func (p *ProviderDownloader) Download(url, path string, verify func(archivePath string) error) error {
 	logrus.WithFields(logrus.Fields{
 		"url":  url,
 		"path": path,
@@ -69,6 +70,9 @@ func (p *ProviderDownloader) Download(url, path string) error {
 	if err != nil {
 		return err
 	}
+	if err := verify(f.Name()); err != nil {
+		return err
+	}
 	logrus.WithFields(logrus.Fields{
 		"src": f.Name(),
 		"dst": path,
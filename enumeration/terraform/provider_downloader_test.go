@@ -26,6 +26,7 @@ func TestProviderDownloader_Download(t *testing.T) {
 		httpStatus *int
 		testFile   *string
 		responder  httpmock.Responder
+		verify     func(archivePath string) error
 		assert     func(assert *assert.Assertions, tmpDir string, err error)
 	}{
 		{
@@ -75,6 +76,19 @@ func TestProviderDownloader_Download(t *testing.T) {
 				assert.Equal([]byte{0x74, 0x65, 0x73, 0x74, 0xa}, file)
 			},
 		},
+		{
+			name:     "TestVerificationFailure",
+			testFile: aws.String("terraform-provider-aws_3.5.0_linux_amd64.zip"),
+			verify: func(archivePath string) error {
+				return fmt.Errorf("checksum mismatch")
+			},
+			assert: func(assert *assert.Assertions, tmpDir string, err error) {
+				assert.EqualError(err, "checksum mismatch")
+				infos, err := os.ReadDir(tmpDir)
+				assert.Nil(err)
+				assert.Len(infos, 0)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -101,7 +115,13 @@ func TestProviderDownloader_Download(t *testing.T) {
 				}
 			}
 
-			err := downloader.Download(url, tmpDir)
+			if c.verify == nil {
+				c.verify = func(archivePath string) error {
+					return nil
+				}
+			}
+
+			err := downloader.Download(url, tmpDir, c.verify)
 
 			c.assert(assert, tmpDir, err)
 		})
@@ -1,6 +1,7 @@
 package terraform
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"os"
@@ -21,6 +22,7 @@ type HomeDirInterface interface {
 
 type ProviderInstaller struct {
 	downloader ProviderDownloaderInterface
+	registry   *ProviderRegistry
 	config     ProviderConfig
 	homeDir    string
 }
@@ -28,6 +30,7 @@ type ProviderInstaller struct {
 func NewProviderInstaller(config ProviderConfig) (*ProviderInstaller, error) {
 	return &ProviderInstaller{
 		NewProviderDownloader(),
+		NewProviderRegistry(),
 		config,
 		config.ConfigDir,
 	}, nil
@@ -39,14 +42,25 @@ func (p *ProviderInstaller) Install() (string, error) {
 
 	info, err := os.Stat(providerPath)
 
+	if info != nil && !info.IsDir() {
+		if verifyErr := p.verifyInstalled(providerPath); verifyErr != nil {
+			logrus.WithFields(logrus.Fields{
+				"path":  providerPath,
+				"error": verifyErr,
+			}).Warn("Existing provider does not match the terraform lock file, installing it again")
+			if err := os.Remove(providerPath); err != nil {
+				return "", err
+			}
+			_ = os.Remove(installRecordPath(providerPath))
+			info, err = nil, os.ErrNotExist
+		}
+	}
+
 	if err != nil && os.IsNotExist(err) {
 		logrus.WithFields(logrus.Fields{
 			"path": providerPath,
 		}).Debug("provider not found, downloading ...")
-		err := p.downloader.Download(
-			p.config.GetDownloadUrl(),
-			providerDir,
-		)
+		verified, err := p.download(providerDir)
 		if err != nil {
 			if notFoundErr, ok := err.(error2.ProviderNotFoundError); ok {
 				notFoundErr.Version = p.config.Version
@@ -55,6 +69,14 @@ func (p *ProviderInstaller) Install() (string, error) {
 			return "", err
 		}
 		logrus.Debug("Download successful")
+		if verified != "" {
+			if err := writeInstallRecord(p.getBinaryPath(), verified); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"path":  p.getBinaryPath(),
+					"error": err,
+				}).Warn("Unable to record provider verification, it will be installed again on next run")
+			}
+		}
 	}
 
 	if info != nil && info.IsDir() {
@@ -73,6 +95,97 @@ func (p *ProviderInstaller) Install() (string, error) {
 	return p.getBinaryPath(), nil
 }
 
+// installRecord is written next to a provider binary once its package has been verified against the terraform lock file.
+// Lock file hashes cover the whole provider package while only its binary is kept in the plugins directory shared by
+// all providers, so the binary cannot be hashed again to verify it on next runs.
+type installRecord struct {
+	// Hash is the hash of the terraform lock file the package matched
+	Hash string `json:"hash"`
+	// Checksum is the SHA256 of the binary once installed
+	Checksum string `json:"checksum"`
+}
+
+func installRecordPath(providerPath string) string {
+	// Hidden so that it never gets mistaken for a binary with a postfix
+	return filepath.Join(filepath.Dir(providerPath), "."+filepath.Base(providerPath)+".json")
+}
+
+func writeInstallRecord(providerPath, hash string) error {
+	checksum, err := fileSHA256(providerPath)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(installRecord{Hash: hash, Checksum: checksum})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(installRecordPath(providerPath), content, 0644)
+}
+
+// verifyInstalled checks a provider binary installed by a previous run against the hashes of the terraform lock file.
+// Archives are verified when downloaded, but the binary could have been installed before a lock file existed or replaced since.
+func (p *ProviderInstaller) verifyInstalled(providerPath string) error {
+	if len(p.config.Hashes) == 0 {
+		return nil
+	}
+
+	content, err := os.ReadFile(installRecordPath(providerPath))
+	if err != nil {
+		return errors.Errorf("%s was not verified against the terraform lock file when installed", providerPath)
+	}
+	record := installRecord{}
+	if err := json.Unmarshal(content, &record); err != nil {
+		return errors.Wrapf(err, "invalid verification record for %s", providerPath)
+	}
+
+	checksum, err := fileSHA256(providerPath)
+	if err != nil {
+		return err
+	}
+	if checksum != record.Checksum {
+		return errors.Errorf("checksum mismatch for %s: got %s, expected %s", providerPath, checksum, record.Checksum)
+	}
+	for _, hash := range p.config.Hashes {
+		if hash == record.Hash {
+			logrus.WithFields(logrus.Fields{
+				"path": providerPath,
+				"hash": record.Hash,
+			}).Debug("Existing provider matches the terraform lock file")
+			return nil
+		}
+	}
+	return errors.Errorf("hash mismatch for %s: installed from a package matching %s, expected one of %s", providerPath, record.Hash, strings.Join(p.config.Hashes, ", "))
+}
+
+// download installs the provider into providerDir, it returns the hash of the terraform lock file the provider matched if any
+func (p *ProviderInstaller) download(providerDir string) (string, error) {
+	mirror := p.config.Mirror
+	if mirror.Directory != "" {
+		return mirror.installFromDirectory(p.config, providerDir)
+	}
+
+	platform := p.config.GetPlatform()
+	var verifier *ProviderVerifier
+	var url string
+	if mirror.URL != "" {
+		archive, err := mirror.getArchive(p.config, platform)
+		if err != nil {
+			return "", err
+		}
+		verifier, url = NewNetworkMirrorVerifier(p.config, platform, archive.Hashes), archive.Url
+	} else {
+		pkg, err := p.registry.GetPackage(p.config, platform)
+		if err != nil {
+			return "", err
+		}
+		verifier, url = NewRegistryVerifier(p.config, platform, pkg), pkg.DownloadUrl
+	}
+	if err := p.downloader.Download(url, providerDir, verifier.Verify); err != nil {
+		return "", err
+	}
+	return verifier.VerifiedHash(), nil
+}
+
 func (p ProviderInstaller) getProviderDirectory() string {
 	return path.Join(p.homeDir, fmt.Sprintf(".driftctl/plugins/%s_%s/", runtime.GOOS, runtime.GOARCH))
 }
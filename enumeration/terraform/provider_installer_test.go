@@ -1,15 +1,19 @@
 package terraform
 
 import (
+	"archive/zip"
 	"fmt"
 	terraformError "github.com/snyk/driftctl/enumeration/terraform/error"
+	"net/http"
 	"os"
 	"path"
 	"runtime"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	"github.com/snyk/driftctl/mocks"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/mod/sumdb/dirhash"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,16 +25,20 @@ func TestProviderInstallerInstallDoesNotExist(t *testing.T) {
 
 	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
 
+	registry := newTestRegistry(t)
 	config := ProviderConfig{
-		Key:     "aws",
-		Version: "3.19.0",
+		Hostname: registry.Hostname(),
+		Key:      "aws",
+		Version:  "3.19.0",
 	}
+	registry.AddPackage("hashicorp", "aws", "3.19.0", config.GetPlatform(), RegistryPackage{DownloadUrl: "aws.zip"})
 
 	mockDownloader := mocks.ProviderDownloaderInterface{}
-	mockDownloader.On("Download", config.GetDownloadUrl(), path.Join(fakeTmpHome, expectedSubFolder)).Return(nil)
+	mockDownloader.On("Download", registry.files.URL+"/aws.zip", path.Join(fakeTmpHome, expectedSubFolder), mock.Anything).Return(nil)
 
 	installer := ProviderInstaller{
 		downloader: &mockDownloader,
+		registry:   registry.Registry(),
 		config:     config,
 		homeDir:    fakeTmpHome,
 	}
@@ -43,6 +51,39 @@ func TestProviderInstallerInstallDoesNotExist(t *testing.T) {
 
 }
 
+func TestProviderInstallerInstallFromNamespace(t *testing.T) {
+
+	assert := assert.New(t)
+	fakeTmpHome := t.TempDir()
+
+	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
+
+	registry := newTestRegistry(t)
+	config := ProviderConfig{
+		Hostname:  registry.Hostname(),
+		Namespace: "integrations",
+		Key:       "github",
+		Version:   "5.3.0",
+	}
+	registry.AddPackage("integrations", "github", "5.3.0", config.GetPlatform(), RegistryPackage{DownloadUrl: "github.zip"})
+
+	mockDownloader := mocks.ProviderDownloaderInterface{}
+	mockDownloader.On("Download", registry.files.URL+"/github.zip", path.Join(fakeTmpHome, expectedSubFolder), mock.Anything).Return(nil)
+
+	installer := ProviderInstaller{
+		downloader: &mockDownloader,
+		registry:   registry.Registry(),
+		config:     config,
+		homeDir:    fakeTmpHome,
+	}
+
+	providerPath, err := installer.Install()
+	mockDownloader.AssertExpectations(t)
+
+	assert.Nil(err)
+	assert.Equal(path.Join(fakeTmpHome, expectedSubFolder, config.GetBinaryName()), providerPath)
+}
+
 func TestProviderInstallerInstallAlreadyExist(t *testing.T) {
 
 	assert := assert.New(t)
@@ -79,6 +120,182 @@ func TestProviderInstallerInstallAlreadyExist(t *testing.T) {
 
 }
 
+func TestProviderInstallerInstallAlreadyExistIsVerified(t *testing.T) {
+
+	assert := assert.New(t)
+	fakeTmpHome := t.TempDir()
+	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
+	providerDir := path.Join(fakeTmpHome, expectedSubFolder)
+	if err := os.MkdirAll(providerDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// h1: hash of the package, as written in terraform lock files
+	hash, err := dirhash.HashZip("./testdata/terraform-provider-aws_3.5.0_linux_amd64.zip", dirhash.Hash1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.5.0",
+		Mirror:  ProviderMirror{Directory: t.TempDir()},
+		Hashes:  []string{hash},
+	}
+	archiveDir := path.Join(config.Mirror.Directory, "registry.terraform.io/hashicorp/aws")
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := os.ReadFile("./testdata/terraform-provider-aws_3.5.0_linux_amd64.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(archiveDir, config.GetArchiveName(config.GetPlatform())), archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Other providers share the plugins directory and must not be part of the hash
+	if err := os.WriteFile(path.Join(providerDir, "terraform-provider-github_v4.0.0"), []byte("github"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	mockDownloader := mocks.ProviderDownloaderInterface{}
+	installer := ProviderInstaller{
+		downloader: &mockDownloader,
+		config:     config,
+		homeDir:    fakeTmpHome,
+	}
+
+	// Provider is installed from the mirror
+	providerPath, err := installer.Install()
+	assert.Nil(err)
+	assert.Equal(path.Join(providerDir, "terraform-provider-aws_v3.5.0_x5"), providerPath)
+	installed, err := os.ReadFile(providerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Existing provider matching the lock file is kept
+	if err := os.RemoveAll(config.Mirror.Directory); err != nil {
+		t.Fatal(err)
+	}
+	providerPath, err = installer.Install()
+	assert.Nil(err)
+	assert.Equal(path.Join(providerDir, "terraform-provider-aws_v3.5.0_x5"), providerPath)
+
+	// Existing provider not matching the lock file is installed again
+	if err := os.WriteFile(providerPath, []byte("tampered"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	_, err = installer.Install()
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "not found in mirror directory")
+	_, err = os.Stat(providerPath)
+	assert.True(os.IsNotExist(err))
+
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(archiveDir, config.GetArchiveName(config.GetPlatform())), archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+	providerPath, err = installer.Install()
+	assert.Nil(err)
+	reinstalled, err := os.ReadFile(providerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(installed, reinstalled)
+	mockDownloader.AssertExpectations(t)
+}
+
+func TestProviderInstallerInstallAlreadyExistWithPackageFilesIsVerified(t *testing.T) {
+
+	assert := assert.New(t)
+	fakeTmpHome := t.TempDir()
+	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
+	providerDir := path.Join(fakeTmpHome, expectedSubFolder)
+
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.5.0",
+		Mirror:  ProviderMirror{Directory: t.TempDir()},
+	}
+	archiveDir := path.Join(config.Mirror.Directory, "registry.terraform.io/hashicorp/aws")
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Provider packages usually ship more than the binary
+	archivePath := path.Join(archiveDir, config.GetArchiveName(config.GetPlatform()))
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := zip.NewWriter(archive)
+	for name, content := range map[string]string{
+		"terraform-provider-aws_v3.5.0_x5": "provider",
+		"LICENSE":                          "license",
+		"CHANGELOG.md":                     "changelog",
+	} {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := dirhash.HashZip(archivePath, dirhash.Hash1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockDownloader := mocks.ProviderDownloaderInterface{}
+	installer := ProviderInstaller{
+		downloader: &mockDownloader,
+		config:     config,
+		homeDir:    fakeTmpHome,
+	}
+
+	// Provider installed before a lock file existed is installed again
+	providerPath, err := installer.Install()
+	assert.Nil(err)
+	assert.Equal(path.Join(providerDir, "terraform-provider-aws_v3.5.0_x5"), providerPath)
+	if err := os.WriteFile(providerPath, []byte("tampered"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	installer.config.Hashes = []string{hash}
+	providerPath, err = installer.Install()
+	assert.Nil(err)
+	installed, err := os.ReadFile(providerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal("provider", string(installed))
+
+	// Existing provider verified when installed is kept, even if its package contained other files
+	if err := os.RemoveAll(config.Mirror.Directory); err != nil {
+		t.Fatal(err)
+	}
+	providerPath, err = installer.Install()
+	assert.Nil(err)
+	assert.Equal(path.Join(providerDir, "terraform-provider-aws_v3.5.0_x5"), providerPath)
+
+	// Existing provider verified against another lock file is installed again
+	installer.config.Hashes = []string{"h1:foo"}
+	_, err = installer.Install()
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "not found in mirror directory")
+	mockDownloader.AssertExpectations(t)
+}
+
 func TestProviderInstallerInstallAlreadyExistButIsDirectory(t *testing.T) {
 
 	assert := assert.New(t)
@@ -160,16 +377,20 @@ func TestProviderInstallerVersionDoesNotExist(t *testing.T) {
 
 	assert := assert.New(t)
 
+	registry := newTestRegistry(t)
 	config := ProviderConfig{
-		Key:     "aws",
-		Version: "666.666.666",
+		Hostname: registry.Hostname(),
+		Key:      "aws",
+		Version:  "666.666.666",
 	}
+	registry.AddPackage("hashicorp", "aws", "666.666.666", config.GetPlatform(), RegistryPackage{DownloadUrl: "aws.zip"})
 
 	mockDownloader := mocks.ProviderDownloaderInterface{}
-	mockDownloader.On("Download", mock.Anything, mock.Anything).Return(terraformError.ProviderNotFoundError{})
+	mockDownloader.On("Download", mock.Anything, mock.Anything, mock.Anything).Return(terraformError.ProviderNotFoundError{})
 
 	installer := ProviderInstaller{
 		downloader: &mockDownloader,
+		registry:   registry.Registry(),
 		config:     config,
 	}
 
@@ -185,17 +406,21 @@ func TestProviderInstallerWithConfigDirectory(t *testing.T) {
 
 	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
 
+	registry := newTestRegistry(t)
 	config := ProviderConfig{
+		Hostname:  registry.Hostname(),
 		Key:       "aws",
 		Version:   "3.19.0",
 		ConfigDir: fakeTmpHome,
 	}
+	registry.AddPackage("hashicorp", "aws", "3.19.0", config.GetPlatform(), RegistryPackage{DownloadUrl: "aws.zip"})
 
 	mockDownloader := mocks.ProviderDownloaderInterface{}
-	mockDownloader.On("Download", config.GetDownloadUrl(), path.Join(fakeTmpHome, expectedSubFolder)).Return(nil)
+	mockDownloader.On("Download", registry.files.URL+"/aws.zip", path.Join(fakeTmpHome, expectedSubFolder), mock.Anything).Return(nil)
 
 	installer, _ := NewProviderInstaller(config)
 	installer.downloader = &mockDownloader
+	installer.registry = registry.Registry()
 
 	providerPath, err := installer.Install()
 	mockDownloader.AssertExpectations(t)
@@ -204,3 +429,94 @@ func TestProviderInstallerWithConfigDirectory(t *testing.T) {
 	assert.Equal(path.Join(fakeTmpHome, expectedSubFolder, config.GetBinaryName()), providerPath)
 
 }
+
+func TestProviderInstallerWithMirrorDirectory(t *testing.T) {
+
+	assert := assert.New(t)
+	fakeTmpHome := t.TempDir()
+	mirrorDir := t.TempDir()
+
+	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
+
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.5.0",
+		Mirror:  ProviderMirror{Directory: mirrorDir},
+	}
+
+	archive, err := os.ReadFile("./testdata/terraform-provider-aws_3.5.0_linux_amd64.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	archiveDir := path.Join(mirrorDir, "registry.terraform.io/hashicorp/aws")
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(archiveDir, config.GetArchiveName(config.GetPlatform())), archive, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	mockDownloader := mocks.ProviderDownloaderInterface{}
+
+	installer := ProviderInstaller{
+		downloader: &mockDownloader,
+		config:     config,
+		homeDir:    fakeTmpHome,
+	}
+
+	providerPath, err := installer.Install()
+	mockDownloader.AssertExpectations(t)
+
+	assert.Nil(err)
+	assert.Equal(path.Join(fakeTmpHome, expectedSubFolder, "terraform-provider-aws_v3.5.0_x5"), providerPath)
+
+	// Provider in the mirror should match the terraform lock file
+	config.Hashes = []string{"h1:foo"}
+	installer.config = config
+	installer.homeDir = t.TempDir()
+	_, err = installer.Install()
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "provider registry.terraform.io/hashicorp/aws 3.5.0 in mirror directory does not match the hashes of the terraform lock file")
+}
+
+func TestProviderInstallerWithNetworkMirror(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	assert := assert.New(t)
+	fakeTmpHome := t.TempDir()
+
+	expectedSubFolder := fmt.Sprintf("/.driftctl/plugins/%s_%s", runtime.GOOS, runtime.GOARCH)
+
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.19.0",
+		Mirror:  ProviderMirror{URL: "https://mirror.example.com/providers/"},
+	}
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/providers/registry.terraform.io/hashicorp/aws/3.19.0.json",
+		httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"archives": {"%s": {"url": "%s"}}}`, config.GetPlatform(), config.GetArchiveName(config.GetPlatform()))),
+	)
+
+	mockDownloader := mocks.ProviderDownloaderInterface{}
+	mockDownloader.On(
+		"Download",
+		"https://mirror.example.com/providers/registry.terraform.io/hashicorp/aws/"+config.GetArchiveName(config.GetPlatform()),
+		path.Join(fakeTmpHome, expectedSubFolder),
+		mock.Anything,
+	).Return(nil)
+
+	installer := ProviderInstaller{
+		downloader: &mockDownloader,
+		config:     config,
+		homeDir:    fakeTmpHome,
+	}
+
+	providerPath, err := installer.Install()
+	mockDownloader.AssertExpectations(t)
+
+	assert.Nil(err)
+	assert.Equal(path.Join(fakeTmpHome, expectedSubFolder, config.GetBinaryName()), providerPath)
+}
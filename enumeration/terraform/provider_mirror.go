@@ -0,0 +1,246 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tferror "github.com/snyk/driftctl/enumeration/terraform/error"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ProviderMirror configures where providers are installed from instead of releases.hashicorp.com
+type ProviderMirror struct {
+	// Directory is a local mirror using the packed or unpacked layout of terraform -plugin-dir and plugin_cache_dir
+	Directory string
+	// URL is the base URL of a server implementing the terraform provider network mirror protocol
+	URL string
+}
+
+type networkMirrorArchive struct {
+	Url    string   `json:"url"`
+	Hashes []string `json:"hashes"`
+}
+
+type networkMirrorVersion struct {
+	Archives map[string]networkMirrorArchive `json:"archives"`
+}
+
+// findInDirectory looks for the provider in the local mirror directory.
+// It returns the path of either the unpacked provider binary or the packed zip archive.
+func (m ProviderMirror) findInDirectory(config ProviderConfig) (path string, packed bool, err error) {
+	platform := config.GetPlatform()
+	providerDir := filepath.Join(m.Directory, filepath.FromSlash(config.GetAddress()))
+
+	// Unpacked layout: HOSTNAME/NAMESPACE/TYPE/VERSION/TARGET/terraform-provider-TYPE_vVERSION[_xN]
+	unpackedDir := filepath.Join(providerDir, config.Version, platform)
+	if entries, err := os.ReadDir(unpackedDir); err == nil {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasPrefix(entry.Name(), config.GetBinaryName()) {
+				return filepath.Join(unpackedDir, entry.Name()), false, nil
+			}
+		}
+	}
+
+	// Packed layout: HOSTNAME/NAMESPACE/TYPE/terraform-provider-TYPE_VERSION_TARGET.zip
+	archivePath := filepath.Join(providerDir, config.GetArchiveName(platform))
+	if _, err := os.Stat(archivePath); err == nil {
+		return archivePath, true, nil
+	}
+
+	return "", false, errors.Errorf(
+		"provider %s %s (%s) not found in mirror directory %s",
+		config.GetAddress(),
+		config.Version,
+		platform,
+		m.Directory,
+	)
+}
+
+// installFromDirectory copies or unpacks the provider found in the local mirror into dst,
+// it returns the hash of the terraform lock file the provider matched if any
+func (m ProviderMirror) installFromDirectory(config ProviderConfig, dst string) (string, error) {
+	src, packed, err := m.findInDirectory(config)
+	if err != nil {
+		return "", err
+	}
+
+	var verified string
+	// Local mirrors have no signed checksums, so we can only rely on the lock file to trust their content
+	if len(config.Hashes) == 0 {
+		logrus.WithFields(logrus.Fields{
+			"provider": config.GetAddress(),
+			"version":  config.Version,
+			"path":     src,
+		}).Warn("Installing provider from mirror directory without verifying it, use a terraform lock file with the hashes of the provider to check its integrity")
+	} else {
+		hashed := src
+		if !packed {
+			hashed = filepath.Dir(src)
+		}
+		verified, err = matchHashes(hashed, config.Hashes)
+		if err != nil {
+			return "", errors.Wrapf(err, "provider %s %s in mirror directory does not match the hashes of the terraform lock file", config.GetAddress(), config.Version)
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"src": src,
+		"dst": dst,
+	}).Debug("Installing provider from mirror directory")
+
+	if packed {
+		unzip := getter.ZipDecompressor{}
+		return verified, unzip.Decompress(dst, src, true, 0)
+	}
+
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return "", err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(filepath.Join(dst, filepath.Base(src)), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return verified, err
+}
+
+// getArchive asks the network mirror where the provider archive for the given platform can be downloaded,
+// and which hashes it should match
+func (m ProviderMirror) getArchive(config ProviderConfig, platform string) (*networkMirrorArchive, error) {
+	versionUrl := fmt.Sprintf("%s/%s/%s.json", strings.TrimSuffix(m.URL, "/"), config.GetAddress(), config.Version)
+
+	logrus.WithFields(logrus.Fields{
+		"url": versionUrl,
+	}).Debug("Looking up provider in network mirror")
+
+	resp, err := http.Get(versionUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, tferror.ProviderNotFoundError{Version: config.Version}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unsuccessful request to %s: %s", versionUrl, resp.Status)
+	}
+
+	version := networkMirrorVersion{}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return nil, errors.Wrapf(err, "invalid response from %s", versionUrl)
+	}
+
+	archive, exist := version.Archives[platform]
+	if !exist {
+		return nil, errors.Errorf(
+			"provider %s %s is not available for %s in network mirror %s",
+			config.GetAddress(),
+			config.Version,
+			platform,
+			m.URL,
+		)
+	}
+
+	base, err := url.Parse(versionUrl)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := url.Parse(archive.Url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid archive url from %s", versionUrl)
+	}
+	archive.Url = base.ResolveReference(ref).String()
+	return &archive, nil
+}
+
+// MirrorProvider downloads the provider archive for the given os_arch platform into a local mirror directory,
+// using the packed layout of terraform -plugin-dir. Archives already present in the directory are kept.
+func MirrorProvider(config ProviderConfig, directory, platform string) (string, error) {
+	archivePath := filepath.Join(directory, filepath.FromSlash(config.GetAddress()), config.GetArchiveName(platform))
+	if _, err := os.Stat(archivePath); err == nil {
+		if err := verifyHashes(archivePath, config.Hashes); err != nil {
+			return "", errors.Wrap(err, "mirrored provider does not match the hashes of the terraform lock file")
+		}
+		logrus.WithFields(logrus.Fields{
+			"path": archivePath,
+		}).Debug("Provider already mirrored")
+		return archivePath, nil
+	}
+
+	var downloadUrl string
+	var verifier *ProviderVerifier
+	if config.Mirror.URL != "" {
+		archive, err := config.Mirror.getArchive(config, platform)
+		if err != nil {
+			return "", err
+		}
+		downloadUrl = archive.Url
+		verifier = NewNetworkMirrorVerifier(config, platform, archive.Hashes)
+	} else {
+		pkg, err := NewProviderRegistry().GetPackage(config, platform)
+		if err != nil {
+			return "", err
+		}
+		downloadUrl = pkg.DownloadUrl
+		verifier = NewRegistryVerifier(config, platform, pkg)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"url":  downloadUrl,
+		"path": archivePath,
+	}).Debug("Mirroring provider")
+
+	resp, err := http.Get(downloadUrl)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound {
+		return "", tferror.ProviderNotFoundError{Version: config.Version}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("unsuccessful request to %s: %s", downloadUrl, resp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(archivePath), 0755); err != nil {
+		return "", err
+	}
+	// Write to a temporary file first so an interrupted download never leaves a truncated archive in the mirror
+	tmp, err := os.CreateTemp(filepath.Dir(archivePath), "terraform-provider-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(tmp, resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = verifier.Verify(tmp.Name())
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), archivePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return archivePath, nil
+}
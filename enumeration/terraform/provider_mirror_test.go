@@ -0,0 +1,207 @@
+package terraform
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"testing"
+
+	terraformError "github.com/snyk/driftctl/enumeration/terraform/error"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderMirror_findInDirectory(t *testing.T) {
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.19.0",
+	}
+	platform := config.GetPlatform()
+
+	cases := []struct {
+		name       string
+		files      []string
+		wantPath   string
+		wantPacked bool
+		wantErr    bool
+	}{
+		{
+			name:     "unpacked layout",
+			files:    []string{fmt.Sprintf("registry.terraform.io/hashicorp/aws/3.19.0/%s/terraform-provider-aws_v3.19.0_x5", platform)},
+			wantPath: fmt.Sprintf("registry.terraform.io/hashicorp/aws/3.19.0/%s/terraform-provider-aws_v3.19.0_x5", platform),
+		},
+		{
+			name:       "packed layout",
+			files:      []string{fmt.Sprintf("registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.19.0_%s.zip", platform)},
+			wantPath:   fmt.Sprintf("registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.19.0_%s.zip", platform),
+			wantPacked: true,
+		},
+		{
+			name: "other version",
+			files: []string{
+				fmt.Sprintf("registry.terraform.io/hashicorp/aws/3.20.0/%s/terraform-provider-aws_v3.20.0_x5", platform),
+				"registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.19.0_plan9_amd64.zip",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, file := range c.files {
+				if err := os.MkdirAll(path.Dir(path.Join(dir, file)), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path.Join(dir, file), []byte{}, 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, packed, err := ProviderMirror{Directory: dir}.findInDirectory(config)
+			if c.wantErr {
+				assert.EqualError(t, err, fmt.Sprintf("provider registry.terraform.io/hashicorp/aws 3.19.0 (%s) not found in mirror directory %s", platform, dir))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, path.Join(dir, c.wantPath), got)
+			assert.Equal(t, c.wantPacked, packed)
+		})
+	}
+}
+
+func TestProviderMirror_getArchive(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.19.0",
+	}
+	versionUrl := "https://mirror.example.com/registry.terraform.io/hashicorp/aws/3.19.0.json"
+
+	cases := []struct {
+		name      string
+		responder httpmock.Responder
+		want      *networkMirrorArchive
+		wantErr   error
+	}{
+		{
+			name:      "relative archive url",
+			responder: httpmock.NewStringResponder(http.StatusOK, `{"archives": {"linux_amd64": {"url": "terraform-provider-aws_3.19.0_linux_amd64.zip", "hashes": ["h1:foo"]}}}`),
+			want: &networkMirrorArchive{
+				Url:    "https://mirror.example.com/registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.19.0_linux_amd64.zip",
+				Hashes: []string{"h1:foo"},
+			},
+		},
+		{
+			name:      "absolute archive url",
+			responder: httpmock.NewStringResponder(http.StatusOK, `{"archives": {"linux_amd64": {"url": "https://cdn.example.com/aws.zip"}}}`),
+			want:      &networkMirrorArchive{Url: "https://cdn.example.com/aws.zip"},
+		},
+		{
+			name:      "unknown version",
+			responder: httpmock.NewStringResponder(http.StatusNotFound, ""),
+			wantErr:   terraformError.ProviderNotFoundError{Version: "3.19.0"},
+		},
+		{
+			name:      "missing platform",
+			responder: httpmock.NewStringResponder(http.StatusOK, `{"archives": {"darwin_amd64": {"url": "terraform-provider-aws_3.19.0_darwin_amd64.zip"}}}`),
+			wantErr:   fmt.Errorf("provider registry.terraform.io/hashicorp/aws 3.19.0 is not available for linux_amd64 in network mirror https://mirror.example.com"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("GET", versionUrl, c.responder)
+
+			got, err := ProviderMirror{URL: "https://mirror.example.com"}.getArchive(config, "linux_amd64")
+			if c.wantErr != nil {
+				assert.EqualError(t, err, c.wantErr.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestMirrorProvider(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	dir := t.TempDir()
+	config := ProviderConfig{
+		Key:     "aws",
+		Version: "3.5.0",
+		Mirror:  ProviderMirror{URL: "https://mirror.example.com"},
+	}
+
+	body, err := os.ReadFile("./testdata/terraform-provider-aws_3.5.0_linux_amd64.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/aws/3.5.0.json",
+		httpmock.NewStringResponder(http.StatusOK, `{"archives": {
+			"linux_amd64": {"url": "terraform-provider-aws_3.5.0_linux_amd64.zip", "hashes": ["zh:2db5345840993edb9bd17ba5715f6bcdca87613dc150b9590f2da14d34aa5b52"]},
+			"darwin_amd64": {"url": "terraform-provider-aws_3.5.0_darwin_amd64.zip", "hashes": ["zh:0000000000000000000000000000000000000000000000000000000000000000"]}
+		}}`),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.5.0_linux_amd64.zip",
+		httpmock.NewBytesResponder(http.StatusOK, body),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.5.0_darwin_amd64.zip",
+		httpmock.NewBytesResponder(http.StatusOK, body),
+	)
+
+	archivePath, err := MirrorProvider(config, dir, "linux_amd64")
+	assert.Nil(t, err)
+	assert.Equal(t, path.Join(dir, "registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.5.0_linux_amd64.zip"), archivePath)
+	content, err := os.ReadFile(archivePath)
+	assert.Nil(t, err)
+	assert.Equal(t, body, content)
+
+	// Archive is already mirrored, it should not be downloaded again
+	_, err = MirrorProvider(config, dir, "linux_amd64")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	// Already mirrored archive should still match the lock file
+	config.Hashes = []string{"h1:foo"}
+	_, err = MirrorProvider(config, dir, "linux_amd64")
+	assert.EqualError(t, err, fmt.Sprintf("mirrored provider does not match the hashes of the terraform lock file: hash mismatch for %s: got h1:7Ca6K4lpDjeZE6QeTlna6tjY0tgrtODWO6KXgoAplgM=, expected one of h1:foo", archivePath))
+	config.Hashes = nil
+
+	// Archive not matching the hashes of the network mirror should not be mirrored
+	_, err = MirrorProvider(config, dir, "darwin_amd64")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "provider registry.terraform.io/hashicorp/aws 3.5.0 (darwin_amd64) does not match the hashes of the network mirror")
+	_, err = os.Stat(path.Join(dir, "registry.terraform.io/hashicorp/aws/terraform-provider-aws_3.5.0_darwin_amd64.zip"))
+	assert.True(t, os.IsNotExist(err))
+	files, _ := os.ReadDir(path.Join(dir, "registry.terraform.io/hashicorp/aws"))
+	assert.Len(t, files, 1)
+
+	config.Mirror = ProviderMirror{}
+	config.Version = "0.0.1"
+	httpmock.RegisterResponder(
+		"GET",
+		"https://registry.terraform.io/.well-known/terraform.json",
+		httpmock.NewStringResponder(http.StatusOK, `{"providers.v1": "/v1/providers/"}`),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://registry.terraform.io/v1/providers/hashicorp/aws/versions",
+		httpmock.NewStringResponder(http.StatusOK, `{"versions": [{"version": "3.5.0", "platforms": [{"os": "linux", "arch": "amd64"}]}]}`),
+	)
+	_, err = MirrorProvider(config, dir, "linux_amd64")
+	assert.Equal(t, terraformError.ProviderNotFoundError{Version: "0.0.1"}, err)
+}
@@ -0,0 +1,227 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	tferror "github.com/snyk/driftctl/enumeration/terraform/error"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// registryProvidersService is the service of the registry protocol used to discover providers, see
+	// https://www.terraform.io/docs/internals/provider-registry-protocol.html
+	registryProvidersService = "providers.v1"
+	// registryProtocolVersion is the major version of the terraform plugin protocol driftctl talks to providers with
+	registryProtocolVersion = "5"
+)
+
+type registryVersions struct {
+	Versions []registryVersion `json:"versions"`
+}
+
+type registryVersion struct {
+	Version   string             `json:"version"`
+	Protocols []string           `json:"protocols"`
+	Platforms []registryPlatform `json:"platforms"`
+}
+
+type registryPlatform struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+type registrySigningKeys struct {
+	GPGPublicKeys []struct {
+		KeyID      string `json:"key_id"`
+		ASCIIArmor string `json:"ascii_armor"`
+	} `json:"gpg_public_keys"`
+}
+
+// RegistryPackage describes where to download a provider archive from, and how to verify it
+type RegistryPackage struct {
+	Protocols           []string            `json:"protocols"`
+	OS                  string              `json:"os"`
+	Arch                string              `json:"arch"`
+	Filename            string              `json:"filename"`
+	DownloadUrl         string              `json:"download_url"`
+	ShasumsUrl          string              `json:"shasums_url"`
+	ShasumsSignatureUrl string              `json:"shasums_signature_url"`
+	Shasum              string              `json:"shasum"`
+	SigningKeys         registrySigningKeys `json:"signing_keys"`
+}
+
+// ProviderRegistry resolves providers from any registry host implementing the terraform provider registry protocol:
+// service discovery, then available versions, then the download endpoint of the requested version and platform
+type ProviderRegistry struct {
+	httpclient *http.Client
+	mu         sync.Mutex
+	services   map[string]*url.URL
+}
+
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{
+		httpclient: http.DefaultClient,
+		services:   map[string]*url.URL{},
+	}
+}
+
+// GetPackage returns the package of the configured provider version for the given os_arch platform
+func (r *ProviderRegistry) GetPackage(config ProviderConfig, platform string) (*RegistryPackage, error) {
+	baseUrl, err := r.discover(config.GetHostname())
+	if err != nil {
+		return nil, err
+	}
+
+	versionsUrl := resolveUrl(baseUrl, fmt.Sprintf("%s/%s/versions", config.GetNamespace(), config.Key))
+	versions := registryVersions{}
+	if err := r.getJSON(versionsUrl, &versions); err != nil {
+		return nil, withVersion(err, config.Version)
+	}
+	version, err := findVersion(versions, config, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadUrl := resolveUrl(baseUrl, fmt.Sprintf("%s/%s/%s/download/%s", config.GetNamespace(), config.Key, version.Version, strings.Replace(platform, "_", "/", 1)))
+	pkg := RegistryPackage{}
+	if err := r.getJSON(downloadUrl, &pkg); err != nil {
+		return nil, withVersion(err, config.Version)
+	}
+	if !supportsProtocol(pkg.Protocols) {
+		return nil, errors.Errorf(
+			"provider %s %s does not support plugin protocol version %s (supported: %s)",
+			config.GetAddress(),
+			config.Version,
+			registryProtocolVersion,
+			strings.Join(pkg.Protocols, ", "),
+		)
+	}
+
+	// Urls returned by the registry may be relative to the download endpoint
+	for _, u := range []*string{&pkg.DownloadUrl, &pkg.ShasumsUrl, &pkg.ShasumsSignatureUrl} {
+		if *u == "" {
+			continue
+		}
+		ref, err := url.Parse(*u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid url returned by %s", downloadUrl)
+		}
+		*u = downloadUrl.ResolveReference(ref).String()
+	}
+	if pkg.DownloadUrl == "" {
+		return nil, errors.Errorf("no download url returned by %s", downloadUrl)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"provider": config.GetAddress(),
+		"version":  config.Version,
+		"url":      pkg.DownloadUrl,
+	}).Debug("Found provider in registry")
+
+	return &pkg, nil
+}
+
+// discover returns the base url of the providers service of the given registry host
+func (r *ProviderRegistry) discover(hostname string) (*url.URL, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if service, exist := r.services[hostname]; exist {
+		return service, nil
+	}
+
+	discoveryUrl := &url.URL{Scheme: "https", Host: hostname, Path: "/.well-known/terraform.json"}
+	services := map[string]interface{}{}
+	if err := r.getJSON(discoveryUrl, &services); err != nil {
+		return nil, errors.Wrapf(err, "unable to discover services of registry %s", hostname)
+	}
+	service, ok := services[registryProvidersService].(string)
+	if !ok {
+		return nil, errors.Errorf("registry %s does not support %s", hostname, registryProvidersService)
+	}
+	ref, err := url.Parse(service)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s service url for registry %s", registryProvidersService, hostname)
+	}
+	// The base url must end with a slash so that paths are resolved below it
+	if !strings.HasSuffix(ref.Path, "/") {
+		ref.Path += "/"
+	}
+	serviceUrl := discoveryUrl.ResolveReference(ref)
+	r.services[hostname] = serviceUrl
+
+	return serviceUrl, nil
+}
+
+func (r *ProviderRegistry) getJSON(u *url.URL, v interface{}) error {
+	logrus.WithFields(logrus.Fields{
+		"url": u.String(),
+	}).Debug("Querying provider registry")
+
+	resp, err := r.httpclient.Get(u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return tferror.ProviderNotFoundError{}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unsuccessful request to %s: %s", u, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrapf(err, "invalid response from %s", u)
+	}
+	return nil
+}
+
+func findVersion(versions registryVersions, config ProviderConfig, platform string) (*registryVersion, error) {
+	for _, version := range versions.Versions {
+		if version.Version != config.Version {
+			continue
+		}
+		for _, p := range version.Platforms {
+			if fmt.Sprintf("%s_%s", p.OS, p.Arch) == platform {
+				return &version, nil
+			}
+		}
+		return nil, errors.Errorf(
+			"provider %s %s is not available for %s",
+			config.GetAddress(),
+			config.Version,
+			platform,
+		)
+	}
+	return nil, tferror.ProviderNotFoundError{Version: config.Version}
+}
+
+func supportsProtocol(protocols []string) bool {
+	// Registries are not required to return protocols on the download endpoint
+	if len(protocols) == 0 {
+		return true
+	}
+	for _, protocol := range protocols {
+		if strings.SplitN(protocol, ".", 2)[0] == registryProtocolVersion {
+			return true
+		}
+	}
+	return false
+}
+
+func withVersion(err error, version string) error {
+	if notFoundErr, ok := err.(tferror.ProviderNotFoundError); ok {
+		notFoundErr.Version = version
+		return notFoundErr
+	}
+	return err
+}
+
+func resolveUrl(base *url.URL, path string) *url.URL {
+	return base.ResolveReference(&url.URL{Path: path})
+}
@@ -0,0 +1,305 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	terraformError "github.com/snyk/driftctl/enumeration/terraform/error"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testRegistry is a local stand-in for a provider registry.
+// The registry api is served over TLS as required by the protocol, archives and checksums by a separate file server.
+type testRegistry struct {
+	api      *httptest.Server
+	files    *httptest.Server
+	packages map[string]RegistryPackage
+	content  map[string][]byte
+	requests []string
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	r := &testRegistry{
+		packages: map[string]RegistryPackage{},
+		content:  map[string][]byte{},
+	}
+	r.api = httptest.NewTLSServer(http.HandlerFunc(r.serveApi))
+	r.files = httptest.NewServer(http.HandlerFunc(r.serveFiles))
+	t.Cleanup(r.api.Close)
+	t.Cleanup(r.files.Close)
+	return r
+}
+
+func (r *testRegistry) Hostname() string {
+	return strings.TrimPrefix(r.api.URL, "https://")
+}
+
+// Registry returns a client trusting the certificate of the stand-in
+func (r *testRegistry) Registry() *ProviderRegistry {
+	registry := NewProviderRegistry()
+	registry.httpclient = r.api.Client()
+	return registry
+}
+
+// AddPackage publishes a provider archive, pkg urls are relative to the file server
+func (r *testRegistry) AddPackage(namespace, key, version, platform string, pkg RegistryPackage) {
+	for _, u := range []*string{&pkg.DownloadUrl, &pkg.ShasumsUrl, &pkg.ShasumsSignatureUrl} {
+		if *u != "" && !strings.Contains(*u, "://") {
+			*u = r.files.URL + "/" + *u
+		}
+	}
+	r.packages[fmt.Sprintf("%s/%s/%s/download/%s", namespace, key, version, strings.Replace(platform, "_", "/", 1))] = pkg
+}
+
+func (r *testRegistry) AddFile(name string, content []byte) {
+	r.content["/"+name] = content
+}
+
+func (r *testRegistry) serveApi(w http.ResponseWriter, req *http.Request) {
+	r.requests = append(r.requests, req.URL.Path)
+	if req.URL.Path == "/.well-known/terraform.json" {
+		_, _ = w.Write([]byte(`{"providers.v1": "/v1/providers/"}`))
+		return
+	}
+	p := strings.TrimPrefix(req.URL.Path, "/v1/providers/")
+
+	if strings.HasSuffix(p, "/versions") {
+		provider := strings.TrimSuffix(p, "versions")
+		versions := map[string]*registryVersion{}
+		result := registryVersions{}
+		for key := range r.packages {
+			if !strings.HasPrefix(key, provider) {
+				continue
+			}
+			parts := strings.Split(strings.TrimPrefix(key, provider), "/")
+			version, exist := versions[parts[0]]
+			if !exist {
+				version = &registryVersion{Version: parts[0], Protocols: []string{"5.0"}}
+				versions[parts[0]] = version
+			}
+			version.Platforms = append(version.Platforms, registryPlatform{OS: parts[2], Arch: parts[3]})
+		}
+		if len(versions) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for _, version := range versions {
+			result.Versions = append(result.Versions, *version)
+		}
+		_ = json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	pkg, exist := r.packages[p]
+	if !exist {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(pkg)
+}
+
+func (r *testRegistry) serveFiles(w http.ResponseWriter, req *http.Request) {
+	content, exist := r.content[req.URL.Path]
+	if !exist {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+func TestProviderRegistry_GetPackage(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.AddPackage("integrations", "github", "4.4.0", "linux_amd64", RegistryPackage{
+		Protocols:   []string{"5.0"},
+		OS:          "linux",
+		Arch:        "amd64",
+		Filename:    "terraform-provider-github_4.4.0_linux_amd64.zip",
+		DownloadUrl: "terraform-provider-github_4.4.0_linux_amd64.zip",
+		ShasumsUrl:  "terraform-provider-github_4.4.0_SHA256SUMS",
+		Shasum:      "1234",
+	})
+	registry.AddPackage("integrations", "github", "6.0.0", "linux_amd64", RegistryPackage{
+		Protocols:   []string{"6.0"},
+		DownloadUrl: "terraform-provider-github_6.0.0_linux_amd64.zip",
+	})
+
+	cases := []struct {
+		name     string
+		config   ProviderConfig
+		platform string
+		want     *RegistryPackage
+		wantErr  error
+	}{
+		{
+			name:     "existing package",
+			config:   ProviderConfig{Hostname: registry.Hostname(), Namespace: "integrations", Key: "github", Version: "4.4.0"},
+			platform: "linux_amd64",
+			want: &RegistryPackage{
+				Protocols:   []string{"5.0"},
+				OS:          "linux",
+				Arch:        "amd64",
+				Filename:    "terraform-provider-github_4.4.0_linux_amd64.zip",
+				DownloadUrl: registry.files.URL + "/terraform-provider-github_4.4.0_linux_amd64.zip",
+				ShasumsUrl:  registry.files.URL + "/terraform-provider-github_4.4.0_SHA256SUMS",
+				Shasum:      "1234",
+			},
+		},
+		{
+			name:     "unknown provider",
+			config:   ProviderConfig{Hostname: registry.Hostname(), Namespace: "hashicorp", Key: "github", Version: "4.4.0"},
+			platform: "linux_amd64",
+			wantErr:  terraformError.ProviderNotFoundError{Version: "4.4.0"},
+		},
+		{
+			name:     "unknown version",
+			config:   ProviderConfig{Hostname: registry.Hostname(), Namespace: "integrations", Key: "github", Version: "4.5.0"},
+			platform: "linux_amd64",
+			wantErr:  terraformError.ProviderNotFoundError{Version: "4.5.0"},
+		},
+		{
+			name:     "unsupported platform",
+			config:   ProviderConfig{Hostname: registry.Hostname(), Namespace: "integrations", Key: "github", Version: "4.4.0"},
+			platform: "darwin_arm64",
+			wantErr:  fmt.Errorf("provider %s/integrations/github 4.4.0 is not available for darwin_arm64", registry.Hostname()),
+		},
+		{
+			name:     "unsupported protocol",
+			config:   ProviderConfig{Hostname: registry.Hostname(), Namespace: "integrations", Key: "github", Version: "6.0.0"},
+			platform: "linux_amd64",
+			wantErr:  fmt.Errorf("provider %s/integrations/github 6.0.0 does not support plugin protocol version 5 (supported: 6.0)", registry.Hostname()),
+		},
+	}
+
+	client := registry.Registry()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := client.GetPackage(c.config, c.platform)
+			if c.wantErr != nil {
+				assert.EqualError(t, err, c.wantErr.Error())
+				if _, ok := c.wantErr.(terraformError.ProviderNotFoundError); ok {
+					assert.IsType(t, c.wantErr, err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+
+	// Services should be discovered once per registry host
+	discoveries := 0
+	for _, request := range registry.requests {
+		if request == "/.well-known/terraform.json" {
+			discoveries++
+		}
+	}
+	assert.Equal(t, 1, discoveries)
+}
+
+func TestProviderRegistry_Discover(t *testing.T) {
+	cases := []struct {
+		name     string
+		response string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "relative service url",
+			response: `{"providers.v1": "/v1/providers"}`,
+			want:     "%s/v1/providers/",
+		},
+		{
+			name:     "absolute service url",
+			response: `{"providers.v1": "https://providers.example.com/v1/"}`,
+			want:     "https://providers.example.com/v1/",
+		},
+		{
+			name:     "no providers service",
+			response: `{"modules.v1": "/v1/modules/"}`,
+			wantErr:  "registry %s does not support providers.v1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(c.response))
+			}))
+			defer server.Close()
+			hostname := strings.TrimPrefix(server.URL, "https://")
+
+			registry := NewProviderRegistry()
+			registry.httpclient = server.Client()
+			got, err := registry.discover(hostname)
+			if c.wantErr != "" {
+				assert.EqualError(t, err, fmt.Sprintf(c.wantErr, hostname))
+				return
+			}
+			assert.Nil(t, err)
+			want := c.want
+			if strings.Contains(want, "%s") {
+				want = fmt.Sprintf(want, server.URL)
+			}
+			assert.Equal(t, want, got.String())
+		})
+	}
+}
+
+func TestProviderInstallerFromRegistry(t *testing.T) {
+	archive, err := os.ReadFile(testArchive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, publicKey := newTestKey(t)
+	checksums := testArchiveSHA256 + "  terraform-provider-aws_3.5.0_linux_amd64.zip\n"
+
+	registry := newTestRegistry(t)
+	registry.AddFile("terraform-provider-aws_3.5.0_linux_amd64.zip", archive)
+	registry.AddFile("terraform-provider-aws_3.5.0_SHA256SUMS", []byte(checksums))
+	registry.AddFile("terraform-provider-aws_3.5.0_SHA256SUMS.sig", sign(t, signer, checksums))
+	pkg := RegistryPackage{
+		Protocols:           []string{"5.0"},
+		Filename:            "terraform-provider-aws_3.5.0_linux_amd64.zip",
+		DownloadUrl:         "terraform-provider-aws_3.5.0_linux_amd64.zip",
+		ShasumsUrl:          "terraform-provider-aws_3.5.0_SHA256SUMS",
+		ShasumsSignatureUrl: "terraform-provider-aws_3.5.0_SHA256SUMS.sig",
+		Shasum:              testArchiveSHA256,
+	}
+	pkg.SigningKeys.GPGPublicKeys = append(pkg.SigningKeys.GPGPublicKeys, struct {
+		KeyID      string `json:"key_id"`
+		ASCIIArmor string `json:"ascii_armor"`
+	}{KeyID: signer.PrimaryKey.KeyIdString(), ASCIIArmor: publicKey})
+	registry.AddPackage("acme", "aws", "3.5.0", "linux_amd64", pkg)
+
+	config := ProviderConfig{
+		Hostname:  registry.Hostname(),
+		Namespace: "acme",
+		Key:       "aws",
+		Version:   "3.5.0",
+	}
+	if config.GetPlatform() != "linux_amd64" {
+		t.Skip("test registry only publishes a linux_amd64 archive")
+	}
+
+	homeDir := t.TempDir()
+	installer := ProviderInstaller{
+		downloader: NewProviderDownloader(),
+		registry:   registry.Registry(),
+		config:     config,
+		homeDir:    homeDir,
+	}
+	providerPath, err := installer.Install()
+	assert.Nil(t, err)
+	assert.Equal(t, path.Join(installer.getProviderDirectory(), "terraform-provider-aws_v3.5.0_x5"), providerPath)
+
+	// Providers published by HashiCorp must be signed with the HashiCorp key, whatever keys the registry returns
+	assert.Equal(t, []string{publicKey}, NewRegistryVerifier(config, "linux_amd64", &pkg).keys)
+	assert.Equal(t, []string{hashicorpPublicKey}, NewRegistryVerifier(ProviderConfig{Key: "aws", Version: "3.5.0"}, "linux_amd64", &pkg).keys)
+}
@@ -0,0 +1,231 @@
+package terraform
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+const (
+	testArchive        = "./testdata/terraform-provider-aws_3.5.0_linux_amd64.zip"
+	testArchiveSHA256  = "2db5345840993edb9bd17ba5715f6bcdca87613dc150b9590f2da14d34aa5b52"
+	testArchiveHash1   = "h1:7Ca6K4lpDjeZE6QeTlna6tjY0tgrtODWO6KXgoAplgM="
+	testChecksumsUrl   = "https://releases.hashicorp.com/terraform-provider-aws/3.5.0/terraform-provider-aws_3.5.0_SHA256SUMS"
+	testSignatureUrl   = testChecksumsUrl + ".sig"
+	testOtherChecksums = "0000000000000000000000000000000000000000000000000000000000000000  terraform-provider-aws_3.5.0_darwin_amd64.zip\n"
+)
+
+func newTestKey(t *testing.T) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("driftctl", "test", "test@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return entity, buf.String()
+}
+
+func sign(t *testing.T, entity *openpgp.Entity, content string) []byte {
+	signature := &bytes.Buffer{}
+	if err := openpgp.DetachSign(signature, entity, bytes.NewBufferString(content), nil); err != nil {
+		t.Fatal(err)
+	}
+	return signature.Bytes()
+}
+
+func TestProviderVerifier_Verify(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	signer, publicKey := newTestKey(t)
+	other, _ := newTestKey(t)
+
+	checksums := testOtherChecksums + testArchiveSHA256 + "  terraform-provider-aws_3.5.0_linux_amd64.zip\n"
+
+	cases := []struct {
+		name         string
+		checksums    string
+		signature    []byte
+		platform     string
+		mirrorHashes []string
+		lockHashes   []string
+		shasum       string
+		release      bool
+		wantErr      string
+	}{
+		{
+			name:      "valid signed checksums",
+			checksums: checksums,
+			signature: sign(t, signer, checksums),
+			platform:  "linux_amd64",
+			release:   true,
+		},
+		{
+			name:      "checksums signed by another key",
+			checksums: checksums,
+			signature: sign(t, other, checksums),
+			platform:  "linux_amd64",
+			release:   true,
+			wantErr:   fmt.Sprintf("invalid signature for %s: openpgp: signature made by unknown entity", testChecksumsUrl),
+		},
+		{
+			name:      "tampered checksums",
+			checksums: checksums,
+			signature: sign(t, signer, testOtherChecksums),
+			platform:  "linux_amd64",
+			release:   true,
+			wantErr:   fmt.Sprintf("invalid signature for %s: openpgp: invalid signature: hash tag doesn't match", testChecksumsUrl),
+		},
+		{
+			name:      "checksum mismatch",
+			checksums: testOtherChecksums,
+			signature: sign(t, signer, testOtherChecksums),
+			platform:  "darwin_amd64",
+			release:   true,
+			wantErr:   "checksum mismatch for terraform-provider-aws_3.5.0_darwin_amd64.zip: expected 0000000000000000000000000000000000000000000000000000000000000000, got " + testArchiveSHA256,
+		},
+		{
+			name:      "checksum announced by the registry not matching signed checksums",
+			checksums: checksums,
+			signature: sign(t, signer, checksums),
+			platform:  "linux_amd64",
+			shasum:    "1234",
+			release:   true,
+			wantErr:   "checksum mismatch for terraform-provider-aws_3.5.0_linux_amd64.zip: registry announced 1234, signed checksums file lists " + testArchiveSHA256,
+		},
+		{
+			name:      "archive missing from checksums",
+			checksums: testOtherChecksums,
+			signature: sign(t, signer, testOtherChecksums),
+			platform:  "linux_amd64",
+			release:   true,
+			wantErr:   fmt.Sprintf("invalid checksums file %s: no checksum found for terraform-provider-aws_3.5.0_linux_amd64.zip", testChecksumsUrl),
+		},
+		{
+			name:       "valid signed checksums and lock file hashes",
+			checksums:  checksums,
+			signature:  sign(t, signer, checksums),
+			platform:   "linux_amd64",
+			release:    true,
+			lockHashes: []string{"h1:foo", testArchiveHash1},
+		},
+		{
+			name:       "valid signed checksums not matching lock file hashes",
+			checksums:  checksums,
+			signature:  sign(t, signer, checksums),
+			platform:   "linux_amd64",
+			release:    true,
+			lockHashes: []string{"h1:foo", "zh:bar"},
+			wantErr: fmt.Sprintf(
+				"provider registry.terraform.io/hashicorp/aws 3.5.0 (linux_amd64) does not match the hashes of the terraform lock file: hash mismatch for %s: got %s, expected one of h1:foo, zh:bar",
+				testArchive, testArchiveHash1+", zh:"+testArchiveSHA256,
+			),
+		},
+		{
+			name:         "matching network mirror hashes",
+			platform:     "linux_amd64",
+			mirrorHashes: []string{"zh:" + testArchiveSHA256},
+			lockHashes:   []string{testArchiveHash1},
+		},
+		{
+			name:         "network mirror hashes mismatch",
+			platform:     "linux_amd64",
+			mirrorHashes: []string{"zh:bar"},
+			wantErr: fmt.Sprintf(
+				"provider registry.terraform.io/hashicorp/aws 3.5.0 (linux_amd64) does not match the hashes of the network mirror: hash mismatch for %s: got zh:%s, expected one of zh:bar",
+				testArchive, testArchiveSHA256,
+			),
+		},
+		{
+			name:       "unsupported lock file hashes are ignored",
+			platform:   "linux_amd64",
+			lockHashes: []string{"h0:foo"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			httpmock.Reset()
+			httpmock.RegisterResponder("GET", testChecksumsUrl, httpmock.NewStringResponder(http.StatusOK, c.checksums))
+			httpmock.RegisterResponder("GET", testSignatureUrl, httpmock.NewBytesResponder(http.StatusOK, c.signature))
+
+			config := ProviderConfig{
+				Key:     "aws",
+				Version: "3.5.0",
+				Hashes:  c.lockHashes,
+			}
+			var verifier *ProviderVerifier
+			if c.release {
+				verifier = NewRegistryVerifier(config, c.platform, &RegistryPackage{
+					Filename:            config.GetArchiveName(c.platform),
+					ShasumsUrl:          testChecksumsUrl,
+					ShasumsSignatureUrl: testSignatureUrl,
+					Shasum:              c.shasum,
+				})
+				verifier.keys = []string{publicKey}
+			} else {
+				verifier = NewNetworkMirrorVerifier(config, c.platform, c.mirrorHashes)
+			}
+
+			err := verifier.Verify(testArchive)
+			if c.wantErr != "" {
+				assert.EqualError(t, err, c.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestProviderVerifier_MissingSignature(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", testChecksumsUrl, httpmock.NewStringResponder(http.StatusOK, testOtherChecksums))
+	httpmock.RegisterResponder("GET", testSignatureUrl, httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	config := ProviderConfig{Key: "aws", Version: "3.5.0"}
+	pkg := &RegistryPackage{
+		Filename:            "terraform-provider-aws_3.5.0_linux_amd64.zip",
+		ShasumsUrl:          testChecksumsUrl,
+		ShasumsSignatureUrl: testSignatureUrl,
+	}
+	err := NewRegistryVerifier(config, "linux_amd64", pkg).Verify(testArchive)
+	assert.EqualError(t, err, fmt.Sprintf("unsuccessful request to %s: 404", testSignatureUrl))
+
+	pkg.ShasumsSignatureUrl = ""
+	err = NewRegistryVerifier(config, "linux_amd64", pkg).Verify(testArchive)
+	assert.EqualError(t, err, "no signed checksums available for provider registry.terraform.io/hashicorp/aws 3.5.0 (linux_amd64)")
+}
+
+func TestVerifyHashes_UnpackedDirectory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(path.Join(dir, "terraform-provider-aws_v3.5.0_x5"), []byte("test\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The content of an unpacked provider has the same h1: hash as its archive
+	assert.Nil(t, verifyHashes(dir, []string{"zh:" + testArchiveSHA256, testArchiveHash1}))
+	assert.EqualError(
+		t,
+		verifyHashes(dir, []string{"zh:" + testArchiveSHA256, "h1:foo"}),
+		fmt.Sprintf("hash mismatch for %s: got %s, expected one of h1:foo", dir, testArchiveHash1),
+	)
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of driftctl spans
+const TracerName = "github.com/snyk/driftctl"
+
+// Attribute keys set on driftctl spans
+const (
+	ProviderKey       = attribute.Key("driftctl.provider")
+	ResourceTypeKey   = attribute.Key("driftctl.resource.type")
+	ResourceIdKey     = attribute.Key("driftctl.resource.id")
+	ResourceCountKey  = attribute.Key("driftctl.resource.count")
+	SourceKey         = attribute.Key("driftctl.iac.source")
+	MiddlewareKey     = attribute.Key("driftctl.middleware")
+	RemoteCountKey    = attribute.Key("driftctl.remote.count")
+	StateCountKey     = attribute.Key("driftctl.state.count")
+	ManagedCountKey   = attribute.Key("driftctl.analysis.managed")
+	UnmanagedCountKey = attribute.Key("driftctl.analysis.unmanaged")
+	DeletedCountKey   = attribute.Key("driftctl.analysis.deleted")
+	DriftedCountKey   = attribute.Key("driftctl.analysis.drifted")
+)
+
+// Start starts a span using the global tracer provider, spans are not recorded unless a provider has been registered
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error if any and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
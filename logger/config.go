@@ -24,5 +24,9 @@ func getConfig() Config {
 		config.Level = level
 	}
 
+	if viper.GetString("log_format") == FormatJSON {
+		config.Formatter = &logrus.JSONFormatter{}
+	}
+
 	return config
 }
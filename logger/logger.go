@@ -6,6 +6,11 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
 type Config struct {
 	Level        logrus.Level
 	Formatter    logrus.Formatter
@@ -9,13 +9,13 @@ type ProviderDownloaderInterface struct {
 	mock.Mock
 }
 
-// Download provides a mock function with given fields: url, path
-func (_m *ProviderDownloaderInterface) Download(url string, path string) error {
-	ret := _m.Called(url, path)
+// Download provides a mock function with given fields: url, path, verify
+func (_m *ProviderDownloaderInterface) Download(url string, path string, verify func(string) error) error {
+	ret := _m.Called(url, path, verify)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(url, path)
+	if rf, ok := ret.Get(0).(func(string, string, func(string) error) error); ok {
+		r0 = rf(url, path, verify)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/remote/common"
 
 	"github.com/r3labs/diff/v2"
 	"github.com/snyk/driftctl/enumeration/resource"
@@ -43,6 +45,8 @@ type Analysis struct {
 	options         AnalyzerOptions
 	summary         Summary
 	alerts          alerter.Alerts
+	attributions    map[string]common.Attribution
+	profile         *profile.Report
 	Duration        time.Duration
 	Date            time.Time
 	ProviderName    string
@@ -63,6 +67,8 @@ type serializableAnalysis struct {
 	Differences     []serializableDifference               `json:"differences"`
 	Coverage        int                                    `json:"coverage"`
 	Alerts          map[string][]alerter.SerializableAlert `json:"alerts"`
+	Attributions    map[string]common.Attribution          `json:"attributions,omitempty"`
+	Profile         *profile.Report                        `json:"profile,omitempty"`
 	ProviderName    string                                 `json:"provider_name"`
 	ProviderVersion string                                 `json:"provider_version"`
 	ScanDuration    uint                                   `json:"scan_duration,omitempty"`
@@ -106,6 +112,10 @@ func (a Analysis) MarshalJSON() ([]byte, error) {
 			}
 		}
 	}
+	if len(a.attributions) > 0 {
+		bla.Attributions = a.attributions
+	}
+	bla.Profile = a.profile
 	bla.Summary = a.summary
 	bla.Coverage = a.Coverage()
 	bla.ProviderName = a.ProviderName
@@ -171,6 +181,10 @@ func (a *Analysis) UnmarshalJSON(bytes []byte) error {
 			}
 		}
 	}
+	if len(bla.Attributions) > 0 {
+		a.attributions = bla.Attributions
+	}
+	a.profile = bla.Profile
 	a.ProviderName = bla.ProviderName
 	a.ProviderVersion = bla.ProviderVersion
 	a.SetIaCSourceCount(bla.Summary.TotalIaCSourceCount)
@@ -215,6 +229,39 @@ func (a *Analysis) SetAlerts(alerts alerter.Alerts) {
 	a.alerts = alerts
 }
 
+// AddAlert records an alert raised after the analysis, once the alerter has been drained
+func (a *Analysis) AddAlert(key string, alert alerter.Alert) {
+	if a.alerts == nil {
+		a.alerts = make(alerter.Alerts)
+	}
+	a.alerts[key] = append(a.alerts[key], alert)
+}
+
+// AddAttribution records who made the last change on a given resource
+func (a *Analysis) AddAttribution(res *resource.Resource, attribution common.Attribution) {
+	if a.attributions == nil {
+		a.attributions = make(map[string]common.Attribution)
+	}
+	a.attributions[attributionKey(res)] = attribution
+}
+
+// Attribution returns who made the last change on a given resource, if known
+func (a *Analysis) Attribution(res *resource.Resource) *common.Attribution {
+	attribution, exist := a.attributions[attributionKey(res)]
+	if !exist {
+		return nil
+	}
+	return &attribution
+}
+
+func (a *Analysis) SetProfile(report *profile.Report) {
+	a.profile = report
+}
+
+func (a *Analysis) Profile() *profile.Report {
+	return a.profile
+}
+
 func (a *Analysis) SetOptions(options AnalyzerOptions) {
 	a.options = options
 }
@@ -316,6 +363,10 @@ func SortChanges(changes []Change) []Change {
 	return changes
 }
 
+func attributionKey(res *resource.Resource) string {
+	return fmt.Sprintf("%s.%s", res.ResourceType(), res.ResourceId())
+}
+
 func escapeKey(line string) string {
 	line = strings.ReplaceAll(line, `\`, `\\`)
 	line = strings.ReplaceAll(line, `.`, `\.`)
@@ -9,6 +9,7 @@ import (
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
 
 	alerter2 "github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/profile"
 
 	"github.com/snyk/driftctl/pkg/filter"
 	"github.com/stretchr/testify/mock"
@@ -1337,6 +1338,10 @@ func TestAnalysis_MarshalJSON(t *testing.T) {
 	})
 	analysis.ProviderName = "AWS"
 	analysis.ProviderVersion = "2.18.5"
+	profiler := profile.NewProfiler()
+	profiler.Record(profile.Enumeration, "aws_iam_access_key", 1500*time.Millisecond, 3, nil)
+	profiler.Record(profile.DetailsFetching, "aws_iam_access_key", 200*time.Millisecond, 1, nil)
+	analysis.SetProfile(profiler.Report())
 
 	got, err := json.MarshalIndent(analysis, "", "\t")
 	if err != nil {
@@ -1423,6 +1428,11 @@ func TestAnalysis_UnmarshalJSON(t *testing.T) {
 				},
 			},
 		},
+		profile: &profile.Report{
+			Enumeration: []profile.TypeProfile{
+				{ResourceType: "aws_iam_access_key", DurationMs: 1500, Calls: 1, ApiCalls: 3},
+			},
+		},
 		ProviderName:    "AWS",
 		ProviderVersion: "2.18.5",
 		Date:            time.Date(2022, 4, 8, 10, 35, 0, 0, time.UTC),
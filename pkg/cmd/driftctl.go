@@ -4,8 +4,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/build"
+	"github.com/snyk/driftctl/logger"
 	"github.com/snyk/driftctl/pkg"
 	"github.com/snyk/driftctl/sentry"
 	"github.com/spf13/cobra"
@@ -45,6 +47,9 @@ func NewDriftctlCmd(build build.BuildInterface) *DriftctlCmd {
 				if err != nil {
 					return err
 				}
+				if err := handleLogFormat(cmd); err != nil {
+					return err
+				}
 				return handleReporting(cmd)
 			},
 			Long:          "Detect, track and alert on infrastructure drift.",
@@ -66,11 +71,14 @@ func NewDriftctlCmd(build build.BuildInterface) *DriftctlCmd {
 		cmd.PersistentFlags().BoolP("no-version-check", "", false, "Disable the version check")
 		cmd.PersistentFlags().BoolP("disable-telemetry", "", false, "Disable telemetry")
 	}
+	cmd.PersistentFlags().String("log-format", logger.FormatText, "Format of the logs written to stderr, text or json")
 	cmd.PersistentFlags().BoolP("send-crash-report", "", false, "Enable error reporting. Crash data will be sent to us via Sentry.\nWARNING: may leak sensitive data (please read the documentation for more details)\nThis flag should be used only if an error occurs during execution")
 
 	cmd.AddCommand(NewScanCmd(&pkg.ScanOptions{}))
 	cmd.AddCommand(NewFmtCmd(&pkg.FmtOptions{}))
 	cmd.AddCommand(NewGenDriftIgnoreCmd())
+	cmd.AddCommand(NewProvidersCmd())
+	cmd.AddCommand(NewServeCmd())
 
 	return cmd
 }
@@ -108,6 +116,22 @@ func handleReporting(cmd *cobra.Command) error {
 	return nil
 }
 
+func handleLogFormat(cmd *cobra.Command) error {
+	format, err := cmd.Flags().GetString("log-format")
+	if err != nil {
+		return nil
+	}
+	if format != logger.FormatText && format != logger.FormatJSON {
+		return errors.Errorf("Invalid log format %s, expected %s or %s", format, logger.FormatText, logger.FormatJSON)
+	}
+	// Logger is initialized before flags are parsed, so it has to be initialized again when the format comes from a flag
+	if viper.GetString("log_format") != format {
+		viper.Set("log_format", format)
+		logger.Init()
+	}
+	return nil
+}
+
 // Iterate over command flags
 // If the command flag is not manually set (f.Changed) we override its value
 // from the according env value
@@ -150,6 +150,7 @@ func TestDriftctlCmd_Invalid(t *testing.T) {
 		{args: []string{"test"}, expected: `unknown command "test" for "driftctl"`},
 		{args: []string{"-t"}, expected: `unknown shorthand flag: 't' in -t`},
 		{args: []string{"--test"}, expected: `unknown flag: --test`},
+		{args: []string{"scan", "--log-format", "xml"}, expected: `Invalid log format xml, expected text or json`},
 	}
 
 	for _, tt := range cases {
@@ -3,8 +3,11 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/resource"
 	cmderrors "github.com/snyk/driftctl/pkg/cmd/errors"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
 	"github.com/snyk/driftctl/pkg/iac/config"
@@ -179,3 +182,35 @@ func parseOutputFlag(out string) (*output.OutputConfig, error) {
 
 	return o, nil
 }
+
+// parseCacheTTLFlag parses values of the cache-ttl flag.
+// A bare duration (e.g. 1h) sets the TTL of every cached value,
+// <resource type>=<duration> (e.g. aws_s3_bucket=10m) overrides it for a given resource type.
+func parseCacheTTLFlag(values []string) (cache.TTLConfig, error) {
+	ttl := cache.TTLConfig{}
+	for _, value := range values {
+		typeDuration := strings.SplitN(value, "=", 2)
+		if len(typeDuration) == 1 {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return ttl, errors.Errorf("Unable to parse cache TTL '%s', expected a duration (e.g. 1h) or <resource type>=<duration> (e.g. aws_s3_bucket=10m)", value)
+			}
+			ttl.Default = duration
+			continue
+		}
+
+		ty := typeDuration[0]
+		if !resource.IsResourceTypeSupported(ty) {
+			return ttl, errors.Errorf("Unsupported resource type '%s' in cache TTL '%s'", ty, value)
+		}
+		duration, err := time.ParseDuration(typeDuration[1])
+		if err != nil {
+			return ttl, errors.Errorf("Unable to parse cache TTL '%s', expected a duration (e.g. 1h) or <resource type>=<duration> (e.g. aws_s3_bucket=10m)", value)
+		}
+		if ttl.ByType == nil {
+			ttl.ByType = map[string]time.Duration{}
+		}
+		ttl.ByType[ty] = duration
+	}
+	return ttl, nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/snyk/driftctl/enumeration/remote/cache"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
 	"github.com/snyk/driftctl/pkg/iac/config"
 )
@@ -233,3 +235,66 @@ func Test_parseOutputFlag(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseCacheTTLFlag(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   cache.TTLConfig
+		err    error
+	}{
+		{
+			name:   "test empty cache ttl",
+			values: []string{},
+			want:   cache.TTLConfig{},
+		},
+		{
+			name:   "test default cache ttl",
+			values: []string{"1h"},
+			want:   cache.TTLConfig{Default: time.Hour},
+		},
+		{
+			name:   "test cache ttl by resource type",
+			values: []string{"1h", "aws_s3_bucket=10m", "aws_instance=0"},
+			want: cache.TTLConfig{
+				Default: time.Hour,
+				ByType: map[string]time.Duration{
+					"aws_s3_bucket": 10 * time.Minute,
+					"aws_instance":  0,
+				},
+			},
+		},
+		{
+			name:   "test invalid duration",
+			values: []string{"foo"},
+			err:    fmt.Errorf("Unable to parse cache TTL 'foo', expected a duration (e.g. 1h) or <resource type>=<duration> (e.g. aws_s3_bucket=10m)"),
+		},
+		{
+			name:   "test invalid duration for resource type",
+			values: []string{"aws_s3_bucket=foo"},
+			err:    fmt.Errorf("Unable to parse cache TTL 'aws_s3_bucket=foo', expected a duration (e.g. 1h) or <resource type>=<duration> (e.g. aws_s3_bucket=10m)"),
+		},
+		{
+			name:   "test unsupported resource type",
+			values: []string{"aws_foobar=1h"},
+			err:    fmt.Errorf("Unsupported resource type 'aws_foobar' in cache TTL 'aws_foobar=1h'"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCacheTTLFlag(tt.values)
+			if tt.err != nil {
+				if err == nil || err.Error() != tt.err.Error() {
+					t.Fatalf("got error = '%v', expected '%v'", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error '%v'", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseCacheTTLFlag() got = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/terraform/lock"
+	"github.com/spf13/cobra"
+)
+
+type providersMirrorOptions struct {
+	To              []string
+	ProviderVersion string
+	ProviderSource  string
+	LockfilePath    string
+	Platforms       []string
+	NetworkMirror   string
+}
+
+func NewProvidersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "Manage the terraform providers used by driftctl",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewProvidersMirrorCmd())
+
+	return cmd
+}
+
+func NewProvidersMirrorCmd() *cobra.Command {
+	opts := &providersMirrorOptions{}
+	defaultPlatform := (&terraform.ProviderConfig{}).GetPlatform()
+
+	cmd := &cobra.Command{
+		Use:   "mirror <directory>",
+		Short: "Download terraform providers into a local mirror directory",
+		Long: "This command will download the terraform providers used by driftctl into a directory, " +
+			"so they can be installed without internet access using 'driftctl scan --provider-mirror <directory>'\n\n" +
+			"Example: driftctl providers mirror --to aws+tf ./providers",
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			for _, to := range opts.To {
+				if !remote.IsSupported(to) {
+					return errors.Errorf(
+						"unsupported cloud provider '%s'\nValid values are: %s",
+						to,
+						strings.Join(remote.GetSupportedRemotes(), ","),
+					)
+				}
+			}
+			if err := validateTfProviderVersionString(opts.ProviderVersion); err != nil {
+				return err
+			}
+			if opts.ProviderVersion != "" && len(opts.To) != 1 {
+				return errors.New("--tf-provider-version can only be used with a single cloud provider")
+			}
+			if opts.ProviderSource != "" && len(opts.To) != 1 {
+				return errors.New("--tf-provider-source can only be used with a single cloud provider")
+			}
+			for _, platform := range opts.Platforms {
+				if match, _ := regexp.MatchString("^[a-z0-9]+_[a-z0-9]+$", platform); !match {
+					return errors.Errorf("Invalid platform %s, expected os_arch (e.g. linux_amd64)", platform)
+				}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return providersMirror(cmd, args[0], opts)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringSliceVarP(&opts.To,
+		"to",
+		"t",
+		remote.GetSupportedRemotes(),
+		"Cloud providers to download the terraform provider of\n"+
+			"Accepted values are: "+strings.Join(remote.GetSupportedRemotes(), ",")+"\n",
+	)
+	fl.StringVar(&opts.ProviderVersion,
+		"tf-provider-version",
+		"",
+		"Terraform provider version to download.\n",
+	)
+	fl.StringVar(&opts.ProviderSource,
+		"tf-provider-source",
+		"",
+		"Source address of the terraform provider to download, as [HOSTNAME/]NAMESPACE/TYPE (e.g. integrations/github).\n"+
+			"Defaults to the source address found in the terraform lock file, or the hashicorp provider on the public registry\n",
+	)
+	fl.StringVar(&opts.LockfilePath,
+		"tf-lockfile",
+		".terraform.lock.hcl",
+		"Terraform lock file to get the providers' versions from. Will be ignored if the file doesn't exist.\n",
+	)
+	fl.StringSliceVar(&opts.Platforms,
+		"platform",
+		[]string{defaultPlatform},
+		"Target platforms to download providers for, as os_arch (e.g. linux_amd64)\n",
+	)
+	fl.StringVar(&opts.NetworkMirror,
+		"provider-network-mirror",
+		"",
+		"Base URL of a terraform provider network mirror to download the terraform providers from\n",
+	)
+
+	return cmd
+}
+
+func providersMirror(cmd *cobra.Command, directory string, opts *providersMirrorOptions) error {
+	lockFile, err := lock.ReadLocksFromFile(opts.LockfilePath)
+	if err != nil {
+		logrus.WithField("error", err.Error()).Debug("Error while parsing terraform lock file")
+	}
+
+	for _, to := range opts.To {
+		address, err := getProviderAddress(to, opts.ProviderSource, lockFile)
+		if err != nil {
+			return err
+		}
+
+		version := opts.ProviderVersion
+		var hashes []string
+		if version == "" {
+			if provider := lockFile.GetProviderByAddress(address); provider != nil {
+				version = provider.Version
+				hashes = provider.Hashes
+			} else {
+				version = remote.GetDefaultProviderVersion(to)
+			}
+		}
+
+		config := terraform.ProviderConfig{
+			Hostname:  address.Hostname,
+			Namespace: address.Namespace,
+			Key:       address.Type,
+			Version:   version,
+			Mirror:    terraform.ProviderMirror{URL: opts.NetworkMirror},
+			Hashes:    hashes,
+		}
+		for _, platform := range opts.Platforms {
+			archivePath, err := terraform.MirrorProvider(config, directory, platform)
+			if err != nil {
+				return errors.Wrapf(err, "unable to mirror %s %s (%s)", config.GetAddress(), version, platform)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Mirrored %s %s (%s) to %s\n", config.GetAddress(), version, platform, archivePath)
+		}
+	}
+
+	return nil
+}
+
+// getProviderAddress returns the source address of the terraform provider of a remote: the given source if any,
+// else the address of the only provider of that type in the lock file, else the hashicorp one on the public registry
+func getProviderAddress(to, source string, lockFile *lock.Lockfile) (*lock.ProviderAddress, error) {
+	address := common.RemoteParameter(to).GetProviderAddress()
+	if source != "" {
+		custom, err := lock.ParseProviderAddress(source)
+		if err != nil {
+			return nil, err
+		}
+		if custom.Type != address.Type {
+			return nil, errors.Errorf("provider source %s does not match the %s provider", source, address.Type)
+		}
+		return custom, nil
+	}
+
+	if lockFile.GetProviderByAddress(address) == nil {
+		if provider := lockFile.GetProviderByType(address.Type); provider != nil {
+			if locked, err := lock.ParseProviderAddress(provider.Address); err == nil {
+				return locked, nil
+			}
+		}
+	}
+	return address, nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvidersMirrorCmd(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// sha256 of "github"
+	hash := "zh:c0b0109d9439de57fe3cf03abeccbc52f4c98170c732d3b69af5e6395ace574e"
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/github/4.4.0.json",
+		httpmock.NewStringResponder(http.StatusOK, `{"archives": {
+			"linux_amd64": {"url": "terraform-provider-github_4.4.0_linux_amd64.zip", "hashes": ["`+hash+`"]},
+			"darwin_arm64": {"url": "terraform-provider-github_4.4.0_darwin_arm64.zip", "hashes": ["`+hash+`"]}
+		}}`),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/github/terraform-provider-github_4.4.0_linux_amd64.zip",
+		httpmock.NewBytesResponder(http.StatusOK, []byte("github")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/hashicorp/github/terraform-provider-github_4.4.0_darwin_arm64.zip",
+		httpmock.NewBytesResponder(http.StatusOK, []byte("github")),
+	)
+
+	dir := t.TempDir()
+	rootCmd := &cobra.Command{Use: "root"}
+	rootCmd.AddCommand(NewProvidersCmd())
+
+	output, err := test.Execute(rootCmd, "providers", "mirror", "--to", "github+tf", "--platform", "linux_amd64,darwin_arm64", "--tf-lockfile", "", "--provider-network-mirror", "https://mirror.example.com", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "Mirrored registry.terraform.io/hashicorp/github 4.4.0 (linux_amd64) to "+path.Join(dir, "registry.terraform.io/hashicorp/github/terraform-provider-github_4.4.0_linux_amd64.zip")+"\n"+
+		"Mirrored registry.terraform.io/hashicorp/github 4.4.0 (darwin_arm64) to "+path.Join(dir, "registry.terraform.io/hashicorp/github/terraform-provider-github_4.4.0_darwin_arm64.zip")+"\n", output)
+
+	_, err = os.Stat(path.Join(dir, "registry.terraform.io/hashicorp/github/terraform-provider-github_4.4.0_linux_amd64.zip"))
+	assert.Nil(t, err)
+}
+
+func TestProvidersMirrorCmd_ProviderSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/integrations/github/5.3.0.json",
+		httpmock.NewStringResponder(http.StatusOK, `{"archives": {
+			"linux_amd64": {"url": "terraform-provider-github_5.3.0_linux_amd64.zip"}
+		}}`),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://mirror.example.com/registry.terraform.io/integrations/github/terraform-provider-github_5.3.0_linux_amd64.zip",
+		httpmock.NewBytesResponder(http.StatusOK, []byte("github")),
+	)
+
+	dir := t.TempDir()
+	rootCmd := &cobra.Command{Use: "root"}
+	rootCmd.AddCommand(NewProvidersCmd())
+
+	output, err := test.Execute(rootCmd, "providers", "mirror", "--to", "github+tf", "--platform", "linux_amd64", "--tf-lockfile", "", "--tf-provider-source", "integrations/github", "--tf-provider-version", "5.3.0", "--provider-network-mirror", "https://mirror.example.com", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "Mirrored registry.terraform.io/integrations/github 5.3.0 (linux_amd64) to "+path.Join(dir, "registry.terraform.io/integrations/github/terraform-provider-github_5.3.0_linux_amd64.zip")+"\n", output)
+}
+
+func TestProvidersMirrorCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"providers", "mirror"}, expected: "accepts 1 arg(s), received 0"},
+		{args: []string{"providers", "mirror", "--to", "test", "dir"}, expected: "unsupported cloud provider 'test'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf"},
+		{args: []string{"providers", "mirror", "--tf-provider-version", "3.19.0", "dir"}, expected: "--tf-provider-version can only be used with a single cloud provider"},
+		{args: []string{"providers", "mirror", "--to", "aws+tf", "--tf-provider-version", "foo", "dir"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
+		{args: []string{"providers", "mirror", "--tf-provider-source", "integrations/github", "dir"}, expected: "--tf-provider-source can only be used with a single cloud provider"},
+		{args: []string{"providers", "mirror", "--platform", "linux", "dir"}, expected: "Invalid platform linux, expected os_arch (e.g. linux_amd64)"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewProvidersCmd())
+		_, err := test.Execute(rootCmd, tt.args...)
+		if err == nil {
+			t.Errorf("Invalid arg should generate error")
+			continue
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("Expected '%v', got '%v'", tt.expected, err)
+		}
+	}
+}
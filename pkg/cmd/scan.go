@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -17,11 +18,16 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/build"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/events"
+	"github.com/snyk/driftctl/enumeration/plugin"
+	"github.com/snyk/driftctl/enumeration/profile"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
 	"github.com/snyk/driftctl/enumeration/remote"
 	"github.com/snyk/driftctl/enumeration/remote/aws"
 	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/snyk/driftctl/enumeration/terraform/lock"
+	"github.com/snyk/driftctl/enumeration/tracing"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
@@ -96,17 +102,25 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 			}
 			opts.ProviderVersion = providerVersion
 
-			if opts.ProviderVersion == "" {
-				lockfilePath, _ := cmd.Flags().GetString("tf-lockfile")
+			lockfilePath, _ := cmd.Flags().GetString("tf-lockfile")
+			// Attempt to read the provider source and version from a terraform lock file
+			lockFile, err := lock.ReadLocksFromFile(lockfilePath)
+			if err != nil {
+				logrus.WithField("error", err.Error()).Debug("Error while parsing terraform lock file")
+			}
 
-				// Attempt to read the provider version from a terraform lock file
-				lockFile, err := lock.ReadLocksFromFile(lockfilePath)
-				if err != nil {
-					logrus.WithField("error", err.Error()).Debug("Error while parsing terraform lock file")
-				}
-				if provider := lockFile.GetProviderByAddress(common.RemoteParameter(to).GetProviderAddress()); provider != nil {
+			providerSource, _ := cmd.Flags().GetString("tf-provider-source")
+			address, err := getProviderAddress(to, providerSource, lockFile)
+			if err != nil {
+				return err
+			}
+			opts.ProviderSource = terraform.ProviderSource{Hostname: address.Hostname, Namespace: address.Namespace}
+
+			if opts.ProviderVersion == "" {
+				if provider := lockFile.GetProviderByAddress(address); provider != nil {
 					opts.ProviderVersion = provider.Version
-					logrus.WithFields(logrus.Fields{"version": opts.ProviderVersion, "provider": to}).Debug("Found provider version in terraform lock file")
+					opts.ProviderHashes = provider.Hashes
+					logrus.WithFields(logrus.Fields{"version": opts.ProviderVersion, "provider": address.String()}).Debug("Found provider version in terraform lock file")
 				}
 			}
 
@@ -114,11 +128,62 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 			opts.DisableTelemetry, _ = cmd.Flags().GetBool("disable-telemetry")
 
 			opts.ConfigDir, _ = cmd.Flags().GetString("config-dir")
+			opts.EnumeratorPluginsDir, _ = cmd.Flags().GetString("enumerator-plugins-dir")
+			if opts.EnumeratorPluginsDir == "" {
+				opts.EnumeratorPluginsDir = filepath.Join(opts.ConfigDir, ".driftctl", "enumerators")
+			}
+
+			opts.ProviderMirror.Directory, _ = cmd.Flags().GetString("provider-mirror")
+			opts.ProviderMirror.URL, _ = cmd.Flags().GetString("provider-network-mirror")
+			if opts.ProviderMirror.Directory != "" && opts.ProviderMirror.URL != "" {
+				return errors.New("--provider-mirror and --provider-network-mirror are mutually exclusive")
+			}
 
 			if onlyManaged, _ := cmd.Flags().GetBool("only-managed"); onlyManaged {
 				opts.Deep = true
 			}
 
+			if opts.RefreshManaged {
+				if opts.OnlyUnmanaged {
+					return errors.New("--refresh-managed and --only-unmanaged are mutually exclusive")
+				}
+				opts.Deep = true
+				opts.OnlyManaged = true
+			}
+
+			cacheTTLFlag, _ := cmd.Flags().GetStringSlice("cache-ttl")
+			cacheTTL, err := parseCacheTTLFlag(cacheTTLFlag)
+			if err != nil {
+				return err
+			}
+			opts.CacheTTL = cacheTTL
+
+			if opts.EnumerationConcurrency < 1 {
+				return errors.New("Enumeration concurrency should be at least 1")
+			}
+			if opts.DetailsFetchingConcurrency < 1 {
+				return errors.New("Details fetching concurrency should be at least 1")
+			}
+			if opts.RateLimit < 0 {
+				return errors.New("Rate limit should not be negative")
+			}
+			if opts.Timeout < 0 {
+				return errors.New("Timeout should not be negative")
+			}
+			if opts.EnumeratorTimeout < 0 {
+				return errors.New("Enumerator timeout should not be negative")
+			}
+			if opts.OTLPEndpoint != "" {
+				if _, err := parseOTLPEndpoint(opts.OTLPEndpoint); err != nil {
+					return err
+				}
+			}
+			if opts.TelemetryEndpoint != "" {
+				if _, err := telemetry.ParseSink(opts.TelemetryEndpoint); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -200,6 +265,12 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		"",
 		"Terraform provider version to use.\n",
 	)
+	fl.String(
+		"tf-provider-source",
+		"",
+		"Source address of the terraform provider to use, as [HOSTNAME/]NAMESPACE/TYPE (e.g. integrations/github).\n"+
+			"Defaults to the source address found in the terraform lock file, or the hashicorp provider on the public registry\n",
+	)
 	fl.BoolVar(&opts.StrictMode,
 		"strict",
 		false,
@@ -238,6 +309,23 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		configDir,
 		"Directory path that driftctl uses for configuration.\n",
 	)
+	fl.String(
+		"enumerator-plugins-dir",
+		"",
+		fmt.Sprintf("%s Directory to load enumerator plugins from, defaults to .driftctl/enumerators in the config directory\n", warn("EXPERIMENTAL:"))+
+			fmt.Sprintf("Plugins are executables named %s<name> enumerating resource types driftctl does not support\n", plugin.BinaryPrefix),
+	)
+	fl.String(
+		"provider-mirror",
+		"",
+		"Directory to install the terraform provider from instead of downloading it.\n"+
+			"Supports the layouts of terraform -plugin-dir and plugin_cache_dir, see 'driftctl providers mirror'\n",
+	)
+	fl.String(
+		"provider-network-mirror",
+		"",
+		"Base URL of a terraform provider network mirror to download the terraform provider from\n",
+	)
 	fl.BoolVar(&opts.OnlyManaged,
 		"only-managed",
 		false,
@@ -248,13 +336,124 @@ func NewScanCmd(opts *pkg.ScanOptions) *cobra.Command {
 		false,
 		"Report only what's not managed by your IaC\n",
 	)
+	fl.BoolVar(&opts.RefreshManaged,
+		"refresh-managed",
+		false,
+		fmt.Sprintf("%s Read resources found in IaC from the cloud provider instead of listing it, implies --deep and --only-managed\n", warn("EXPERIMENTAL:"))+
+			"Resource types that cannot be read individually are reported without being compared\n",
+	)
+	fl.BoolVar(&opts.Attribution,
+		"attribution",
+		false,
+		fmt.Sprintf("%s Retrieve who last changed unmanaged and changed resources from cloud provider audit logs\n", warn("EXPERIMENTAL:"))+
+			"Only supported for gcp+tf (Cloud Audit Logs) and azure+tf (Activity Log)\n",
+	)
+	fl.StringSlice(
+		"cache-ttl",
+		[]string{},
+		fmt.Sprintf("%s Persist enumeration results in the config directory and reuse them until they expire\n", warn("EXPERIMENTAL:"))+
+			"Accepts a duration (e.g. 1h) applied to every resource type, or <resource type>=<duration> (e.g. aws_s3_bucket=10m) to override it\n"+
+			"Disabled by default, only supported for aws+tf\n",
+	)
+	fl.IntVar(&opts.EnumerationConcurrency,
+		"enumeration-concurrency",
+		remote.DefaultConcurrency,
+		"Number of resource types enumerated in parallel\n",
+	)
+	fl.IntVar(&opts.DetailsFetchingConcurrency,
+		"details-fetching-concurrency",
+		remote.DefaultConcurrency,
+		"Number of resources whose details are read in parallel in deep mode\n",
+	)
+	fl.Float64Var(&opts.RateLimit,
+		"rate-limit",
+		ratelimit.DefaultRate,
+		"Maximum number of requests per second sent to each cloud service, 0 disables rate limiting\n"+
+			"The rate is automatically reduced when the cloud provider throttles requests. Only supported for aws+tf\n",
+	)
+	fl.DurationVar(&opts.Timeout,
+		"timeout",
+		0,
+		"Maximum duration of the scan (e.g. 30m), the scan fails when it is reached. Disabled by default\n",
+	)
+	fl.DurationVar(&opts.EnumeratorTimeout,
+		"enumerator-timeout",
+		0,
+		"Maximum duration of the enumeration of a single resource type (e.g. 5m). Disabled by default\n"+
+			"Resources of a type that could not be enumerated in time are ignored and reported in an alert\n",
+	)
+	fl.BoolVar(&opts.ProfileReport,
+		"profile-report",
+		false,
+		"Record the duration, API calls and errors of each resource type, print the slowest types at the end of the scan\n"+
+			"and include the report in the JSON output. API calls are only counted for aws+tf\n",
+	)
+	fl.StringVar(&opts.TelemetryEndpoint,
+		"telemetry-endpoint",
+		"",
+		"Send the telemetry of the scan to your own endpoint instead of driftctl's, as an http(s) url receiving a JSON POST request\n"+
+			"or a local file the telemetry of each scan is appended to as a JSON line (e.g. file:///var/log/driftctl.ndjson)\n"+
+			"Telemetry is sent to a custom endpoint even if usage reporting is not enabled on this build, unless telemetry is disabled\n",
+	)
+	fl.StringVar(&opts.OTLPEndpoint,
+		"otlp-endpoint",
+		"",
+		"Export traces of the scan to an OpenTelemetry collector, as an OTLP/HTTP url (e.g. http://localhost:4318)\n"+
+			"Spans cover state reading, the enumeration of each resource type, middlewares and analysis. Disabled by default\n",
+	)
+	fl.StringVar(&opts.EventsFile,
+		"events-file",
+		"",
+		"Write scan events (state reading, enumeration of each resource type, alerts) to a file as newline delimited JSON\n"+
+			"Use - to write events to stderr\n",
+	)
 
 	return cmd
 }
 
-func scanRun(opts *pkg.ScanOptions) error {
+func scanRun(opts *pkg.ScanOptions) (err error) {
 	store := memstore.New()
 
+	if opts.EventsFile != "" {
+		closeEvents, err := openEventsFile(opts.EventsFile)
+		if err != nil {
+			return err
+		}
+		defer closeEvents()
+	}
+	start := time.Now()
+	events.Emit(events.NewScanStarted(opts.To))
+	defer func() {
+		if _, ok := err.(cmderrors.InfrastructureNotInSync); ok {
+			events.Emit(events.NewScanFinished(opts.To, time.Since(start), nil))
+			return
+		}
+		events.Emit(events.NewScanFinished(opts.To, time.Since(start), err))
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	if opts.OTLPEndpoint != "" {
+		shutdownTracing, err := initTracing(ctx, opts.OTLPEndpoint)
+		if err != nil {
+			return err
+		}
+		defer shutdownTracing()
+	}
+	ctx, span := tracing.Start(ctx, "driftctl scan", tracing.ProviderKey.String(opts.To))
+	defer func() {
+		if _, ok := err.(cmderrors.InfrastructureNotInSync); ok {
+			tracing.End(span, nil)
+			return
+		}
+		tracing.End(span, err)
+	}()
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
@@ -284,6 +483,9 @@ func scanRun(opts *pkg.ScanOptions) error {
 
 	providerLibrary := terraform.NewProviderLibrary()
 	remoteLibrary := common.NewRemoteLibrary()
+	if opts.Attribution {
+		remoteLibrary.EnableAttribution()
+	}
 
 	iacProgress := globaloutput.NewProgress("Scanning states", "Scanned states", true)
 	scanProgress := globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
@@ -292,7 +494,7 @@ func scanRun(opts *pkg.ScanOptions) error {
 
 	resFactory := dctlresource.NewDriftctlResourceFactory(resourceSchemaRepository)
 
-	err := remote.Activate(opts.To, opts.ProviderVersion, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir)
+	err = remote.Activate(opts.To, opts.ProviderVersion, opts.ProviderHashes, opts.ProviderSource, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, opts.ConfigDir, opts.ProviderMirror, opts.CacheTTL, opts.RateLimit)
 	if err != nil {
 		if err == aws.AWSCredentialsNotFoundError {
 			// special case command-line advice, because AWS is the default cloud
@@ -316,13 +518,33 @@ func scanRun(opts *pkg.ScanOptions) error {
 		logrus.Trace("Exited")
 	}()
 
+	enumeratorPlugins, err := plugin.Load(opts.EnumeratorPluginsDir, remoteLibrary)
+	if err != nil {
+		return err
+	}
+	defer enumeratorPlugins.Cleanup()
+	for _, ty := range enumeratorPlugins.ResourceTypes() {
+		dctlresource.AddSupportedType(ty)
+	}
+
 	logrus.Debug("Checking for driftignore")
 	driftIgnore := filter.NewDriftIgnore(opts.DriftignorePath, opts.Driftignores...)
 
-	// TODO use enum library interface here
-	scanner := remote.NewScanner(remoteLibrary, alerter, remote.ScannerOptions{Deep: opts.Deep}, driftIgnore)
+	var profiler *profile.Profiler
+	if opts.ProfileReport {
+		profiler = profile.NewProfiler()
+	}
 
-	iacSupplier, err := supplier.GetIACSupplier(opts.From, providerLibrary, opts.BackendOptions, iacProgress, alerter, resFactory, driftIgnore)
+	// TODO use enum library interface here
+	scanner := remote.NewScanner(ctx, remoteLibrary, alerter, remote.ScannerOptions{
+		Deep:                       opts.Deep,
+		EnumerationConcurrency:     opts.EnumerationConcurrency,
+		DetailsFetchingConcurrency: opts.DetailsFetchingConcurrency,
+		EnumeratorTimeout:          opts.EnumeratorTimeout,
+		Profiler:                   profiler,
+	}, driftIgnore)
+
+	iacSupplier, err := supplier.GetIACSupplier(ctx, opts.From, providerLibrary, opts.BackendOptions, iacProgress, alerter, resFactory, driftIgnore)
 	if err != nil {
 		return err
 	}
@@ -338,21 +560,29 @@ func scanRun(opts *pkg.ScanOptions) error {
 		iacProgress,
 		resourceSchemaRepository,
 		store,
+		remoteLibrary.Attributor(),
 	)
 
 	go func() {
 		<-c
 		logrus.Warn("Detected interrupt, cleanup ...")
+		cancel()
 		ctl.Stop()
 	}()
 
-	analysis, err := ctl.Run()
+	analysis, err := ctl.Run(ctx)
 	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("Scan did not complete within %s, use --timeout to increase this delay", opts.Timeout)
+		}
 		return err
 	}
 
 	analysis.ProviderVersion = opts.ProviderVersion
 	analysis.ProviderName = opts.To
+	if profiler != nil {
+		analysis.SetProfile(profiler.Report())
+	}
 	store.Bucket(memstore.TelemetryBucket).Set("provider_name", analysis.ProviderName)
 
 	validOutput := false
@@ -374,9 +604,16 @@ func scanRun(opts *pkg.ScanOptions) error {
 
 	globaloutput.Printf(color.WhiteString("Scan duration: %s\n", analysis.Duration.Round(time.Second)))
 	globaloutput.Printf(color.WhiteString("Provider version used to scan: %s. Use --tf-provider-version to use another version.\n"), opts.ProviderVersion)
+	if report := analysis.Profile(); report != nil {
+		printProfileReport(report)
+	}
 
 	if !opts.DisableTelemetry {
-		tl := telemetry.NewTelemetry(&build.Build{})
+		var sink telemetry.Sink
+		if opts.TelemetryEndpoint != "" {
+			sink, _ = telemetry.ParseSink(opts.TelemetryEndpoint)
+		}
+		tl := telemetry.NewTelemetry(&build.Build{}, sink)
 		tl.SendTelemetry(store.Bucket(memstore.TelemetryBucket))
 	}
 
@@ -387,6 +624,49 @@ func scanRun(opts *pkg.ScanOptions) error {
 	return nil
 }
 
+// slowestTypesCount is the number of resource types printed by the profile report for each phase
+const slowestTypesCount = 10
+
+func printProfileReport(report *profile.Report) {
+	phases := []struct {
+		title string
+		kind  profile.Kind
+	}{
+		{"Slowest resource types to enumerate:", profile.Enumeration},
+		{"Slowest resource types to read details of:", profile.DetailsFetching},
+	}
+	for _, phase := range phases {
+		slowest := report.Slowest(phase.kind, slowestTypesCount)
+		if len(slowest) == 0 {
+			continue
+		}
+		globaloutput.Printf(color.WhiteString("%s\n", phase.title))
+		for _, p := range slowest {
+			globaloutput.Printf("  %-50s %10s %6d API calls %4d errors\n", p.ResourceType, p.Duration(), p.ApiCalls, p.Errors)
+		}
+	}
+}
+
+// openEventsFile sends scan events to the given file, the returned func restores the previous emitter
+func openEventsFile(path string) (func(), error) {
+	if path == "-" {
+		events.ChangeEmitter(events.NewNDJSONEmitter(os.Stderr))
+		return func() { events.ChangeEmitter(&events.VoidEmitter{}) }, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create events file")
+	}
+	emitter := events.NewNDJSONEmitter(f)
+	events.ChangeEmitter(emitter)
+	return func() {
+		events.ChangeEmitter(&events.VoidEmitter{})
+		// Events emitted concurrently may still hold the previous emitter
+		emitter.Close()
+		_ = f.Close()
+	}, nil
+}
+
 func validateTfProviderVersionString(version string) error {
 	if version == "" {
 		return nil
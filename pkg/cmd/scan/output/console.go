@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/fatih/color"
@@ -90,6 +91,9 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 				if humanAttrs := formatResourceAttributes(res); humanAttrs != "" {
 					humanString += fmt.Sprintf("\n        %s", humanAttrs)
 				}
+				if attribution := formatAttribution(analysis, res); attribution != "" {
+					humanString += fmt.Sprintf("\n        %s", attribution)
+				}
 				fmt.Println(humanString)
 			}
 		}
@@ -133,6 +137,9 @@ func (c *Console) Write(analysis *analyser.Analysis) error {
 					humanString += fmt.Sprintf("\n%s%s", whiteSpace, humanAttrs)
 					whiteSpace += "    "
 				}
+				if attribution := formatAttribution(analysis, difference.Res); attribution != "" {
+					humanString += fmt.Sprintf("\n%s%s", indentBase+"    ", attribution)
+				}
 				fmt.Println(humanString)
 				for _, change := range difference.Changelog {
 					path := strings.Join(change.Path, ".")
@@ -304,3 +311,11 @@ func formatResourceAttributes(res *resource.Resource) string {
 	}
 	return attrString
 }
+
+func formatAttribution(analysis *analyser.Analysis, res *resource.Resource) string {
+	attribution := analysis.Attribution(res)
+	if attribution == nil {
+		return ""
+	}
+	return color.HiBlackString("Last changed by %s on %s", attribution.Principal, attribution.Date.Format(time.RFC3339))
+}
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/snyk/driftctl/enumeration/terraform"
 	"github.com/snyk/driftctl/pkg"
 	"github.com/snyk/driftctl/pkg/iac/config"
 	"github.com/snyk/driftctl/pkg/iac/terraform/state"
@@ -51,6 +53,17 @@ func TestScanCmd_Valid(t *testing.T) {
 		{args: []string{"scan", "--tf-lockfile", "../.terraform.lock.hcl"}},
 		{args: []string{"scan", "--only-managed"}},
 		{args: []string{"scan", "--only-unmanaged"}},
+		{args: []string{"scan", "--cache-ttl", "1h", "--cache-ttl", "aws_s3_bucket=10m"}},
+		{args: []string{"scan", "--enumeration-concurrency", "20", "--details-fetching-concurrency", "5"}},
+		{args: []string{"scan", "--rate-limit", "0"}},
+		{args: []string{"scan", "--rate-limit", "12.5"}},
+		{args: []string{"scan", "--timeout", "30m", "--enumerator-timeout", "5m"}},
+		{args: []string{"scan", "--provider-mirror", "/tmp/providers"}},
+		{args: []string{"scan", "--provider-mirror", "", "--provider-network-mirror", "https://mirror.example.com/providers/"}},
+		{args: []string{"scan", "--events-file", "-"}},
+		{args: []string{"scan", "--profile-report"}},
+		{args: []string{"scan", "--telemetry-endpoint", "file:///tmp/driftctl.ndjson"}},
+		{args: []string{"scan", "--otlp-endpoint", "http://localhost:4318"}},
 	}
 
 	for _, tt := range cases {
@@ -93,6 +106,18 @@ func TestScanCmd_Invalid(t *testing.T) {
 		{args: []string{"scan", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
 		{args: []string{"scan", "--driftignore"}, expected: "flag needs an argument: --driftignore"},
 		{args: []string{"scan", "--tf-lockfile"}, expected: "flag needs an argument: --tf-lockfile"},
+		{args: []string{"scan", "--tf-provider-source", "github"}, expected: `invalid provider source address "github", expected [HOSTNAME/]NAMESPACE/TYPE`},
+		{args: []string{"scan", "--to", "aws+tf", "--tf-provider-source", "integrations/github"}, expected: "provider source integrations/github does not match the aws provider"},
+		{args: []string{"scan", "--enumeration-concurrency", "0"}, expected: "Enumeration concurrency should be at least 1"},
+		{args: []string{"scan", "--details-fetching-concurrency", "-1"}, expected: "Details fetching concurrency should be at least 1"},
+		{args: []string{"scan", "--rate-limit", "-1"}, expected: "Rate limit should not be negative"},
+		{args: []string{"scan", "--timeout", "-1m"}, expected: "Timeout should not be negative"},
+		{args: []string{"scan", "--provider-mirror", "/tmp/providers", "--provider-network-mirror", "https://mirror.example.com"}, expected: "--provider-mirror and --provider-network-mirror are mutually exclusive"},
+		{args: []string{"scan", "--refresh-managed", "--only-unmanaged"}, expected: "--refresh-managed and --only-unmanaged are mutually exclusive"},
+		{args: []string{"scan", "--enumerator-timeout", "-1m"}, expected: "Enumerator timeout should not be negative"},
+		{args: []string{"scan", "--telemetry-endpoint", "/tmp/driftctl.ndjson"}, expected: "Invalid telemetry endpoint /tmp/driftctl.ndjson, expected an http(s) url or a file path as file://<path>"},
+		{args: []string{"scan", "--otlp-endpoint", "localhost:4318"}, expected: "Invalid OTLP endpoint localhost:4318, expected an http or https url (e.g. http://localhost:4318)"},
+		{args: []string{"scan", "--cache-ttl", "foo"}, expected: "Unable to parse cache TTL 'foo', expected a duration (e.g. 1h) or <resource type>=<duration> (e.g. aws_s3_bucket=10m)"},
 	}
 
 	for _, tt := range cases {
@@ -142,6 +167,53 @@ func Test_Options(t *testing.T) {
 				assert.Equal(t, "", opts.ProviderVersion)
 			},
 		},
+		{
+			name: "should install hashicorp providers from the public registry by default",
+			args: []string{"scan", "--to", "aws+tf", "--tf-lockfile", "testdata/terraform_valid.lock.hcl"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, terraform.ProviderSource{Hostname: "registry.terraform.io", Namespace: "hashicorp"}, opts.ProviderSource)
+			},
+		},
+		{
+			name: "should get provider source and version from lockfile",
+			args: []string{"scan", "--to", "github+tf", "--tf-lockfile", "testdata/terraform_integrations.lock.hcl"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, terraform.ProviderSource{Hostname: "registry.terraform.io", Namespace: "integrations"}, opts.ProviderSource)
+				assert.Equal(t, "5.3.0", opts.ProviderVersion)
+				assert.Equal(t, []string{"h1:pFsKVGjnvAUu9Scqkk3M3Ke3sDf0SC4u3kOnc8/L8Kk="}, opts.ProviderHashes)
+			},
+		},
+		{
+			name: "should get provider source from flag",
+			args: []string{"scan", "--to", "github+tf", "--tf-lockfile", "testdata/terraform_integrations.lock.hcl", "--tf-provider-source", "registry.example.com/integrations/github"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, terraform.ProviderSource{Hostname: "registry.example.com", Namespace: "integrations"}, opts.ProviderSource)
+				assert.Equal(t, "", opts.ProviderVersion)
+			},
+		},
+		{
+			name: "refresh managed should enable deep and only managed modes",
+			args: []string{"scan", "--refresh-managed"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.True(t, opts.RefreshManaged)
+				assert.True(t, opts.Deep)
+				assert.True(t, opts.OnlyManaged)
+			},
+		},
+		{
+			name: "should load enumerator plugins from the config dir by default",
+			args: []string{"scan", "--config-dir", "/home/driftctl"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, filepath.Join("/home/driftctl", ".driftctl", "enumerators"), opts.EnumeratorPluginsDir)
+			},
+		},
+		{
+			name: "should load enumerator plugins from the given dir",
+			args: []string{"scan", "--enumerator-plugins-dir", "/opt/driftctl/enumerators"},
+			assertOptions: func(t *testing.T, opts *pkg.ScanOptions) {
+				assert.Equal(t, "/opt/driftctl/enumerators", opts.EnumeratorPluginsDir)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -193,3 +265,22 @@ func Test_RetrieveBackendsFromHCL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOTLPEndpoint(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		want     int
+	}{
+		{endpoint: "http://localhost:4318", want: 2},
+		{endpoint: "https://collector.example.com", want: 1},
+		{endpoint: "https://collector.example.com/otlp/v1/traces", want: 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.endpoint, func(t *testing.T) {
+			opts, err := parseOTLPEndpoint(c.endpoint)
+			assert.Nil(t, err)
+			assert.Len(t, opts, c.want)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/enumerator"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/server"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/terraform/lock"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+type serveOptions struct {
+	To              string
+	ProviderVersion string
+	ProviderSource  string
+	ConfigDir       string
+	Listen          string
+}
+
+func NewServeCmd() *cobra.Command {
+	opts := &serveOptions{}
+	var source terraform.ProviderSource
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Expose cloud resources enumeration as a gRPC service",
+		Long: "This command starts a gRPC server listing and refreshing cloud resources with driftctl enumerators, " +
+			"see enumeration/server/pb/enumeration.proto for the service definition\n\n" +
+			"Example: driftctl serve --to aws+tf --listen localhost:50051",
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !remote.IsSupported(opts.To) {
+				return errors.Errorf(
+					"unsupported cloud provider '%s'\nValid values are: %s",
+					opts.To,
+					strings.Join(remote.GetSupportedRemotes(), ","),
+				)
+			}
+			if err := validateTfProviderVersionString(opts.ProviderVersion); err != nil {
+				return err
+			}
+			address, err := getProviderAddress(opts.To, opts.ProviderSource, &lock.Lockfile{})
+			if err != nil {
+				return err
+			}
+			source = terraform.ProviderSource{Hostname: address.Hostname, Namespace: address.Namespace}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serveRun(opts, source)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.To,
+		"to",
+		"t",
+		common.RemoteAWSTerraform,
+		"Cloud provider to enumerate\n"+
+			"Accepted values are: "+strings.Join(remote.GetSupportedRemotes(), ",")+"\n",
+	)
+	fl.StringVar(&opts.ProviderVersion,
+		"tf-provider-version",
+		"",
+		"Terraform provider version to use.\n",
+	)
+	fl.StringVar(&opts.ProviderSource,
+		"tf-provider-source",
+		"",
+		"Source address of the terraform provider to use, as [HOSTNAME/]NAMESPACE/TYPE (e.g. integrations/github).\n",
+	)
+	fl.StringVar(&opts.ConfigDir,
+		"config-dir",
+		"",
+		"Directory path that driftctl uses for configuration, a temporary directory is used if not set.\n",
+	)
+	fl.StringVar(&opts.Listen,
+		"listen",
+		"localhost:50051",
+		"Address the gRPC server listens on\n",
+	)
+
+	return cmd
+}
+
+func serveRun(opts *serveOptions, source terraform.ProviderSource) error {
+	cloudEnumerator, err := enumerator.NewCloudEnumerator().
+		WithCloud(strings.TrimSuffix(opts.To, "+tf")).
+		WithProviderVersion(opts.ProviderVersion).
+		WithProviderSource(source).
+		WithConfigDirectory(opts.ConfigDir).
+		Build()
+	if err != nil {
+		return err
+	}
+	defer cloudEnumerator.Cleanup()
+
+	listener, err := net.Listen("tcp", opts.Listen)
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer()
+	server.NewServer(cloudEnumerator).Register(grpcServer)
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		logrus.Warn("Detected interrupt, stopping server ...")
+		grpcServer.GracefulStop()
+	}()
+
+	logrus.WithField("address", listener.Addr().String()).Info("Enumeration server listening")
+	return grpcServer.Serve(listener)
+}
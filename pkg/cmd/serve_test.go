@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/test"
+	"github.com/spf13/cobra"
+)
+
+func TestServeCmd_Invalid(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{args: []string{"serve", "foo"}, expected: `unknown command "foo" for "root serve"`},
+		{args: []string{"serve", "--to", "test"}, expected: "unsupported cloud provider 'test'\nValid values are: aws+tf,github+tf,gcp+tf,azure+tf"},
+		{args: []string{"serve", "--tf-provider-version", "foo"}, expected: "Invalid version argument foo, expected a valid semver string (e.g. 2.13.4)"},
+		{args: []string{"serve", "--tf-provider-source", "integrations/github"}, expected: "provider source integrations/github does not match the aws provider"},
+	}
+
+	for _, tt := range cases {
+		rootCmd := &cobra.Command{Use: "root"}
+		rootCmd.AddCommand(NewServeCmd())
+		_, err := test.Execute(rootCmd, tt.args...)
+		if err == nil {
+			t.Errorf("Invalid arg should generate error")
+			continue
+		}
+		if err.Error() != tt.expected {
+			t.Errorf("Expected '%v', got '%v'", tt.expected, err)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+provider "registry.terraform.io/integrations/github" {
+    version     = "5.3.0"
+    constraints = "~> 5.3.0"
+    hashes = [
+        "h1:pFsKVGjnvAUu9Scqkk3M3Ke3sDf0SC4u3kOnc8/L8Kk=",
+    ]
+}
@@ -4,6 +4,7 @@ import "github.com/spf13/viper"
 
 func Init() {
 	_ = viper.BindEnv("log_level")
+	_ = viper.BindEnv("log_format")
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("dctl")
 }
@@ -1,13 +1,21 @@
 package pkg
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/jmespath/go-jmespath"
 	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/events"
+	"github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/tracing"
 	"github.com/snyk/driftctl/pkg/analyser"
 	"github.com/snyk/driftctl/pkg/cmd/scan/output"
 	"github.com/snyk/driftctl/pkg/filter"
@@ -17,6 +25,7 @@ import (
 	"github.com/snyk/driftctl/pkg/middlewares"
 	globaloutput "github.com/snyk/driftctl/pkg/output"
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/telemetry"
 )
 
 type FmtOptions struct {
@@ -35,12 +44,36 @@ type ScanOptions struct {
 	StrictMode       bool
 	DisableTelemetry bool
 	ProviderVersion  string
-	ConfigDir        string
-	DriftignorePath  string
-	Driftignores     []string
-	Deep             bool
-	OnlyManaged      bool
-	OnlyUnmanaged    bool
+	ProviderHashes   []string
+	// ProviderSource is the registry hostname and namespace the terraform provider is installed from
+	ProviderSource terraform.ProviderSource
+	ConfigDir      string
+	// EnumeratorPluginsDir is the directory enumerator plugins are loaded from
+	EnumeratorPluginsDir string
+	ProviderMirror       terraform.ProviderMirror
+	DriftignorePath      string
+	Driftignores         []string
+	Deep                 bool
+	OnlyManaged          bool
+	OnlyUnmanaged        bool
+	Attribution          bool
+	CacheTTL             cache.TTLConfig
+	// RefreshManaged reads resources found in IaC from the cloud instead of enumerating it, implies Deep and OnlyManaged
+	RefreshManaged bool
+
+	EnumerationConcurrency     int
+	DetailsFetchingConcurrency int
+	RateLimit                  float64
+	Timeout                    time.Duration
+	EnumeratorTimeout          time.Duration
+	// ProfileReport enables the recording of the cost of each resource type
+	ProfileReport bool
+	// TelemetryEndpoint is the url or file:// path telemetry is sent to instead of the default endpoint
+	TelemetryEndpoint string
+	// OTLPEndpoint is the OTLP/HTTP url traces are exported to, tracing is disabled if not set
+	OTLPEndpoint string
+	// EventsFile is the path scan events are written to as newline delimited JSON, "-" for stderr
+	EventsFile string
 }
 
 type DriftCTL struct {
@@ -54,6 +87,7 @@ type DriftCTL struct {
 	resourceSchemaRepository dctlresource.SchemaRepositoryInterface
 	opts                     *ScanOptions
 	store                    memstore.Store
+	attributor               common.Attributor
 }
 
 func NewDriftCTL(remoteSupplier resource.Supplier,
@@ -65,7 +99,8 @@ func NewDriftCTL(remoteSupplier resource.Supplier,
 	scanProgress globaloutput.Progress,
 	iacProgress globaloutput.Progress,
 	resourceSchemaRepository dctlresource.SchemaRepositoryInterface,
-	store memstore.Store) *DriftCTL {
+	store memstore.Store,
+	attributor common.Attributor) *DriftCTL {
 	return &DriftCTL{
 		remoteSupplier,
 		iacSupplier,
@@ -77,12 +112,13 @@ func NewDriftCTL(remoteSupplier resource.Supplier,
 		resourceSchemaRepository,
 		opts,
 		store,
+		attributor,
 	}
 }
 
-func (d DriftCTL) Run() (*analyser.Analysis, error) {
+func (d DriftCTL) Run(ctx context.Context) (*analyser.Analysis, error) {
 	start := time.Now()
-	remoteResources, resourcesFromState, err := d.scan()
+	remoteResources, resourcesFromState, err := d.scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +153,9 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		middlewares.NewEipAssociationExpander(d.resourceFactory),
 		middlewares.NewAwsNatGatewayEipAssoc(),
 		middlewares.NewRDSClusterInstanceExpander(d.resourceFactory),
+		middlewares.NewDocDBClusterInstanceExpander(d.resourceFactory),
+		middlewares.NewDocDBSubnetGroupExpander(d.resourceFactory),
+		middlewares.NewAwsOpenSearchDomainReconciler(d.resourceFactory),
 		middlewares.NewAwsApiGatewayDeploymentExpander(d.resourceFactory),
 		middlewares.NewAwsApiGatewayResourceExpander(d.resourceFactory),
 		middlewares.NewAwsApiGatewayApiExpander(d.resourceFactory),
@@ -143,11 +182,12 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 			middlewares.NewGoogleLegacyBucketIAMMember(),
 			middlewares.NewGoogleDefaultIAMMember(),
 			middlewares.NewAwsDefaultApiGatewayAccount(),
+			middlewares.NewAwsDefaultCloudwatchLogGroup(),
 		)
 	}
 
 	logrus.Debug("Ready to run middlewares")
-	err = middleware.Execute(&remoteResources, &resourcesFromState)
+	err = middleware.ExecuteContext(ctx, &remoteResources, &resourcesFromState)
 	if err != nil {
 		return nil, err
 	}
@@ -164,11 +204,28 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 		}
 	}
 
+	_, span := tracing.Start(ctx, "Analyzer.Analyze",
+		tracing.RemoteCountKey.Int(len(remoteResources)),
+		tracing.StateCountKey.Int(len(resourcesFromState)),
+	)
 	analysis, err := d.analyzer.Analyze(remoteResources, resourcesFromState)
+	if err == nil {
+		span.SetAttributes(
+			tracing.ManagedCountKey.Int(analysis.Summary().TotalManaged),
+			tracing.UnmanagedCountKey.Int(analysis.Summary().TotalUnmanaged),
+			tracing.DeletedCountKey.Int(analysis.Summary().TotalDeleted),
+			tracing.DriftedCountKey.Int(analysis.Summary().TotalDrifted),
+		)
+	}
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
 
+	if d.opts.Attribution {
+		d.attribute(ctx, &analysis)
+	}
+
 	analysis.SetIaCSourceCount(d.iacSupplier.SourceCount())
 	analysis.Duration = time.Since(start)
 	analysis.Date = time.Now()
@@ -177,6 +234,8 @@ func (d DriftCTL) Run() (*analyser.Analysis, error) {
 	d.store.Bucket(memstore.TelemetryBucket).Set("total_managed", analysis.Summary().TotalManaged)
 	d.store.Bucket(memstore.TelemetryBucket).Set("duration", uint(analysis.Duration.Seconds()+0.5))
 	d.store.Bucket(memstore.TelemetryBucket).Set("iac_source_count", d.iacSupplier.SourceCount())
+	d.store.Bucket(memstore.TelemetryBucket).Set("resource_types", countByResourceType(&analysis))
+	d.store.Bucket(memstore.TelemetryBucket).Set("alert_count", countAlerts(analysis.Alerts()))
 
 	return &analysis, nil
 }
@@ -196,10 +255,81 @@ func (d DriftCTL) Stop() {
 	}
 }
 
-func (d DriftCTL) scan() (remoteResources []*resource.Resource, resourcesFromState []*resource.Resource, err error) {
+func countByResourceType(analysis *analyser.Analysis) map[string]telemetry.ResourceTypeCount {
+	counts := map[string]telemetry.ResourceTypeCount{}
+	count := func(resources []*resource.Resource, inc func(c *telemetry.ResourceTypeCount)) {
+		for _, res := range resources {
+			c := counts[res.ResourceType()]
+			inc(&c)
+			counts[res.ResourceType()] = c
+		}
+	}
+	count(analysis.Managed(), func(c *telemetry.ResourceTypeCount) { c.Managed++ })
+	count(analysis.Unmanaged(), func(c *telemetry.ResourceTypeCount) { c.Unmanaged++ })
+	count(analysis.Deleted(), func(c *telemetry.ResourceTypeCount) { c.Missing++ })
+	for _, difference := range analysis.Differences() {
+		c := counts[difference.Res.ResourceType()]
+		c.Changed++
+		counts[difference.Res.ResourceType()] = c
+	}
+	return counts
+}
+
+func countAlerts(alerts alerter.Alerts) int {
+	total := 0
+	for _, a := range alerts {
+		total += len(a)
+	}
+	return total
+}
+
+// attribute looks for the last principal that modified unmanaged and changed resources.
+// Attribution errors are reported as alerts without preventing the attribution of remaining resources.
+func (d DriftCTL) attribute(ctx context.Context, analysis *analyser.Analysis) {
+	if d.attributor == nil {
+		logrus.Warn("Attribution is not supported for this cloud provider, ignoring")
+		return
+	}
+
+	resources := make([]*resource.Resource, 0, analysis.Summary().TotalUnmanaged+analysis.Summary().TotalDrifted)
+	resources = append(resources, analysis.Unmanaged()...)
+	for _, difference := range analysis.Differences() {
+		resources = append(resources, difference.Res)
+	}
+
+	reported := map[string]struct{}{}
+	for _, res := range resources {
+		attribution, err := d.attributor.Attribute(ctx, res)
+		if err != nil {
+			// Attributors load audit logs once, so the same error is likely returned for every resource
+			if _, exist := reported[err.Error()]; !exist {
+				reported[err.Error()] = struct{}{}
+				alert := alerts.NewAttributionAlert(err)
+				events.Emit(events.NewAlertRaised("", alert.Message(), "", ""))
+				analysis.AddAlert("", alert)
+			}
+			continue
+		}
+		if attribution == nil {
+			continue
+		}
+		analysis.AddAttribution(res, *attribution)
+	}
+}
+
+func (d DriftCTL) scan(ctx context.Context) (remoteResources []*resource.Resource, resourcesFromState []*resource.Resource, err error) {
+	ctx, span := tracing.Start(ctx, "DriftCTL.scan")
+	defer func() {
+		span.SetAttributes(
+			tracing.RemoteCountKey.Int(len(remoteResources)),
+			tracing.StateCountKey.Int(len(resourcesFromState)),
+		)
+		tracing.End(span, err)
+	}()
+
 	logrus.Info("Start reading IaC")
 	d.iacProgress.Start()
-	resourcesFromState, err = d.iacSupplier.Resources()
+	resourcesFromState, err = resource.ResourcesOf(ctx, d.iacSupplier)
 	d.iacProgress.Stop()
 	if err != nil {
 		return nil, nil, err
@@ -208,7 +338,11 @@ func (d DriftCTL) scan() (remoteResources []*resource.Resource, resourcesFromSta
 	logrus.Info("Start scanning cloud provider")
 	d.scanProgress.Start()
 	defer d.scanProgress.Stop()
-	remoteResources, err = d.remoteSupplier.Resources()
+	if d.opts.RefreshManaged {
+		remoteResources, err = d.refresh(ctx, resourcesFromState)
+	} else {
+		remoteResources, err = resource.ResourcesOf(ctx, d.remoteSupplier)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,3 +361,31 @@ func (d DriftCTL) scan() (remoteResources []*resource.Resource, resourcesFromSta
 
 	return normalizedRemoteResources, resourcesFromState, err
 }
+
+// refresher reads the current state of already known resources, as implemented by remote.Scanner
+type refresher interface {
+	Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error)
+}
+
+// refresh reads the cloud counterpart of resources found in IaC instead of enumerating the whole cloud
+func (d DriftCTL) refresh(ctx context.Context, resourcesFromState []*resource.Resource) ([]*resource.Resource, error) {
+	r, ok := d.remoteSupplier.(refresher)
+	if !ok {
+		return nil, fmt.Errorf("remote supplier %T cannot refresh managed resources", d.remoteSupplier)
+	}
+
+	input := &enumeration.RefreshInput{Resources: map[string][]*resource.Resource{}}
+	for _, res := range resourcesFromState {
+		input.Resources[res.ResourceType()] = append(input.Resources[res.ResourceType()], res)
+	}
+	output, err := r.Refresh(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteResources := make([]*resource.Resource, 0, len(resourcesFromState))
+	for _, resources := range output.Resources {
+		remoteResources = append(remoteResources, resources...)
+	}
+	return remoteResources, nil
+}
@@ -1,14 +1,21 @@
 package pkg_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration"
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/remote"
+	remotealerts "github.com/snyk/driftctl/enumeration/remote/alerts"
+	"github.com/snyk/driftctl/enumeration/remote/common"
 	"github.com/snyk/driftctl/enumeration/resource"
 	"github.com/snyk/driftctl/pkg"
 	"github.com/snyk/driftctl/pkg/analyser"
@@ -17,10 +24,15 @@ import (
 	"github.com/snyk/driftctl/pkg/output"
 	dctlresource "github.com/snyk/driftctl/pkg/resource"
 	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/telemetry"
 	"github.com/snyk/driftctl/test"
 	testresource "github.com/snyk/driftctl/test/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TestProvider struct {
@@ -97,9 +109,9 @@ func runTest(t *testing.T, cases TestCases) {
 			analyzer := analyser.NewAnalyzer(testAlerter, analyser.AnalyzerOptions{Deep: c.options.Deep}, testFilter)
 
 			store := memstore.New()
-			driftctl := pkg.NewDriftCTL(remoteSupplier, stateSupplier, testAlerter, analyzer, resourceFactory, c.options, scanProgress, iacProgress, repo, store)
+			driftctl := pkg.NewDriftCTL(remoteSupplier, stateSupplier, testAlerter, analyzer, resourceFactory, c.options, scanProgress, iacProgress, repo, store, nil)
 
-			analysis, err := driftctl.Run()
+			analysis, err := driftctl.Run(context.TODO())
 
 			c.assert(t, test.NewScanResult(t, analysis), err)
 			if c.assertStore != nil {
@@ -126,6 +138,7 @@ func TestDriftctlRun_BasicBehavior(t *testing.T) {
 				assert.Equal(t, 0, store.Bucket(memstore.TelemetryBucket).Get("total_managed"))
 				assert.Equal(t, uint(0), store.Bucket(memstore.TelemetryBucket).Get("duration"))
 				assert.Equal(t, uint(2), store.Bucket(memstore.TelemetryBucket).Get("iac_source_count"))
+				assert.Equal(t, map[string]telemetry.ResourceTypeCount{}, store.Bucket(memstore.TelemetryBucket).Get("resource_types"))
 			},
 		},
 		{
@@ -232,6 +245,10 @@ func TestDriftctlRun_BasicBehavior(t *testing.T) {
 				assert.Equal(t, 1, store.Bucket(memstore.TelemetryBucket).Get("total_managed"))
 				assert.Equal(t, uint(0), store.Bucket(memstore.TelemetryBucket).Get("duration"))
 				assert.Equal(t, uint(2), store.Bucket(memstore.TelemetryBucket).Get("iac_source_count"))
+				assert.Equal(t, map[string]telemetry.ResourceTypeCount{
+					"FakeResource": {Managed: 1, Changed: 1},
+				}, store.Bucket(memstore.TelemetryBucket).Get("resource_types"))
+				assert.Equal(t, 0, store.Bucket(memstore.TelemetryBucket).Get("alert_count"))
 			},
 		},
 		{
@@ -1569,9 +1586,10 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 				iacProgress,
 				repo,
 				store,
+				nil,
 			)
 
-			analysis, err := driftctl.Run()
+			analysis, err := driftctl.Run(context.TODO())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1581,3 +1599,271 @@ func TestDriftctlRun_TestResourcesNormalization(t *testing.T) {
 	}
 
 }
+
+// refreshingSupplier reads the cloud counterpart of managed resources and fails if the cloud is enumerated
+type refreshingSupplier struct {
+	refreshed map[string][]*resource.Resource
+}
+
+func (s refreshingSupplier) Resources() ([]*resource.Resource, error) {
+	return nil, errors.New("cloud should not be enumerated")
+}
+
+func (s refreshingSupplier) Refresh(ctx context.Context, input *enumeration.RefreshInput) (*enumeration.RefreshOutput, error) {
+	return &enumeration.RefreshOutput{Resources: s.refreshed}, nil
+}
+
+// enumeratingScanner returns a scanner for a cloud listing iam users with their attributes, without details fetcher
+func enumeratingScanner(users ...*resource.Resource) *remote.Scanner {
+	enumerator := &common.MockEnumerator{}
+	enumerator.On("SupportedType").Return(resource.ResourceType(aws.AwsIamUserResourceType))
+	enumerator.On("Enumerate", mock.Anything).Return(users, nil)
+	remoteLibrary := common.NewRemoteLibrary()
+	remoteLibrary.AddEnumerator(enumerator)
+
+	typeFilter := &enumeration.MockFilter{}
+	typeFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+	return remote.NewScanner(context.TODO(), remoteLibrary, alerter.NewAlerter(), remote.ScannerOptions{Deep: true}, typeFilter)
+}
+
+func TestDriftctlRun_RefreshManaged(t *testing.T) {
+	cases := []struct {
+		name           string
+		remoteSupplier resource.Supplier
+		assert         func(t *testing.T, analysis *analyser.Analysis, err error)
+	}{
+		{
+			name: "should compare resources refreshed from state",
+			remoteSupplier: refreshingSupplier{refreshed: map[string][]*resource.Resource{
+				aws.AwsIamUserResourceType: {
+					{Id: "deployer", Type: aws.AwsIamUserResourceType, Attrs: &resource.Attributes{"name": "deployer", "path": "/admin/"}},
+				},
+			}},
+			assert: func(t *testing.T, analysis *analyser.Analysis, err error) {
+				assert.NoError(t, err)
+				result := test.NewScanResult(t, analysis)
+				result.AssertManagedCount(1)
+				result.AssertUnmanagedCount(0)
+				result.AssertResourceDeleted("auditor", aws.AwsIamUserResourceType)
+				result.AssertResourceHasDrift("deployer", aws.AwsIamUserResourceType, analyser.Change{
+					Change: diff.Change{Type: diff.UPDATE, Path: []string{"path"}, From: "/", To: "/admin/"},
+				})
+			},
+		},
+		{
+			name: "should enumerate types without details fetcher instead of returning their state",
+			remoteSupplier: enumeratingScanner(
+				&resource.Resource{Id: "deployer", Type: aws.AwsIamUserResourceType, Attrs: &resource.Attributes{"name": "deployer", "path": "/admin/"}},
+				&resource.Resource{Id: "intruder", Type: aws.AwsIamUserResourceType, Attrs: &resource.Attributes{"name": "intruder", "path": "/"}},
+			),
+			assert: func(t *testing.T, analysis *analyser.Analysis, err error) {
+				assert.NoError(t, err)
+				result := test.NewScanResult(t, analysis)
+				result.AssertManagedCount(1)
+				result.AssertUnmanagedCount(0)
+				result.AssertResourceDeleted("auditor", aws.AwsIamUserResourceType)
+				result.AssertResourceHasDrift("deployer", aws.AwsIamUserResourceType, analyser.Change{
+					Change: diff.Change{Type: diff.UPDATE, Path: []string{"path"}, From: "/", To: "/admin/"},
+				})
+			},
+		},
+		{
+			name:           "should fail when remote supplier cannot refresh",
+			remoteSupplier: &resource.MockSupplier{},
+			assert: func(t *testing.T, analysis *analyser.Analysis, err error) {
+				assert.EqualError(t, err, "remote supplier *resource.MockSupplier cannot refresh managed resources")
+				assert.Nil(t, analysis)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+			resourceFactory := dctlresource.NewDriftctlResourceFactory(repo)
+			testAlerter := alerter.NewAlerter()
+			options := &pkg.ScanOptions{Deep: true, OnlyManaged: true, RefreshManaged: true}
+
+			stateSupplier := &dctlresource.MockIaCSupplier{}
+			stateSupplier.On("Resources").Return([]*resource.Resource{
+				resourceFactory.CreateAbstractResource(aws.AwsIamUserResourceType, "deployer", map[string]interface{}{"name": "deployer", "path": "/"}),
+				resourceFactory.CreateAbstractResource(aws.AwsIamUserResourceType, "auditor", map[string]interface{}{"name": "auditor", "path": "/"}),
+			}, nil)
+			stateSupplier.On("SourceCount").Return(uint(1))
+
+			scanProgress := &output.MockProgress{}
+			scanProgress.On("Start").Return().Once()
+			scanProgress.On("Stop").Return().Once()
+			iacProgress := &output.MockProgress{}
+			iacProgress.On("Start").Return().Once()
+			iacProgress.On("Stop").Return().Once()
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+			testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+			analyzer := analyser.NewAnalyzer(testAlerter, analyser.AnalyzerOptions{Deep: true, OnlyManaged: true}, testFilter)
+
+			driftctl := pkg.NewDriftCTL(c.remoteSupplier, stateSupplier, testAlerter, analyzer, resourceFactory, options, scanProgress, iacProgress, repo, memstore.New(), nil)
+
+			analysis, err := driftctl.Run(context.TODO())
+			c.assert(t, analysis, err)
+		})
+	}
+}
+
+func TestDriftctlRun_TraceScan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+	resourceFactory := dctlresource.NewDriftctlResourceFactory(repo)
+	testAlerter := alerter.NewAlerter()
+	options := &pkg.ScanOptions{Deep: true}
+
+	stateSupplier := &dctlresource.MockIaCSupplier{}
+	stateSupplier.On("Resources").Return([]*resource.Resource{}, nil)
+	stateSupplier.On("SourceCount").Return(uint(1))
+
+	scanProgress := &output.MockProgress{}
+	scanProgress.On("Start").Return().Once()
+	scanProgress.On("Stop").Return().Once()
+	iacProgress := &output.MockProgress{}
+	iacProgress.On("Start").Return().Once()
+	iacProgress.On("Stop").Return().Once()
+
+	testFilter := &filter.MockFilter{}
+	testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+	testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+	analyzer := analyser.NewAnalyzer(testAlerter, analyser.AnalyzerOptions{Deep: true}, testFilter)
+
+	driftctl := pkg.NewDriftCTL(enumeratingScanner(), stateSupplier, testAlerter, analyzer, resourceFactory, options, scanProgress, iacProgress, repo, memstore.New(), nil)
+	_, err := driftctl.Run(context.Background())
+	assert.NoError(t, err)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	assert.Equal(t, spans["DriftCTL.scan"].SpanContext().SpanID(), spans["Scanner.Resources"].Parent().SpanID())
+}
+
+func TestDriftctlRun_Attribution(t *testing.T) {
+	unmanaged := &resource.Resource{Id: "intruder", Type: aws.AwsIamUserResourceType}
+	drifted := &resource.Resource{Id: "deployer", Type: aws.AwsIamUserResourceType}
+	attributionDate := time.Date(2022, 1, 11, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		attribution bool
+		mocks       func(attributor *common.MockAttributor)
+		assert      func(t *testing.T, analysis *analyser.Analysis, attributor *common.MockAttributor)
+	}{
+		{
+			name:        "should attribute unmanaged and drifted resources",
+			attribution: true,
+			mocks: func(attributor *common.MockAttributor) {
+				attributor.On("Attribute", mock.Anything, mock.MatchedBy(func(res *resource.Resource) bool {
+					return res.ResourceId() == "intruder"
+				})).Return(&common.Attribution{Principal: "mallory@example.com", Date: attributionDate}, nil).Once()
+				attributor.On("Attribute", mock.Anything, mock.MatchedBy(func(res *resource.Resource) bool {
+					return res.ResourceId() == "deployer"
+				})).Return(nil, nil).Once()
+			},
+			assert: func(t *testing.T, analysis *analyser.Analysis, attributor *common.MockAttributor) {
+				assert.Equal(t, &common.Attribution{Principal: "mallory@example.com", Date: attributionDate}, analysis.Attribution(unmanaged))
+				assert.Nil(t, analysis.Attribution(drifted))
+			},
+		},
+		{
+			name:        "should not fail the scan when audit logs cannot be read",
+			attribution: true,
+			mocks: func(attributor *common.MockAttributor) {
+				attributor.On("Attribute", mock.Anything, mock.Anything).Return(nil, errors.New("access denied")).Twice()
+			},
+			assert: func(t *testing.T, analysis *analyser.Analysis, attributor *common.MockAttributor) {
+				assert.Nil(t, analysis.Attribution(unmanaged))
+				assert.Nil(t, analysis.Attribution(drifted))
+				assert.Equal(t, alerter.Alerts{
+					"": []alerter.Alert{remotealerts.NewAttributionAlert(errors.New("access denied"))},
+				}, analysis.Alerts())
+			},
+		},
+		{
+			name:        "should keep attributing resources after an attribution error",
+			attribution: true,
+			mocks: func(attributor *common.MockAttributor) {
+				attributor.On("Attribute", mock.Anything, mock.MatchedBy(func(res *resource.Resource) bool {
+					return res.ResourceId() == "intruder"
+				})).Return(&common.Attribution{Principal: "mallory@example.com", Date: attributionDate}, nil).Once()
+				attributor.On("Attribute", mock.Anything, mock.MatchedBy(func(res *resource.Resource) bool {
+					return res.ResourceId() == "deployer"
+				})).Return(nil, errors.New("query timeout")).Once()
+			},
+			assert: func(t *testing.T, analysis *analyser.Analysis, attributor *common.MockAttributor) {
+				assert.Equal(t, &common.Attribution{Principal: "mallory@example.com", Date: attributionDate}, analysis.Attribution(unmanaged))
+				assert.Nil(t, analysis.Attribution(drifted))
+				assert.Len(t, analysis.Alerts()[""], 1)
+			},
+		},
+		{
+			name:        "should not attribute when attribution is disabled",
+			attribution: false,
+			mocks:       func(attributor *common.MockAttributor) {},
+			assert: func(t *testing.T, analysis *analyser.Analysis, attributor *common.MockAttributor) {
+				attributor.AssertNotCalled(t, "Attribute", mock.Anything, mock.Anything)
+				assert.Nil(t, analysis.Attribution(unmanaged))
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+			resourceFactory := dctlresource.NewDriftctlResourceFactory(repo)
+			testAlerter := alerter.NewAlerter()
+			options := &pkg.ScanOptions{Deep: true, Attribution: c.attribution}
+
+			stateSupplier := &dctlresource.MockIaCSupplier{}
+			stateSupplier.On("Resources").Return([]*resource.Resource{
+				resourceFactory.CreateAbstractResource(aws.AwsIamUserResourceType, "deployer", map[string]interface{}{"name": "deployer", "path": "/"}),
+			}, nil)
+			stateSupplier.On("SourceCount").Return(uint(1))
+
+			remoteSupplier := &resource.MockSupplier{}
+			remoteSupplier.On("Resources").Return([]*resource.Resource{
+				resourceFactory.CreateAbstractResource(aws.AwsIamUserResourceType, "deployer", map[string]interface{}{"name": "deployer", "path": "/admin/"}),
+				resourceFactory.CreateAbstractResource(aws.AwsIamUserResourceType, "intruder", map[string]interface{}{"name": "intruder", "path": "/"}),
+			}, nil)
+
+			scanProgress := &output.MockProgress{}
+			scanProgress.On("Start").Return().Once()
+			scanProgress.On("Stop").Return().Once()
+			iacProgress := &output.MockProgress{}
+			iacProgress.On("Start").Return().Once()
+			iacProgress.On("Stop").Return().Once()
+
+			testFilter := &filter.MockFilter{}
+			testFilter.On("IsTypeIgnored", mock.Anything).Return(false)
+			testFilter.On("IsResourceIgnored", mock.Anything).Return(false)
+			testFilter.On("IsFieldIgnored", mock.Anything, mock.Anything).Return(false)
+			analyzer := analyser.NewAnalyzer(testAlerter, analyser.AnalyzerOptions{Deep: true}, testFilter)
+
+			attributor := &common.MockAttributor{}
+			c.mocks(attributor)
+
+			driftctl := pkg.NewDriftCTL(remoteSupplier, stateSupplier, testAlerter, analyzer, resourceFactory, options, scanProgress, iacProgress, repo, memstore.New(), attributor)
+
+			analysis, err := driftctl.Run(context.TODO())
+			assert.NoError(t, err)
+			result := test.NewScanResult(t, analysis)
+			result.AssertResourceUnmanaged("intruder", aws.AwsIamUserResourceType)
+			result.AssertResourceHasDrift("deployer", aws.AwsIamUserResourceType, analyser.Change{
+				Change: diff.Change{Type: diff.UPDATE, Path: []string{"path"}, From: "/", To: "/admin/"},
+			})
+
+			c.assert(t, analysis, attributor)
+			attributor.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package pkg
+
+import (
+	"fmt"
+
+	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+)
+
+// ConfigurationError is returned when a scan is not properly configured
+type ConfigurationError struct {
+	msg string
+}
+
+func newConfigurationError(msg string) ConfigurationError {
+	return ConfigurationError{msg}
+}
+
+func (e ConfigurationError) Error() string {
+	return e.msg
+}
+
+// ProviderError is returned when the terraform provider used to read states and resources cannot be initialized
+type ProviderError struct {
+	Err error
+}
+
+func (e ProviderError) Error() string {
+	return fmt.Sprintf("unable to initialize provider: %s", e.Err)
+}
+
+func (e ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// StateError is returned when the IaC sources cannot be configured
+type StateError struct {
+	Err error
+}
+
+func (e StateError) Error() string {
+	return fmt.Sprintf("unable to configure IaC sources: %s", e.Err)
+}
+
+func (e StateError) Unwrap() error {
+	return e.Err
+}
+
+// ScanError is returned when the scan itself fails, the context error is wrapped when it is canceled or timed out
+type ScanError struct {
+	Err error
+}
+
+func (e ScanError) Error() string {
+	return fmt.Sprintf("scan failed: %s", e.Err)
+}
+
+func (e ScanError) Unwrap() error {
+	return e.Err
+}
+
+// OutputError is returned along with the analysis when it cannot be written to one of the outputs
+type OutputError struct {
+	Output output.Output
+	Err    error
+}
+
+func (e OutputError) Error() string {
+	return fmt.Sprintf("unable to write analysis to %T: %s", e.Output, e.Err)
+}
+
+func (e OutputError) Unwrap() error {
+	return e.Err
+}
@@ -712,6 +712,27 @@ func TestDriftIgnore_IsTypeIgnored(t *testing.T) {
 			path:    "testdata/drift_ignore_all/.driftignore",
 			ignores: []string{"*", "!aws_s3*", "!aws_route53*"},
 		},
+		{
+			name: "do not ignore eks cluster when one of its children is not ignored",
+			resources: []*resource.Resource{
+				{
+					Type: "aws_eks_cluster",
+				},
+				{
+					Type: "aws_eks_node_group",
+				},
+				{
+					Type: "aws_eks_addon",
+				},
+			},
+			want: []bool{
+				false,
+				false,
+				true,
+			},
+			path:    "testdata/drift_ignore_all/.driftignore",
+			ignores: []string{"*", "!aws_eks_node_group"},
+		},
 		{
 			name: "do not ignore type when one inclusion rule with resource ID exist",
 			resources: []*resource.Resource{
@@ -35,11 +35,14 @@ func (r *IacChainSupplier) AddSupplier(supplier resource2.IaCSupplier) {
 }
 
 func (r *IacChainSupplier) Resources() ([]*resource.Resource, error) {
+	return r.ResourcesWithContext(context.Background())
+}
 
+func (r *IacChainSupplier) ResourcesWithContext(ctx context.Context) ([]*resource.Resource, error) {
 	for _, supplier := range r.suppliers {
 		sup := supplier
 		r.runner.Run(func() (interface{}, error) {
-			resources, err := sup.Resources()
+			resources, err := resource.ResourcesOf(ctx, sup)
 			return &result{err, resources}, nil
 		})
 	}
@@ -1,6 +1,7 @@
 package supplier
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
@@ -32,7 +33,8 @@ func IsSupplierSupported(supplierKey string) bool {
 	return false
 }
 
-func GetIACSupplier(configs []config.SupplierConfig,
+func GetIACSupplier(ctx context.Context,
+	configs []config.SupplierConfig,
 	library *terraform.ProviderLibrary,
 	backendOpts *backend.Options,
 	progress output.Progress,
@@ -52,7 +54,7 @@ func GetIACSupplier(configs []config.SupplierConfig,
 		var err error
 		switch config.Key {
 		case state.TerraformStateReaderSupplier:
-			supplier, err = state.NewReader(config, library, backendOpts, progress, alerter, deserializer, filter)
+			supplier, err = state.NewReader(ctx, config, library, backendOpts, progress, alerter, deserializer, filter)
 		default:
 			return nil, errors.Errorf("Unsupported supplier '%s'", config.Key)
 		}
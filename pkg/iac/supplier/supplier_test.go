@@ -1,6 +1,7 @@
 package supplier
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -97,7 +98,7 @@ func TestGetIACSupplier(t *testing.T) {
 
 			testFilter := &filter.MockFilter{}
 
-			_, err := GetIACSupplier(tt.args.config, terraform.NewProviderLibrary(), tt.args.options, progress, alerter, factory, testFilter)
+			_, err := GetIACSupplier(context.TODO(), tt.args.config, terraform.NewProviderLibrary(), tt.args.options, progress, alerter, factory, testFilter)
 
 			if tt.wantErr != nil && err.Error() != tt.wantErr.Error() {
 				t.Errorf("GetIACSupplier() error = %v, wantErr %v", err, tt.wantErr)
@@ -1,11 +1,15 @@
 package state
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/events"
 	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/enumeration/tracing"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/states"
@@ -34,6 +38,7 @@ type decodedRes struct {
 }
 
 type TerraformStateReader struct {
+	ctx            context.Context
 	library        *terraform.ProviderLibrary
 	config         config.SupplierConfig
 	backend        backend.Backend
@@ -55,8 +60,9 @@ func (r *TerraformStateReader) initReader() error {
 	return nil
 }
 
-func NewReader(config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, progress output.Progress, alerter *alerter.Alerter, deserializer *resource.Deserializer, filter filter.Filter) (*TerraformStateReader, error) {
+func NewReader(ctx context.Context, config config.SupplierConfig, library *terraform.ProviderLibrary, backendOpts *backend.Options, progress output.Progress, alerter *alerter.Alerter, deserializer *resource.Deserializer, filter filter.Filter) (*TerraformStateReader, error) {
 	reader := TerraformStateReader{
+		ctx:            ctx,
 		library:        library,
 		config:         config,
 		deserializer:   deserializer,
@@ -223,18 +229,22 @@ func (r *TerraformStateReader) decode(valFromState map[string][]decodedRes) ([]*
 }
 
 func (r *TerraformStateReader) Resources() ([]*resource.Resource, error) {
+	return r.ResourcesWithContext(r.context())
+}
+
+func (r *TerraformStateReader) ResourcesWithContext(ctx context.Context) ([]*resource.Resource, error) {
 	if r.enumerator == nil {
-		return r.retrieveForState(r.config.Path)
+		return r.retrieveForState(ctx, r.config.Path)
 	}
 
-	return r.retrieveMultiplesStates()
+	return r.retrieveMultiplesStates(ctx)
 }
 
 func (r *TerraformStateReader) SourceCount() uint {
 	return r.sourceCount
 }
 
-func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resource, error) {
+func (r *TerraformStateReader) retrieveForState(ctx context.Context, path string) ([]*resource.Resource, error) {
 	r.config.Path = path
 	r.sourceCount += 1
 	logrus.WithFields(logrus.Fields{
@@ -242,15 +252,31 @@ func (r *TerraformStateReader) retrieveForState(path string) ([]*resource.Resour
 		"backend": r.config.Backend,
 	}).Debug("Reading resources from state")
 	r.progress.Inc()
+	source := r.config.String()
+	events.Emit(events.NewStateReadStarted(source))
+	_, span := tracing.Start(ctx, "TerraformStateReader.Read", tracing.SourceKey.String(source))
+	start := time.Now()
 	values, err := r.retrieve()
 	if err != nil {
-		return nil, errors.Wrap(err, r.config.String())
+		tracing.End(span, err)
+		events.Emit(events.NewStateReadFinished(source, 0, time.Since(start), err))
+		return nil, errors.Wrap(err, source)
 	}
 	decode, err := r.decode(values)
-	return decode, errors.Wrap(err, r.config.String())
+	span.SetAttributes(tracing.ResourceCountKey.Int(len(decode)))
+	tracing.End(span, err)
+	events.Emit(events.NewStateReadFinished(source, len(decode), time.Since(start), err))
+	return decode, errors.Wrap(err, source)
+}
+
+func (r *TerraformStateReader) context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
 }
 
-func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource, error) {
+func (r *TerraformStateReader) retrieveMultiplesStates(ctx context.Context) ([]*resource.Resource, error) {
 	keys, err := r.enumerator.Enumerate()
 	if err != nil {
 		r.alerter.SendAlert("", NewStateReadingAlert(r.enumerator.Origin(), err))
@@ -266,7 +292,7 @@ func (r *TerraformStateReader) retrieveMultiplesStates() ([]*resource.Resource,
 	readingError := iac.NewStateReadingError()
 
 	for _, key := range keys {
-		resources, err := r.retrieveForState(key)
+		resources, err := r.retrieveForState(ctx, key)
 		if err != nil {
 			readingError.Add(err)
 			r.alerter.SendAlert("", NewStateReadingAlert(key, err))
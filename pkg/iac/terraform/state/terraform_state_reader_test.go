@@ -227,7 +227,7 @@ func TestTerraformStateReader_AWS_Resources(t *testing.T) {
 
 			if shouldUpdate {
 				var err error
-				realProvider, err = aws.NewAWSTerraformProvider(tt.providerVersion, progress, os.TempDir())
+				realProvider, err = aws.NewAWSTerraformProvider(tt.providerVersion, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -313,7 +313,7 @@ func TestTerraformStateReader_Github_Resources(t *testing.T) {
 
 			if shouldUpdate {
 				var err error
-				realProvider, err = github.NewGithubTerraformProvider("", progress, os.TempDir())
+				realProvider, err = github.NewGithubTerraformProvider("", nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -418,7 +418,7 @@ func TestTerraformStateReader_Google_Resources(t *testing.T) {
 			var realProvider *google.GCPTerraformProvider
 			providerVersion := "3.78.0"
 			var err error
-			realProvider, err = google.NewGCPTerraformProvider(providerVersion, progress, os.TempDir())
+			realProvider, err = google.NewGCPTerraformProvider(providerVersion, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -512,7 +512,7 @@ func TestTerraformStateReader_Azure_Resources(t *testing.T) {
 			var realProvider *azurerm.AzureTerraformProvider
 			providerVersion := "2.71.0"
 			var err error
-			realProvider, err = azurerm.NewAzureTerraformProvider(providerVersion, progress, os.TempDir())
+			realProvider, err = azurerm.NewAzureTerraformProvider(providerVersion, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 			if err != nil {
 				t.Fatal(err)
 			}
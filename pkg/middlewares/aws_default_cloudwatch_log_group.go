@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// Log groups created on the fly by AWS services the first time they write logs
+var defaultCloudwatchLogGroupPrefixes = []string{
+	"/aws/lambda/",
+	"/aws/codebuild/",
+	"/aws/eks/",
+	"/aws/rds/",
+	"/aws/ecs/containerinsights/",
+	"/aws/apigateway/",
+	"API-Gateway-Execution-Logs_",
+}
+
+// AwsDefaultCloudwatchLogGroup is a middleware that ignores log groups automatically created by AWS services
+// (e.g. /aws/lambda/<function> on the first invocation of a lambda function) when they are not managed by IaC.
+type AwsDefaultCloudwatchLogGroup struct{}
+
+func NewAwsDefaultCloudwatchLogGroup() AwsDefaultCloudwatchLogGroup {
+	return AwsDefaultCloudwatchLogGroup{}
+}
+
+func (m AwsDefaultCloudwatchLogGroup) isDefault(logGroupName string) bool {
+	for _, prefix := range defaultCloudwatchLogGroupPrefixes {
+		if strings.HasPrefix(logGroupName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m AwsDefaultCloudwatchLogGroup) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newRemoteResources := make([]*resource.Resource, 0)
+
+	for _, remoteResource := range *remoteResources {
+		// Ignore all resources other than log groups
+		if remoteResource.ResourceType() != aws.AwsCloudwatchLogGroupResourceType {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Ignore all non-default log groups
+		if !m.isDefault(remoteResource.ResourceId()) {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Check if log group is managed by IaC
+		existInState := false
+		for _, stateResource := range *resourcesFromState {
+			if remoteResource.Equal(stateResource) {
+				existInState = true
+				break
+			}
+		}
+
+		// Include resource if it's managed in IaC
+		if existInState {
+			newRemoteResources = append(newRemoteResources, remoteResource)
+			continue
+		}
+
+		// Else, resource is not added to newRemoteResources slice, so it will be ignored
+		logrus.WithFields(logrus.Fields{
+			"id":   remoteResource.ResourceId(),
+			"type": remoteResource.ResourceType(),
+		}).Debug("Ignoring default log group as it is not managed by IaC")
+	}
+
+	*remoteResources = newRemoteResources
+
+	return nil
+}
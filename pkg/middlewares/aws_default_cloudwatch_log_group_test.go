@@ -0,0 +1,102 @@
+package middlewares
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/r3labs/diff/v2"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+func TestAwsDefaultCloudwatchLogGroup_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		remoteResources    []*resource.Resource
+		resourcesFromState []*resource.Resource
+		expected           []*resource.Resource
+	}{
+		{
+			"test that default log groups are ignored when not managed by IaC",
+			[]*resource.Resource{
+				{
+					Id: "fake",
+				},
+				{
+					Id:    "/aws/lambda/foo",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "API-Gateway-Execution-Logs_abcdef/prod",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "application",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			[]*resource.Resource{},
+			[]*resource.Resource{
+				{
+					Id: "fake",
+				},
+				{
+					Id:    "application",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+		{
+			"test that default log groups are not ignored when managed by IaC",
+			[]*resource.Resource{
+				{
+					Id:    "/aws/lambda/foo",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "/aws/lambda/bar",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:    "/aws/lambda/foo",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			[]*resource.Resource{
+				{
+					Id:    "/aws/lambda/foo",
+					Type:  aws.AwsCloudwatchLogGroupResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewAwsDefaultCloudwatchLogGroup()
+			err := m.Execute(&tt.remoteResources, &tt.resourcesFromState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changelog, err := diff.Diff(tt.expected, tt.remoteResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changelog) > 0 {
+				for _, change := range changelog {
+					t.Errorf("%s got = %v, want %v", strings.Join(change.Path, "."), awsutil.Prettify(change.From), awsutil.Prettify(change.To))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// AwsDocDBClusterInstanceExpander search for DocumentDB cluster instances from state to import corresponding remote db instances.
+// DocumentDB instances are served by the RDS API, so they are enumerated as db instances like RDS cluster instances are.
+type AwsDocDBClusterInstanceExpander struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewDocDBClusterInstanceExpander(resourceFactory resource.ResourceFactory) AwsDocDBClusterInstanceExpander {
+	return AwsDocDBClusterInstanceExpander{
+		resourceFactory: resourceFactory,
+	}
+}
+
+func (m AwsDocDBClusterInstanceExpander) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newResourcesFromState := make([]*resource.Resource, 0)
+
+	dbInstances := make(map[string]*resource.Resource)
+	for _, remoteRes := range *remoteResources {
+		if remoteRes.ResourceType() != aws.AwsDbInstanceResourceType {
+			continue
+		}
+		dbInstances[remoteRes.ResourceId()] = remoteRes
+	}
+
+	for _, stateRes := range *resourcesFromState {
+		// Ignore all resources other than docdb_cluster_instance
+		if stateRes.ResourceType() != aws.AwsDocDBClusterInstanceResourceType {
+			newResourcesFromState = append(newResourcesFromState, stateRes)
+			continue
+		}
+
+		// If we don't manage to find a db instance corresponding to this cluster instance, simply add it back to the state.
+		remoteRes, found := dbInstances[stateRes.ResourceId()]
+		if !found {
+			newResourcesFromState = append(newResourcesFromState, stateRes)
+			continue
+		}
+
+		newDbInstance := m.resourceFactory.CreateAbstractResource(aws.AwsDbInstanceResourceType, remoteRes.ResourceId(), *remoteRes.Attributes())
+		newResourcesFromState = append(newResourcesFromState, newDbInstance)
+		logrus.WithFields(logrus.Fields{
+			"id": newDbInstance.ResourceId(),
+		}).Debug("Created new db instance from DocumentDB cluster instance")
+	}
+	*resourcesFromState = newResourcesFromState
+	return nil
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// AwsDocDBSubnetGroupExpander search for DocumentDB subnet groups from state to import corresponding remote db subnet groups.
+// DocumentDB subnet groups are served by the RDS API and cannot be told apart from RDS ones.
+type AwsDocDBSubnetGroupExpander struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewDocDBSubnetGroupExpander(resourceFactory resource.ResourceFactory) AwsDocDBSubnetGroupExpander {
+	return AwsDocDBSubnetGroupExpander{
+		resourceFactory: resourceFactory,
+	}
+}
+
+func (m AwsDocDBSubnetGroupExpander) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	newResourcesFromState := make([]*resource.Resource, 0)
+
+	subnetGroups := make(map[string]*resource.Resource)
+	for _, remoteRes := range *remoteResources {
+		if remoteRes.ResourceType() != aws.AwsDbSubnetGroupResourceType {
+			continue
+		}
+		subnetGroups[remoteRes.ResourceId()] = remoteRes
+	}
+
+	for _, stateRes := range *resourcesFromState {
+		// Ignore all resources other than docdb_subnet_group
+		if stateRes.ResourceType() != aws.AwsDocDBSubnetGroupResourceType {
+			newResourcesFromState = append(newResourcesFromState, stateRes)
+			continue
+		}
+
+		// If we don't manage to find a db subnet group corresponding to this one, simply add it back to the state.
+		remoteRes, found := subnetGroups[stateRes.ResourceId()]
+		if !found {
+			newResourcesFromState = append(newResourcesFromState, stateRes)
+			continue
+		}
+
+		newSubnetGroup := m.resourceFactory.CreateAbstractResource(aws.AwsDbSubnetGroupResourceType, remoteRes.ResourceId(), *remoteRes.Attributes())
+		newResourcesFromState = append(newResourcesFromState, newSubnetGroup)
+		logrus.WithFields(logrus.Fields{
+			"id": newSubnetGroup.ResourceId(),
+		}).Debug("Created new db subnet group from DocumentDB subnet group")
+	}
+	*resourcesFromState = newResourcesFromState
+	return nil
+}
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+)
+
+// AwsOpenSearchDomainReconciler turns remote OpenSearch domains back into Elasticsearch domains when the state manages
+// them with aws_elasticsearch_domain, which is the only way to manage OpenSearch engine domains with AWS provider 3.x.
+type AwsOpenSearchDomainReconciler struct {
+	resourceFactory resource.ResourceFactory
+}
+
+func NewAwsOpenSearchDomainReconciler(resourceFactory resource.ResourceFactory) AwsOpenSearchDomainReconciler {
+	return AwsOpenSearchDomainReconciler{
+		resourceFactory: resourceFactory,
+	}
+}
+
+func (m AwsOpenSearchDomainReconciler) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	elasticsearchDomains := make(map[string]struct{})
+	for _, stateRes := range *resourcesFromState {
+		if stateRes.ResourceType() == aws.AwsElasticsearchDomainResourceType {
+			elasticsearchDomains[stateRes.ResourceId()] = struct{}{}
+		}
+	}
+
+	newRemoteResources := make([]*resource.Resource, 0, len(*remoteResources))
+	for _, remoteRes := range *remoteResources {
+		// Ignore all resources other than opensearch_domain
+		if remoteRes.ResourceType() != aws.AwsOpenSearchDomainResourceType {
+			newRemoteResources = append(newRemoteResources, remoteRes)
+			continue
+		}
+
+		if _, managed := elasticsearchDomains[remoteRes.ResourceId()]; !managed {
+			newRemoteResources = append(newRemoteResources, remoteRes)
+			continue
+		}
+
+		newDomain := m.resourceFactory.CreateAbstractResource(aws.AwsElasticsearchDomainResourceType, remoteRes.ResourceId(), *remoteRes.Attributes())
+		newRemoteResources = append(newRemoteResources, newDomain)
+		logrus.WithFields(logrus.Fields{
+			"id": newDomain.ResourceId(),
+		}).Debug("Reconciled OpenSearch domain managed as an Elasticsearch domain")
+	}
+	*remoteResources = newRemoteResources
+	return nil
+}
@@ -0,0 +1,147 @@
+package middlewares
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsOpenSearchDomainReconciler_Execute(t *testing.T) {
+	tests := []struct {
+		name                    string
+		remoteResources         []*resource.Resource
+		stateResources          []*resource.Resource
+		expectedRemoteResources []*resource.Resource
+		expectedStateResources  []*resource.Resource
+		mock                    func(factory *dctlresource.MockResourceFactory)
+	}{
+		{
+			name: "should not reconcile unmanaged opensearch domains",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type:  aws.AwsOpenSearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			stateResources: []*resource.Resource{},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type:  aws.AwsOpenSearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedStateResources: []*resource.Resource{},
+		},
+		{
+			name: "should reconcile opensearch domains managed as elasticsearch domains",
+			remoteResources: []*resource.Resource{
+				{
+					Id:    "bucket01",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type: aws.AwsOpenSearchDomainResourceType,
+					Attrs: &resource.Attributes{
+						"domain_name": "search",
+					},
+				},
+				{
+					Id:   "arn:aws:es:us-east-1:123456789012:domain/logs",
+					Type: aws.AwsOpenSearchDomainResourceType,
+					Attrs: &resource.Attributes{
+						"domain_name": "logs",
+					},
+				},
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/legacy",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			stateResources: []*resource.Resource{
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/legacy",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedRemoteResources: []*resource.Resource{
+				{
+					Id:    "bucket01",
+					Type:  aws.AwsS3BucketResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:   "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type: aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{
+						"domain_name": "search",
+					},
+				},
+				{
+					Id:   "arn:aws:es:us-east-1:123456789012:domain/logs",
+					Type: aws.AwsOpenSearchDomainResourceType,
+					Attrs: &resource.Attributes{
+						"domain_name": "logs",
+					},
+				},
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/legacy",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			expectedStateResources: []*resource.Resource{
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/search",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+				{
+					Id:    "arn:aws:es:us-east-1:123456789012:domain/legacy",
+					Type:  aws.AwsElasticsearchDomainResourceType,
+					Attrs: &resource.Attributes{},
+				},
+			},
+			mock: func(factory *dctlresource.MockResourceFactory) {
+				factory.On("CreateAbstractResource", aws.AwsElasticsearchDomainResourceType, "arn:aws:es:us-east-1:123456789012:domain/search", map[string]interface{}{"domain_name": "search"}).
+					Return(&resource.Resource{
+						Id:    "arn:aws:es:us-east-1:123456789012:domain/search",
+						Type:  aws.AwsElasticsearchDomainResourceType,
+						Attrs: &resource.Attributes{"domain_name": "search"},
+					}).
+					Once()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := &dctlresource.MockResourceFactory{}
+			if tt.mock != nil {
+				tt.mock(factory)
+			}
+
+			m := NewAwsOpenSearchDomainReconciler(factory)
+			err := m.Execute(&tt.remoteResources, &tt.stateResources)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.expectedRemoteResources, tt.remoteResources, "Unexpected remote resources")
+			assert.Equal(t, tt.expectedStateResources, tt.stateResources, "Unexpected state resources")
+			factory.AssertExpectations(t)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package middlewares
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/tracing"
 )
 
 type Chain []Middleware
@@ -14,11 +16,23 @@ func NewChain(middlewares ...Middleware) Chain {
 }
 
 func (c Chain) Execute(remoteResources, resourcesFromState *[]*resource.Resource) error {
+	return c.ExecuteContext(context.Background(), remoteResources, resourcesFromState)
+}
+
+// ExecuteContext runs the middlewares in order, each in its own span of the trace carried by ctx
+func (c Chain) ExecuteContext(ctx context.Context, remoteResources, resourcesFromState *[]*resource.Resource) error {
 	for _, middleware := range c {
+		name := fmt.Sprintf("%T", middleware)
 		logrus.WithFields(logrus.Fields{
-			"middleware": fmt.Sprintf("%T", middleware),
+			"middleware": name,
 		}).Debug("Starting middleware")
+		_, span := tracing.Start(ctx, "Middleware.Execute", tracing.MiddlewareKey.String(name))
 		err := middleware.Execute(remoteResources, resourcesFromState)
+		span.SetAttributes(
+			tracing.RemoteCountKey.Int(len(*remoteResources)),
+			tracing.StateCountKey.Int(len(*resourcesFromState)),
+		)
+		tracing.End(span, err)
 		if err != nil {
 			return err
 		}
@@ -1,10 +1,19 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var callCounters map[string]int
@@ -83,3 +92,33 @@ func TestChainMiddlewareErrorShouldStopExecution(t *testing.T) {
 	}
 
 }
+
+func TestChainMiddlewareShouldTraceEachMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	callCounters = make(map[string]int)
+
+	middleware := NewChain(FakeMiddleware{Name: "1"}, FakeMiddleware{Name: "2", Err: errors.New("Test error")})
+	remoteResources := []*resource.Resource{{Id: "foo", Type: "FakeType"}}
+	stateResources := []*resource.Resource{}
+	ctx, root := otel.Tracer("test").Start(context.Background(), "root")
+	err := middleware.ExecuteContext(ctx, &remoteResources, &stateResources)
+	root.End()
+	assert.EqualError(t, err, "Test error")
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	for _, span := range spans[:2] {
+		assert.Equal(t, "Middleware.Execute", span.Name())
+		assert.Equal(t, root.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Equal(t, []attribute.KeyValue{
+			tracing.MiddlewareKey.String("middlewares.FakeMiddleware"),
+			tracing.RemoteCountKey.Int(1),
+			tracing.StateCountKey.Int(0),
+		}, span.Attributes())
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsAcmCertificateResourceType = "aws_acm_certificate"
+
+func initAwsAcmCertificateMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsAcmCertificateResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// The private key of imported certificates can never be read back from AWS
+		val.SafeDelete([]string{"private_key"})
+	})
+	resourceSchemaRepository.SetFlags(AwsAcmCertificateResourceType, resource.FlagDeepMode)
+}
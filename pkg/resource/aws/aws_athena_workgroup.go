@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsAthenaWorkgroupResourceType = "aws_athena_workgroup"
+
+func initAwsAthenaWorkgroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsAthenaWorkgroupResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		// Only used by terraform to delete named queries on deletion
+		val.SafeDelete([]string{"force_destroy"})
+	})
+	resourceSchemaRepository.SetFlags(AwsAthenaWorkgroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchDashboardResourceType = "aws_cloudwatch_dashboard"
+
+func initAwsCloudwatchDashboardMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchDashboardResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["dashboard_body"])
+		if err == nil {
+			_ = val.SafeSet([]string{"dashboard_body"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchDashboardResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"dashboard_body": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchDashboardResourceType, resource.FlagDeepMode)
+}
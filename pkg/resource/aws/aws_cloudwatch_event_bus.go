@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventBusResourceType = "aws_cloudwatch_event_bus"
+
+func initAwsCloudwatchEventBusMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudwatchEventBusResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventRuleResourceType = "aws_cloudwatch_event_rule"
+
+func initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchEventRuleResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["event_pattern"])
+		if err == nil {
+			_ = val.SafeSet([]string{"event_pattern"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchEventRuleResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"event_pattern": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchEventRuleResourceType, resource.FlagDeepMode)
+}
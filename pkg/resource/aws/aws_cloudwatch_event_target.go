@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchEventTargetResourceType = "aws_cloudwatch_event_target"
+
+func initAwsCloudwatchEventTargetMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchEventTargetResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["input"])
+		if err == nil {
+			_ = val.SafeSet([]string{"input"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsCloudwatchEventTargetResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"input": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchEventTargetResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchLogGroupResourceType = "aws_cloudwatch_log_group"
+
+func initAwsCloudwatchLogGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCloudwatchLogGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only used by terraform to generate the name, never read back from AWS
+		val.SafeDelete([]string{"name_prefix"})
+	})
+	resourceSchemaRepository.SetFlags(AwsCloudwatchLogGroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchLogMetricFilterResourceType = "aws_cloudwatch_log_metric_filter"
+
+func initAwsCloudwatchLogMetricFilterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudwatchLogMetricFilterResourceType, resource.FlagDeepMode)
+}
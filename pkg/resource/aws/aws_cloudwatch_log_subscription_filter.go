@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchLogSubscriptionFilterResourceType = "aws_cloudwatch_log_subscription_filter"
+
+func initAwsCloudwatchLogSubscriptionFilterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudwatchLogSubscriptionFilterResourceType, resource.FlagDeepMode)
+}
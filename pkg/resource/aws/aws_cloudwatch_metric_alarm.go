@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCloudwatchMetricAlarmResourceType = "aws_cloudwatch_metric_alarm"
+
+func initAwsCloudwatchMetricAlarmMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCloudwatchMetricAlarmResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCognitoIdentityPoolResourceType = "aws_cognito_identity_pool"
+
+func initAwsCognitoIdentityPoolMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsCognitoIdentityPoolResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCognitoUserPoolResourceType = "aws_cognito_user_pool"
+
+func initAwsCognitoUserPoolMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCognitoUserPoolResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		// Changes every time a user signs up, this is not a drift
+		val.SafeDelete([]string{"estimated_number_of_users"})
+	})
+	resourceSchemaRepository.SetFlags(AwsCognitoUserPoolResourceType, resource.FlagDeepMode)
+}
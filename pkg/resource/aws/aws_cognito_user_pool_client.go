@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsCognitoUserPoolClientResourceType = "aws_cognito_user_pool_client"
+
+func initAwsCognitoUserPoolClientMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsCognitoUserPoolClientResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		// The secret is read from both the state and the remote in deep mode, make sure it never ends up in the output
+		val.SafeDelete([]string{"client_secret"})
+		// Only used by terraform to ask for a secret on creation
+		val.SafeDelete([]string{"generate_secret"})
+	})
+	resourceSchemaRepository.SetFlags(AwsCognitoUserPoolClientResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,22 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsDocDBClusterResourceType = "aws_docdb_cluster"
+
+func initAwsDocDBClusterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsDocDBClusterResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+		val.SafeDelete([]string{"master_password"})
+		val.SafeDelete([]string{"cluster_members"})
+		val.SafeDelete([]string{"skip_final_snapshot"})
+		val.SafeDelete([]string{"apply_immediately"})
+		val.SafeDelete([]string{"final_snapshot_identifier"})
+		val.SafeDelete([]string{"snapshot_identifier"})
+	})
+	resourceSchemaRepository.SetFlags(AwsDocDBClusterResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsDocDBClusterParameterGroupResourceType = "aws_docdb_cluster_parameter_group"
+
+func initAwsDocDBClusterParameterGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsDocDBClusterParameterGroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEc2TransitGatewayResourceType = "aws_ec2_transit_gateway"
+
+func initAwsEc2TransitGatewayMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsEc2TransitGatewayResourceType, resource.FlagDeepMode)
+}
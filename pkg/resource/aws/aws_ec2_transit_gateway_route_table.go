@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEc2TransitGatewayRouteTableResourceType = "aws_ec2_transit_gateway_route_table"
+
+func initAwsEc2TransitGatewayRouteTableMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsEc2TransitGatewayRouteTableResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEc2TransitGatewayVpcAttachmentResourceType = "aws_ec2_transit_gateway_vpc_attachment"
+
+func initAwsEc2TransitGatewayVpcAttachmentMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsEc2TransitGatewayVpcAttachmentResourceType, resource.FlagDeepMode)
+}
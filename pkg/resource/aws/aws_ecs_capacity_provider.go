@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsCapacityProviderResourceType = "aws_ecs_capacity_provider"
+
+func initAwsEcsCapacityProviderMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsEcsCapacityProviderResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEcsTaskDefinitionResourceType = "aws_ecs_task_definition"
+
+func initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEcsTaskDefinitionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["container_definitions"])
+		if err == nil {
+			_ = val.SafeSet([]string{"container_definitions"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsEcsTaskDefinitionResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"container_definitions": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsEcsTaskDefinitionResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEfsFileSystemResourceType = "aws_efs_file_system"
+
+func initAwsEfsFileSystemMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEfsFileSystemResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		// Metered size changes as soon as data is written, this is not a drift
+		val.SafeDelete([]string{"size_in_bytes"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEfsFileSystemResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEfsMountTargetResourceType = "aws_efs_mount_target"
+
+func initAwsEfsMountTargetMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsEfsMountTargetResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,21 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksAddonResourceType = "aws_eks_addon"
+
+func initAwsEksAddonMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	// aws_eks_addon does not exist in the schema of older 3.x AWS providers
+	if _, exist := resourceSchemaRepository.GetSchema(AwsEksAddonResourceType); !exist {
+		return
+	}
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksAddonResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only changes how terraform applies the addon, never read back from AWS
+		val.SafeDelete([]string{"resolve_conflicts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEksAddonResourceType, resource.FlagDeepMode)
+}
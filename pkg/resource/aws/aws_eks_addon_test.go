@@ -0,0 +1,37 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/providers"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsEksAddon_Metadata(t *testing.T) {
+	repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+
+	schema, exist := repo.GetSchema(aws.AwsEksAddonResourceType)
+	assert.True(t, exist)
+	assert.True(t, schema.Flags.HasFlag(resource.FlagDeepMode))
+}
+
+func TestAwsEksAddon_MetadataWithoutSchema(t *testing.T) {
+	hook := logrustest.NewGlobal()
+	defer hook.Reset()
+
+	repo := schemas.NewSchemaRepository()
+	err := repo.Init("aws", "3.19.0", map[string]providers.Schema{})
+	assert.NoError(t, err)
+
+	_, exist := repo.GetSchema(aws.AwsEksAddonResourceType)
+	assert.False(t, exist)
+	// Metadata must not be set on a type missing from the provider schema
+	for _, entry := range hook.AllEntries() {
+		assert.NotEqual(t, aws.AwsEksAddonResourceType, entry.Data["type"], entry.Message)
+	}
+}
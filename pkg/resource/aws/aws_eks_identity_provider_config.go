@@ -0,0 +1,20 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksIdentityProviderConfigResourceType = "aws_eks_identity_provider_config"
+
+func initAwsEksIdentityProviderConfigMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	// aws_eks_identity_provider_config does not exist in the schema of older 3.x AWS providers
+	if _, exist := resourceSchemaRepository.GetSchema(AwsEksIdentityProviderConfigResourceType); !exist {
+		return
+	}
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksIdentityProviderConfigResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEksIdentityProviderConfigResourceType, resource.FlagDeepMode)
+}
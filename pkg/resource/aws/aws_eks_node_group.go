@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsEksNodeGroupResourceType = "aws_eks_node_group"
+
+func initAwsEksNodeGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsEksNodeGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		val.SafeDelete([]string{"timeouts"})
+		// Only changes how terraform rolls out version updates, never read back from AWS
+		val.SafeDelete([]string{"force_update_version"})
+	})
+	resourceSchemaRepository.SetFlags(AwsEksNodeGroupResourceType, resource.FlagDeepMode)
+}
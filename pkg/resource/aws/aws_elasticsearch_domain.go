@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsElasticsearchDomainResourceType = "aws_elasticsearch_domain"
+
+func initAwsElasticsearchDomainMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsElasticsearchDomainResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["access_policies"])
+		if err == nil {
+			_ = val.SafeSet([]string{"access_policies"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsElasticsearchDomainResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"access_policies": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsElasticsearchDomainResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsGlueCatalogDatabaseResourceType = "aws_glue_catalog_database"
+
+func initAwsGlueCatalogDatabaseMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsGlueCatalogDatabaseResourceType, resource.FlagDeepMode)
+}
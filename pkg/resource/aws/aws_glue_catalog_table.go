@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsGlueCatalogTableResourceType = "aws_glue_catalog_table"
+
+func initAwsGlueCatalogTableMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsGlueCatalogTableResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsGlueCrawlerResourceType = "aws_glue_crawler"
+
+func initAwsGlueCrawlerMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsGlueCrawlerResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		jsonString, err := helpers.NormalizeJsonString((*val)["configuration"])
+		if err == nil {
+			_ = val.SafeSet([]string{"configuration"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsGlueCrawlerResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"configuration": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsGlueCrawlerResourceType, resource.FlagDeepMode)
+}
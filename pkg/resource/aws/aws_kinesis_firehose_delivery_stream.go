@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsKinesisFirehoseDeliveryStreamResourceType = "aws_kinesis_firehose_delivery_stream"
+
+func initAwsKinesisFirehoseDeliveryStreamMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsKinesisFirehoseDeliveryStreamResourceType, resource.FlagDeepMode)
+}
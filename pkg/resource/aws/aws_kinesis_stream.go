@@ -0,0 +1,18 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsKinesisStreamResourceType = "aws_kinesis_stream"
+
+func initAwsKinesisStreamMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsKinesisStreamResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+		// Only used by terraform to deregister consumers on deletion
+		val.SafeDelete([]string{"enforce_consumer_deletion"})
+	})
+	resourceSchemaRepository.SetFlags(AwsKinesisStreamResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsLambdaAliasResourceType = "aws_lambda_alias"
+
+func initAwsLambdaAliasMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsLambdaAliasResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsLambdaPermissionResourceType = "aws_lambda_permission"
+
+func initAwsLambdaPermissionMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsLambdaPermissionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only used by terraform to generate the statement id, never read back from AWS
+		val.SafeDelete([]string{"statement_id_prefix"})
+	})
+	resourceSchemaRepository.SetFlags(AwsLambdaPermissionResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,16 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsMskClusterResourceType = "aws_msk_cluster"
+
+func initAwsMskClusterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsMskClusterResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+	})
+	resourceSchemaRepository.SetFlags(AwsMskClusterResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsNetworkInterfaceResourceType = "aws_network_interface"
+
+func initAwsNetworkInterfaceMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsNetworkInterfaceResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsOpenSearchDomainResourceType = "aws_opensearch_domain"
+
+func initAwsOpenSearchDomainMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	// aws_opensearch_domain only exists in the AWS provider schema starting from 4.x
+	if _, exist := resourceSchemaRepository.GetSchema(AwsOpenSearchDomainResourceType); !exist {
+		return
+	}
+	resourceSchemaRepository.SetNormalizeFunc(AwsOpenSearchDomainResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["access_policies"])
+		if err == nil {
+			_ = val.SafeSet([]string{"access_policies"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsOpenSearchDomainResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"access_policies": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsOpenSearchDomainResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,54 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestAwsOpenSearchDomain_Metadata(t *testing.T) {
+	repo := schemas.NewSchemaRepository()
+	err := repo.Init("aws", "4.30.0", map[string]providers.Schema{
+		aws.AwsOpenSearchDomainResourceType: {
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"domain_name":     {Type: cty.String, Required: true},
+					"access_policies": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	schema, exist := repo.GetSchema(aws.AwsOpenSearchDomainResourceType)
+	assert.True(t, exist)
+	assert.True(t, schema.Flags.HasFlag(resource.FlagDeepMode))
+	assert.True(t, schema.Attributes["access_policies"].JsonString)
+
+	factory := dctlresource.NewDriftctlResourceFactory(repo)
+	res := factory.CreateAbstractResource(aws.AwsOpenSearchDomainResourceType, "example", map[string]interface{}{
+		"domain_name":     "example",
+		"access_policies": "{\"Version\":  \"2012-10-17\",\n \"Statement\": []}",
+		"timeouts":        map[string]interface{}{"update": "1h"},
+	})
+
+	assert.Equal(t, &resource.Attributes{
+		"domain_name":     "example",
+		"access_policies": "{\"Statement\":[],\"Version\":\"2012-10-17\"}",
+	}, res.Attributes())
+}
+
+func TestAwsOpenSearchDomain_MetadataWithProvider3(t *testing.T) {
+	repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+
+	_, exist := repo.GetSchema(aws.AwsOpenSearchDomainResourceType)
+	assert.False(t, exist)
+}
@@ -0,0 +1,21 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsRedshiftClusterResourceType = "aws_redshift_cluster"
+
+func initAwsRedshiftClusterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsRedshiftClusterResourceType, func(res *resource.Resource) {
+		val := res.Attributes()
+		val.SafeDelete([]string{"timeouts"})
+		val.SafeDelete([]string{"master_password"})
+		val.SafeDelete([]string{"skip_final_snapshot"})
+		val.SafeDelete([]string{"final_snapshot_identifier"})
+		val.SafeDelete([]string{"snapshot_identifier"})
+		val.SafeDelete([]string{"snapshot_cluster_identifier"})
+	})
+	resourceSchemaRepository.SetFlags(AwsRedshiftClusterResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsRedshiftParameterGroupResourceType = "aws_redshift_parameter_group"
+
+func initAwsRedshiftParameterGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsRedshiftParameterGroupResourceType, resource.FlagDeepMode)
+}
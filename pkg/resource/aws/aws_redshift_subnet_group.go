@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsRedshiftSubnetGroupResourceType = "aws_redshift_subnet_group"
+
+func initAwsRedshiftSubnetGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsRedshiftSubnetGroupResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretResourceType = "aws_secretsmanager_secret"
+
+func initAwsSecretsManagerSecretMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Secret values must never end up in driftctl outputs, whatever the state they come from
+		val.SafeDelete([]string{"secret_string"})
+		// These arguments only change how terraform applies the secret, they are never read back from AWS
+		val.SafeDelete([]string{"recovery_window_in_days"})
+		val.SafeDelete([]string{"force_overwrite_replica_secret"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err == nil {
+			_ = val.SafeSet([]string{"policy"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsSecretsManagerSecretResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsSecretsManagerSecretResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretPolicyResourceType = "aws_secretsmanager_secret_policy"
+
+func initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSecretsManagerSecretPolicyResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only validates the policy when terraform applies it, never read back from AWS
+		val.SafeDelete([]string{"block_public_policy"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err == nil {
+			_ = val.SafeSet([]string{"policy"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsSecretsManagerSecretPolicyResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsSecretsManagerSecretPolicyResourceType, resource.FlagDeepMode)
+}
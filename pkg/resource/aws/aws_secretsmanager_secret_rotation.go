@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSecretsManagerSecretRotationResourceType = "aws_secretsmanager_secret_rotation"
+
+func initAwsSecretsManagerSecretRotationMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsSecretsManagerSecretRotationResourceType, resource.FlagDeepMode)
+}
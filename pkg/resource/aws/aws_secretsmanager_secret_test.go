@@ -0,0 +1,27 @@
+package aws_test
+
+import (
+	"testing"
+
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsSecretsManagerSecret_NormalizeRemovesSecretString(t *testing.T) {
+	repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+	factory := dctlresource.NewDriftctlResourceFactory(repo)
+
+	res := factory.CreateAbstractResource(aws.AwsSecretsManagerSecretResourceType, "arn:aws:secretsmanager:us-east-1:123456789012:secret:app-db-AbCdEf", map[string]interface{}{
+		"name":                    "app-db",
+		"secret_string":           "s3cr3t",
+		"recovery_window_in_days": 30,
+	})
+
+	assert.Equal(t, "app-db", *res.Attributes().GetString("name"))
+	for _, field := range []string{"secret_string", "recovery_window_in_days"} {
+		_, exist := res.Attributes().Get(field)
+		assert.False(t, exist, field)
+	}
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSesDomainIdentityResourceType = "aws_ses_domain_identity"
+
+func initAwsSesDomainIdentityMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsSesDomainIdentityResourceType, resource.FlagDeepMode)
+}
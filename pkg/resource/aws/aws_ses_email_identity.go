@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSesEmailIdentityResourceType = "aws_ses_email_identity"
+
+func initAwsSesEmailIdentityMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsSesEmailIdentityResourceType, resource.FlagDeepMode)
+}
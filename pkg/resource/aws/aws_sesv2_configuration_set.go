@@ -0,0 +1,3 @@
+package aws
+
+const AwsSesv2ConfigurationSetResourceType = "aws_sesv2_configuration_set"
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSfnStateMachineResourceType = "aws_sfn_state_machine"
+
+func initAwsSfnStateMachineMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSfnStateMachineResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		jsonString, err := helpers.NormalizeJsonString((*val)["definition"])
+		if err == nil {
+			_ = val.SafeSet([]string{"definition"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsSfnStateMachineResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"definition": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsSfnStateMachineResourceType, resource.FlagDeepMode)
+}
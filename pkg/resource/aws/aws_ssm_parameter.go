@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsSsmParameterResourceType = "aws_ssm_parameter"
+
+func initAwsSsmParameterMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsSsmParameterResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Parameter values may be secrets and must never end up in driftctl outputs
+		val.SafeDelete([]string{"value"})
+		// Only changes how terraform applies the parameter, never read back from AWS
+		val.SafeDelete([]string{"overwrite"})
+	})
+	// Not compatible with deep mode: reading a parameter from the provider decrypts its value
+}
@@ -0,0 +1,30 @@
+package aws_test
+
+import (
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/aws"
+	testresource "github.com/snyk/driftctl/test/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsSsmParameter_NormalizeRemovesValue(t *testing.T) {
+	repo := testresource.InitFakeSchemaRepository("aws", "3.62.0")
+	factory := dctlresource.NewDriftctlResourceFactory(repo)
+
+	res := factory.CreateAbstractResource(aws.AwsSsmParameterResourceType, "/app/database/password", map[string]interface{}{
+		"name":      "/app/database/password",
+		"type":      "SecureString",
+		"value":     "s3cr3t",
+		"overwrite": true,
+	})
+
+	assert.Equal(t, &resource.Attributes{
+		"name": "/app/database/password",
+		"type": "SecureString",
+	}, res.Attributes())
+	_, exist := res.Attributes().Get("value")
+	assert.False(t, exist)
+}
@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcDhcpOptionsResourceType = "aws_vpc_dhcp_options"
+
+func initAwsVpcDhcpOptionsMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsVpcDhcpOptionsResourceType, resource.FlagDeepMode)
+}
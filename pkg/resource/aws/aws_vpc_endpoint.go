@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/pkg/helpers"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcEndpointResourceType = "aws_vpc_endpoint"
+
+func initAwsVpcEndpointMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcEndpointResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only used by terraform to accept the endpoint connection on creation
+		val.SafeDelete([]string{"auto_accept"})
+		jsonString, err := helpers.NormalizeJsonString((*val)["policy"])
+		if err == nil {
+			_ = val.SafeSet([]string{"policy"}, jsonString)
+		}
+	})
+	resourceSchemaRepository.UpdateSchema(AwsVpcEndpointResourceType, map[string]func(attributeSchema *resource.AttributeSchema){
+		"policy": func(attributeSchema *resource.AttributeSchema) {
+			attributeSchema.JsonString = true
+		},
+	})
+	resourceSchemaRepository.SetFlags(AwsVpcEndpointResourceType, resource.FlagDeepMode)
+}
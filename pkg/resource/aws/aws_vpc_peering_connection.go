@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsVpcPeeringConnectionResourceType = "aws_vpc_peering_connection"
+
+func initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsVpcPeeringConnectionResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Only used by terraform to accept the connection on creation
+		val.SafeDelete([]string{"auto_accept"})
+	})
+	resourceSchemaRepository.SetFlags(AwsVpcPeeringConnectionResourceType, resource.FlagDeepMode)
+}
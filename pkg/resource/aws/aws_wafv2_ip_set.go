@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsWafv2IpSetResourceType = "aws_wafv2_ip_set"
+
+func initAwsWafv2IpSetMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsWafv2IpSetResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Changes on every update of the IP set, this is not a drift
+		val.SafeDelete([]string{"lock_token"})
+	})
+	resourceSchemaRepository.SetFlags(AwsWafv2IpSetResourceType, resource.FlagDeepMode)
+}
@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsWafv2RuleGroupResourceType = "aws_wafv2_rule_group"
+
+func initAwsWafv2RuleGroupMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsWafv2RuleGroupResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Changes on every update of the rule group, this is not a drift
+		val.SafeDelete([]string{"lock_token"})
+	})
+	resourceSchemaRepository.SetFlags(AwsWafv2RuleGroupResourceType, resource.FlagDeepMode)
+}
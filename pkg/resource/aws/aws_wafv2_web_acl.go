@@ -0,0 +1,17 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsWafv2WebAclResourceType = "aws_wafv2_web_acl"
+
+func initAwsWafv2WebAclMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetNormalizeFunc(AwsWafv2WebAclResourceType, func(res *resource.Resource) {
+		val := res.Attrs
+		// Changes on every update of the web ACL, this is not a drift
+		val.SafeDelete([]string{"lock_token"})
+	})
+	resourceSchemaRepository.SetFlags(AwsWafv2WebAclResourceType, resource.FlagDeepMode)
+}
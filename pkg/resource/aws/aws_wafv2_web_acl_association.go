@@ -0,0 +1,12 @@
+package aws
+
+import (
+	"github.com/snyk/driftctl/enumeration/resource"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+)
+
+const AwsWafv2WebAclAssociationResourceType = "aws_wafv2_web_acl_association"
+
+func initAwsWafv2WebAclAssociationMetaData(resourceSchemaRepository dctlresource.SchemaRepositoryInterface) {
+	resourceSchemaRepository.SetFlags(AwsWafv2WebAclAssociationResourceType, resource.FlagDeepMode)
+}
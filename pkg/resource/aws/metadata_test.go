@@ -118,6 +118,67 @@ func TestAWS_Metadata_Flags(t *testing.T) {
 		aws.AwsApplicationLoadBalancerListenerResourceType: {},
 		aws.AwsIamGroupResourceType:                        {},
 		aws.AwsEcrRepositoryPolicyResourceType:             {},
+		aws.AwsEcsClusterResourceType:                      {resource.FlagDeepMode},
+		aws.AwsEcsServiceResourceType:                      {resource.FlagDeepMode},
+		aws.AwsEcsTaskDefinitionResourceType:               {resource.FlagDeepMode},
+		aws.AwsEcsCapacityProviderResourceType:             {resource.FlagDeepMode},
+		aws.AwsEksClusterResourceType:                      {resource.FlagDeepMode},
+		aws.AwsEksNodeGroupResourceType:                    {resource.FlagDeepMode},
+		aws.AwsEksAddonResourceType:                        {resource.FlagDeepMode},
+		aws.AwsEksFargateProfileResourceType:               {resource.FlagDeepMode},
+		aws.AwsEksIdentityProviderConfigResourceType:       {resource.FlagDeepMode},
+		aws.AwsSecretsManagerSecretResourceType:            {resource.FlagDeepMode},
+		aws.AwsSecretsManagerSecretPolicyResourceType:      {resource.FlagDeepMode},
+		aws.AwsSecretsManagerSecretRotationResourceType:    {resource.FlagDeepMode},
+		aws.AwsSsmParameterResourceType:                    {},
+		aws.AwsSsmDocumentResourceType:                     {resource.FlagDeepMode},
+		aws.AwsLambdaPermissionResourceType:                {resource.FlagDeepMode},
+		aws.AwsLambdaAliasResourceType:                     {resource.FlagDeepMode},
+		aws.AwsCloudwatchEventBusResourceType:              {resource.FlagDeepMode},
+		aws.AwsCloudwatchEventRuleResourceType:             {resource.FlagDeepMode},
+		aws.AwsCloudwatchEventTargetResourceType:           {resource.FlagDeepMode},
+		aws.AwsSfnStateMachineResourceType:                 {resource.FlagDeepMode},
+		aws.AwsSfnActivityResourceType:                     {resource.FlagDeepMode},
+		aws.AwsCloudwatchLogGroupResourceType:              {resource.FlagDeepMode},
+		aws.AwsCloudwatchLogMetricFilterResourceType:       {resource.FlagDeepMode},
+		aws.AwsCloudwatchLogSubscriptionFilterResourceType: {resource.FlagDeepMode},
+		aws.AwsCloudwatchMetricAlarmResourceType:           {resource.FlagDeepMode},
+		aws.AwsCloudwatchDashboardResourceType:             {resource.FlagDeepMode},
+		aws.AwsVpcEndpointResourceType:                     {resource.FlagDeepMode},
+		aws.AwsVpcPeeringConnectionResourceType:            {resource.FlagDeepMode},
+		aws.AwsEc2TransitGatewayResourceType:               {resource.FlagDeepMode},
+		aws.AwsEc2TransitGatewayVpcAttachmentResourceType:  {resource.FlagDeepMode},
+		aws.AwsEc2TransitGatewayRouteTableResourceType:     {resource.FlagDeepMode},
+		aws.AwsFlowLogResourceType:                         {resource.FlagDeepMode},
+		aws.AwsVpcDhcpOptionsResourceType:                  {resource.FlagDeepMode},
+		aws.AwsNetworkInterfaceResourceType:                {resource.FlagDeepMode},
+		aws.AwsAcmCertificateResourceType:                  {resource.FlagDeepMode},
+		aws.AwsWafv2WebAclResourceType:                     {resource.FlagDeepMode},
+		aws.AwsWafv2WebAclAssociationResourceType:          {resource.FlagDeepMode},
+		aws.AwsWafv2IpSetResourceType:                      {resource.FlagDeepMode},
+		aws.AwsWafv2RuleGroupResourceType:                  {resource.FlagDeepMode},
+		aws.AwsShieldProtectionResourceType:                {resource.FlagDeepMode},
+		aws.AwsEfsFileSystemResourceType:                   {resource.FlagDeepMode},
+		aws.AwsEfsMountTargetResourceType:                  {resource.FlagDeepMode},
+		aws.AwsRedshiftClusterResourceType:                 {resource.FlagDeepMode},
+		aws.AwsRedshiftSubnetGroupResourceType:             {resource.FlagDeepMode},
+		aws.AwsRedshiftParameterGroupResourceType:          {resource.FlagDeepMode},
+		aws.AwsElasticsearchDomainResourceType:             {resource.FlagDeepMode},
+		aws.AwsMskClusterResourceType:                      {resource.FlagDeepMode},
+		aws.AwsDocDBClusterResourceType:                    {resource.FlagDeepMode},
+		aws.AwsDocDBClusterParameterGroupResourceType:      {resource.FlagDeepMode},
+		aws.AwsKinesisStreamResourceType:                   {resource.FlagDeepMode},
+		aws.AwsKinesisFirehoseDeliveryStreamResourceType:   {resource.FlagDeepMode},
+		aws.AwsGlueCatalogDatabaseResourceType:             {resource.FlagDeepMode},
+		aws.AwsGlueCatalogTableResourceType:                {resource.FlagDeepMode},
+		aws.AwsGlueJobResourceType:                         {resource.FlagDeepMode},
+		aws.AwsGlueCrawlerResourceType:                     {resource.FlagDeepMode},
+		aws.AwsAthenaWorkgroupResourceType:                 {resource.FlagDeepMode},
+		aws.AwsCognitoUserPoolResourceType:                 {resource.FlagDeepMode},
+		aws.AwsCognitoUserPoolClientResourceType:           {resource.FlagDeepMode},
+		aws.AwsCognitoIdentityPoolResourceType:             {resource.FlagDeepMode},
+		aws.AwsSesDomainIdentityResourceType:               {resource.FlagDeepMode},
+		aws.AwsSesEmailIdentityResourceType:                {resource.FlagDeepMode},
 	}
 
 	schemaRepository := testresource.InitFakeSchemaRepository("aws", "3.19.0")
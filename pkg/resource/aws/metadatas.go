@@ -44,6 +44,32 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initAwsS3BucketMetaData(resourceSchemaRepository)
 	initAwsS3BucketPolicyMetaData(resourceSchemaRepository)
 	initAwsEcrRepositoryMetaData(resourceSchemaRepository)
+	initAwsEcsClusterMetaData(resourceSchemaRepository)
+	initAwsEcsServiceMetaData(resourceSchemaRepository)
+	initAwsEcsTaskDefinitionMetaData(resourceSchemaRepository)
+	initAwsEcsCapacityProviderMetaData(resourceSchemaRepository)
+	initAwsEksClusterMetaData(resourceSchemaRepository)
+	initAwsEksNodeGroupMetaData(resourceSchemaRepository)
+	initAwsEksAddonMetaData(resourceSchemaRepository)
+	initAwsEksFargateProfileMetaData(resourceSchemaRepository)
+	initAwsEksIdentityProviderConfigMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretPolicyMetaData(resourceSchemaRepository)
+	initAwsSecretsManagerSecretRotationMetaData(resourceSchemaRepository)
+	initAwsSsmParameterMetaData(resourceSchemaRepository)
+	initAwsSsmDocumentMetaData(resourceSchemaRepository)
+	initAwsLambdaPermissionMetaData(resourceSchemaRepository)
+	initAwsLambdaAliasMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventBusMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventRuleMetaData(resourceSchemaRepository)
+	initAwsCloudwatchEventTargetMetaData(resourceSchemaRepository)
+	initAwsSfnStateMachineMetaData(resourceSchemaRepository)
+	initAwsSfnActivityMetaData(resourceSchemaRepository)
+	initAwsCloudwatchLogGroupMetaData(resourceSchemaRepository)
+	initAwsCloudwatchLogMetricFilterMetaData(resourceSchemaRepository)
+	initAwsCloudwatchLogSubscriptionFilterMetaData(resourceSchemaRepository)
+	initAwsCloudwatchMetricAlarmMetaData(resourceSchemaRepository)
+	initAwsCloudwatchDashboardMetaData(resourceSchemaRepository)
 	initAwsRouteMetaData(resourceSchemaRepository)
 	initAwsRoute53RecordMetaData(resourceSchemaRepository)
 	initAwsRoute53ZoneMetaData(resourceSchemaRepository)
@@ -70,4 +96,40 @@ func InitResourcesMetadata(resourceSchemaRepository resource.SchemaRepositoryInt
 	initAwsCloudformationStackMetaData(resourceSchemaRepository)
 	initAwsAppAutoscalingTargetMetaData(resourceSchemaRepository)
 	initAwsCloudtrailMetaData(resourceSchemaRepository)
+	initAwsVpcEndpointMetaData(resourceSchemaRepository)
+	initAwsVpcPeeringConnectionMetaData(resourceSchemaRepository)
+	initAwsEc2TransitGatewayMetaData(resourceSchemaRepository)
+	initAwsEc2TransitGatewayVpcAttachmentMetaData(resourceSchemaRepository)
+	initAwsEc2TransitGatewayRouteTableMetaData(resourceSchemaRepository)
+	initAwsFlowLogMetaData(resourceSchemaRepository)
+	initAwsVpcDhcpOptionsMetaData(resourceSchemaRepository)
+	initAwsNetworkInterfaceMetaData(resourceSchemaRepository)
+	initAwsAcmCertificateMetaData(resourceSchemaRepository)
+	initAwsWafv2WebAclMetaData(resourceSchemaRepository)
+	initAwsWafv2WebAclAssociationMetaData(resourceSchemaRepository)
+	initAwsWafv2IpSetMetaData(resourceSchemaRepository)
+	initAwsWafv2RuleGroupMetaData(resourceSchemaRepository)
+	initAwsShieldProtectionMetaData(resourceSchemaRepository)
+	initAwsEfsFileSystemMetaData(resourceSchemaRepository)
+	initAwsEfsMountTargetMetaData(resourceSchemaRepository)
+	initAwsRedshiftClusterMetaData(resourceSchemaRepository)
+	initAwsRedshiftSubnetGroupMetaData(resourceSchemaRepository)
+	initAwsRedshiftParameterGroupMetaData(resourceSchemaRepository)
+	initAwsElasticsearchDomainMetaData(resourceSchemaRepository)
+	initAwsOpenSearchDomainMetaData(resourceSchemaRepository)
+	initAwsMskClusterMetaData(resourceSchemaRepository)
+	initAwsDocDBClusterMetaData(resourceSchemaRepository)
+	initAwsDocDBClusterParameterGroupMetaData(resourceSchemaRepository)
+	initAwsKinesisStreamMetaData(resourceSchemaRepository)
+	initAwsKinesisFirehoseDeliveryStreamMetaData(resourceSchemaRepository)
+	initAwsGlueCatalogDatabaseMetaData(resourceSchemaRepository)
+	initAwsGlueCatalogTableMetaData(resourceSchemaRepository)
+	initAwsGlueJobMetaData(resourceSchemaRepository)
+	initAwsGlueCrawlerMetaData(resourceSchemaRepository)
+	initAwsAthenaWorkgroupMetaData(resourceSchemaRepository)
+	initAwsCognitoUserPoolMetaData(resourceSchemaRepository)
+	initAwsCognitoUserPoolClientMetaData(resourceSchemaRepository)
+	initAwsCognitoIdentityPoolMetaData(resourceSchemaRepository)
+	initAwsSesDomainIdentityMetaData(resourceSchemaRepository)
+	initAwsSesEmailIdentityMetaData(resourceSchemaRepository)
 }
@@ -0,0 +1,314 @@
+package pkg
+
+import (
+	"context"
+	"os"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/snyk/driftctl/enumeration/alerter"
+	"github.com/snyk/driftctl/enumeration/plugin"
+	"github.com/snyk/driftctl/enumeration/ratelimit"
+	"github.com/snyk/driftctl/enumeration/remote"
+	"github.com/snyk/driftctl/enumeration/remote/cache"
+	"github.com/snyk/driftctl/enumeration/remote/common"
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/cmd/scan/output"
+	"github.com/snyk/driftctl/pkg/filter"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/supplier"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/pkg/memstore"
+	globaloutput "github.com/snyk/driftctl/pkg/output"
+	dctlresource "github.com/snyk/driftctl/pkg/resource"
+	"github.com/snyk/driftctl/pkg/resource/schemas"
+)
+
+type scanBuilder struct {
+	to              string
+	providerVersion string
+	providerSource  terraform.ProviderSource
+	configDirectory string
+	pluginsDir      string
+	provider        terraform.TerraformProvider
+	remoteSupplier  resource.Supplier
+	iacSupplier     dctlresource.IaCSupplier
+	from            []config.SupplierConfig
+	backendOptions  *backend.Options
+	outputs         []output.Output
+
+	strictMode     bool
+	refreshManaged bool
+	filter         *jmespath.JMESPath
+	driftignore    string
+	ignoreRules    []string
+	rateLimit      float64
+	scannerOpts    remote.ScannerOptions
+	analyzerOpts   analyser.AnalyzerOptions
+}
+
+// NewScan returns a builder running a drift analysis, for embedding driftctl in another program
+func NewScan() *scanBuilder {
+	return &scanBuilder{
+		rateLimit: ratelimit.DefaultRate,
+	}
+}
+
+// WithCloud Choose which cloud to scan, e.g. aws+tf
+func (b *scanBuilder) WithCloud(to string) *scanBuilder {
+	b.to = to
+	return b
+}
+
+// WithProviderVersion optionally choose the terraform provider version used to read states and resources
+func (b *scanBuilder) WithProviderVersion(providerVersion string) *scanBuilder {
+	b.providerVersion = providerVersion
+	return b
+}
+
+// WithProviderSource optionally install the terraform provider from another registry hostname or namespace than hashicorp's on the public registry
+func (b *scanBuilder) WithProviderSource(source terraform.ProviderSource) *scanBuilder {
+	b.providerSource = source
+	return b
+}
+
+// WithConfigDirectory optionally choose the directory used to download the terraform provider, a temporary directory is used if not set
+func (b *scanBuilder) WithConfigDirectory(configDir string) *scanBuilder {
+	b.configDirectory = configDir
+	return b
+}
+
+// WithProvider optionally use an already initialized terraform provider instead of downloading one for the cloud,
+// a remote supplier must then be provided as well
+func (b *scanBuilder) WithProvider(provider terraform.TerraformProvider) *scanBuilder {
+	b.provider = provider
+	return b
+}
+
+// WithRemoteSupplier optionally choose the supplier of cloud resources instead of enumerating the cloud
+func (b *scanBuilder) WithRemoteSupplier(supplier resource.Supplier) *scanBuilder {
+	b.remoteSupplier = supplier
+	return b
+}
+
+// WithIaCSupplier optionally choose the supplier of IaC resources instead of reading states
+func (b *scanBuilder) WithIaCSupplier(supplier dctlresource.IaCSupplier) *scanBuilder {
+	b.iacSupplier = supplier
+	return b
+}
+
+// WithStates Choose the IaC sources read to retrieve managed resources
+func (b *scanBuilder) WithStates(from ...config.SupplierConfig) *scanBuilder {
+	b.from = append(b.from, from...)
+	return b
+}
+
+// WithBackendOptions optionally choose the options used to read states from remote backends
+func (b *scanBuilder) WithBackendOptions(opts *backend.Options) *scanBuilder {
+	b.backendOptions = opts
+	return b
+}
+
+// WithOutputs optionally choose where the analysis is written once the scan is done
+func (b *scanBuilder) WithOutputs(outputs ...output.Output) *scanBuilder {
+	b.outputs = append(b.outputs, outputs...)
+	return b
+}
+
+// WithDeep optionally compare the attributes of managed resources with their cloud counterparts
+func (b *scanBuilder) WithDeep(deep bool) *scanBuilder {
+	b.scannerOpts.Deep = deep
+	b.analyzerOpts.Deep = deep
+	return b
+}
+
+// WithStrictMode optionally keep the resources created by default by cloud providers in the analysis
+func (b *scanBuilder) WithStrictMode(strictMode bool) *scanBuilder {
+	b.strictMode = strictMode
+	return b
+}
+
+// WithOnlyManaged optionally restrict the analysis to managed resources
+func (b *scanBuilder) WithOnlyManaged(onlyManaged bool) *scanBuilder {
+	b.analyzerOpts.OnlyManaged = onlyManaged
+	return b
+}
+
+// WithOnlyUnmanaged optionally restrict the analysis to unmanaged resources
+func (b *scanBuilder) WithOnlyUnmanaged(onlyUnmanaged bool) *scanBuilder {
+	b.analyzerOpts.OnlyUnmanaged = onlyUnmanaged
+	return b
+}
+
+// WithRefreshManaged optionally read resources found in IaC from the cloud instead of enumerating it,
+// it implies deep and only managed modes and requires the remote supplier, if any, to be able to refresh resources
+func (b *scanBuilder) WithRefreshManaged(refreshManaged bool) *scanBuilder {
+	b.refreshManaged = refreshManaged
+	if refreshManaged {
+		b.WithDeep(true)
+		b.WithOnlyManaged(true)
+	}
+	return b
+}
+
+// WithFilter optionally restrict the analysis to resources matching a JMESPath expression
+func (b *scanBuilder) WithFilter(filter *jmespath.JMESPath) *scanBuilder {
+	b.filter = filter
+	return b
+}
+
+// WithDriftignore optionally ignore resources listed in a driftignore file, or matching the given rules if any
+func (b *scanBuilder) WithDriftignore(path string, rules ...string) *scanBuilder {
+	b.driftignore = path
+	b.ignoreRules = rules
+	return b
+}
+
+// WithScannerOptions optionally tune the concurrency, timeouts and profiling of the cloud enumeration
+func (b *scanBuilder) WithScannerOptions(opts remote.ScannerOptions) *scanBuilder {
+	deep := b.scannerOpts.Deep
+	b.scannerOpts = opts
+	b.scannerOpts.Deep = deep
+	return b
+}
+
+// WithEnumeratorPlugins optionally load enumerator plugins from a directory, see the enumeration/plugin package
+func (b *scanBuilder) WithEnumeratorPlugins(dir string) *scanBuilder {
+	b.pluginsDir = dir
+	return b
+}
+
+// WithRateLimit optionally choose the maximum number of requests per second sent to each cloud service, zero disables rate limiting
+func (b *scanBuilder) WithRateLimit(rateLimit float64) *scanBuilder {
+	b.rateLimit = rateLimit
+	return b
+}
+
+// Run scans the cloud and the IaC sources and returns the resulting analysis, it stops as soon as ctx is done
+func (b *scanBuilder) Run(ctx context.Context) (*analyser.Analysis, error) {
+	if b.remoteSupplier == nil && b.to == "" {
+		return nil, newConfigurationError("either a cloud or a remote supplier is required")
+	}
+	if b.provider != nil && b.remoteSupplier == nil {
+		return nil, newConfigurationError("a remote supplier is required when using an initialized provider")
+	}
+	if b.provider == nil && b.to == "" {
+		return nil, newConfigurationError("a cloud is required to read states without an initialized provider")
+	}
+	if b.to != "" && !remote.IsSupported(b.to) {
+		return nil, newConfigurationError("unsupported cloud " + b.to)
+	}
+	if b.iacSupplier == nil && len(b.from) == 0 {
+		return nil, newConfigurationError("either states or an IaC supplier are required")
+	}
+
+	alerter := alerter.NewAlerter()
+	providerLibrary := terraform.NewProviderLibrary()
+	remoteLibrary := common.NewRemoteLibrary()
+	iacProgress := globaloutput.NewProgress("Scanning states", "Scanned states", true)
+	scanProgress := globaloutput.NewProgress("Scanning resources", "Scanned resources", false)
+	resourceSchemaRepository := schemas.NewSchemaRepository()
+	resFactory := dctlresource.NewDriftctlResourceFactory(resourceSchemaRepository)
+	defer providerLibrary.Cleanup()
+
+	providerName, providerVersion := "", b.providerVersion
+	if b.provider != nil {
+		providerName, providerVersion = b.provider.Name(), b.provider.Version()
+		providerLibrary.AddProvider(providerName, b.provider)
+	} else {
+		if providerVersion == "" {
+			providerVersion = remote.GetDefaultProviderVersion(b.to)
+		}
+		configDirectory := b.configDirectory
+		if configDirectory == "" {
+			tempDir, err := os.MkdirTemp("", "driftctl")
+			if err != nil {
+				return nil, ProviderError{err}
+			}
+			configDirectory = tempDir
+		}
+		err := remote.Activate(b.to, providerVersion, nil, b.providerSource, alerter, providerLibrary, remoteLibrary, scanProgress, resFactory, configDirectory, terraform.ProviderMirror{}, cache.TTLConfig{}, b.rateLimit)
+		if err != nil {
+			return nil, ProviderError{err}
+		}
+		providerName = common.RemoteParameter(b.to).GetProviderAddress().Type
+	}
+	if b.pluginsDir != "" {
+		enumeratorPlugins, err := plugin.Load(b.pluginsDir, remoteLibrary)
+		if err != nil {
+			return nil, ProviderError{err}
+		}
+		defer enumeratorPlugins.Cleanup()
+		for _, ty := range enumeratorPlugins.ResourceTypes() {
+			dctlresource.AddSupportedType(ty)
+		}
+	}
+	if err := resourceSchemaRepository.Init(providerName, providerVersion, providerLibrary.Provider(providerName).Schema()); err != nil {
+		return nil, ProviderError{err}
+	}
+
+	driftIgnore := filter.NewDriftIgnore(b.driftignore, b.ignoreRules...)
+
+	remoteSupplier := b.remoteSupplier
+	if remoteSupplier == nil {
+		remoteSupplier = remote.NewScanner(ctx, remoteLibrary, alerter, b.scannerOpts, driftIgnore)
+	}
+
+	iacSupplier := b.iacSupplier
+	if iacSupplier == nil {
+		var err error
+		iacSupplier, err = supplier.GetIACSupplier(ctx, b.from, providerLibrary, b.backendOptions, iacProgress, alerter, resFactory, driftIgnore)
+		if err != nil {
+			return nil, StateError{err}
+		}
+	}
+
+	opts := &ScanOptions{
+		To:              b.to,
+		From:            b.from,
+		Filter:          b.filter,
+		StrictMode:      b.strictMode,
+		ProviderVersion: providerVersion,
+		Deep:            b.analyzerOpts.Deep,
+		OnlyManaged:     b.analyzerOpts.OnlyManaged,
+		OnlyUnmanaged:   b.analyzerOpts.OnlyUnmanaged,
+		RefreshManaged:  b.refreshManaged,
+	}
+
+	ctl := NewDriftCTL(
+		remoteSupplier,
+		iacSupplier,
+		alerter,
+		analyser.NewAnalyzer(alerter, b.analyzerOpts, driftIgnore),
+		resFactory,
+		opts,
+		scanProgress,
+		iacProgress,
+		resourceSchemaRepository,
+		memstore.New(),
+		remoteLibrary.Attributor(),
+	)
+
+	analysis, err := ctl.Run(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ScanError{ctx.Err()}
+		}
+		return nil, ScanError{err}
+	}
+
+	analysis.ProviderName = b.to
+	analysis.ProviderVersion = providerVersion
+	if b.scannerOpts.Profiler != nil {
+		analysis.SetProfile(b.scannerOpts.Profiler.Report())
+	}
+
+	for _, o := range b.outputs {
+		if err := o.Write(analysis); err != nil {
+			return analysis, OutputError{o, err}
+		}
+	}
+
+	return analysis, nil
+}
@@ -0,0 +1,75 @@
+package pkg_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/test/mocks"
+)
+
+// fakeSupplier returns a fixed list of cloud resources
+type fakeSupplier struct {
+	resources []*resource.Resource
+}
+
+func (s fakeSupplier) Resources() ([]*resource.Resource, error) {
+	return s.resources, nil
+}
+
+// fixtureProvider reads resource schemas from test/schemas without a real provider
+type fixtureProvider struct {
+	*mocks.MockedGoldenTFProvider
+	version string
+}
+
+func (p fixtureProvider) Name() string {
+	return terraform.AWS
+}
+
+func (p fixtureProvider) Version() string {
+	return p.version
+}
+
+func ExampleNewScan() {
+	provider := fixtureProvider{mocks.NewMockedGoldenTFProvider("scan_builder", terraform.AWS, "3.62.0", nil, false), "3.62.0"}
+
+	analysis, err := pkg.NewScan().
+		WithProvider(provider).
+		WithRemoteSupplier(fakeSupplier{resources: []*resource.Resource{
+			{Type: "aws_iam_user", Id: "deployer", Attrs: &resource.Attributes{"name": "deployer"}},
+			{Type: "aws_iam_user", Id: "intruder", Attrs: &resource.Attributes{"name": "intruder"}},
+		}}).
+		WithStates(config.SupplierConfig{
+			Key:     state.TerraformStateReaderSupplier,
+			Backend: backend.BackendKeyFile,
+			Path:    "test/scan_builder/terraform.tfstate",
+		}).
+		WithStrictMode(true).
+		Run(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("in sync: %t\n", analysis.IsSync())
+	for _, res := range analysis.Managed() {
+		fmt.Printf("managed: %s\n", res.ResourceId())
+	}
+	for _, res := range analysis.Unmanaged() {
+		fmt.Printf("unmanaged: %s\n", res.ResourceId())
+	}
+	for _, res := range analysis.Deleted() {
+		fmt.Printf("missing: %s\n", res.ResourceId())
+	}
+	// Output:
+	// in sync: false
+	// managed: deployer
+	// unmanaged: intruder
+	// missing: auditor
+}
@@ -0,0 +1,120 @@
+package pkg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/snyk/driftctl/enumeration/resource"
+	"github.com/snyk/driftctl/enumeration/terraform"
+	"github.com/snyk/driftctl/pkg"
+	"github.com/snyk/driftctl/pkg/analyser"
+	"github.com/snyk/driftctl/pkg/iac/config"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state"
+	"github.com/snyk/driftctl/pkg/iac/terraform/state/backend"
+	"github.com/snyk/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingOutput struct{}
+
+func (o failingOutput) Write(*analyser.Analysis) error {
+	return errors.New("disk full")
+}
+
+func TestScanBuilder_Errors(t *testing.T) {
+	fixtureState := config.SupplierConfig{
+		Key:     state.TerraformStateReaderSupplier,
+		Backend: backend.BackendKeyFile,
+		Path:    "test/scan_builder/terraform.tfstate",
+	}
+	newProvider := func() fixtureProvider {
+		return fixtureProvider{mocks.NewMockedGoldenTFProvider("scan_builder", terraform.AWS, "3.62.0", nil, false), "3.62.0"}
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name      string
+		ctx       context.Context
+		run       func(context.Context) (*analyser.Analysis, error)
+		assertErr func(*testing.T, error)
+		analysis  bool
+	}{
+		{
+			name: "without cloud nor remote supplier",
+			run:  pkg.NewScan().WithStates(fixtureState).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.ConfigurationError{})
+			},
+		},
+		{
+			name: "with unsupported cloud",
+			run:  pkg.NewScan().WithCloud("aws+unknown").WithStates(fixtureState).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.ConfigurationError{})
+			},
+		},
+		{
+			name: "without states",
+			run:  pkg.NewScan().WithProvider(newProvider()).WithRemoteSupplier(fakeSupplier{}).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.ConfigurationError{})
+			},
+		},
+		{
+			name: "with unsupported state",
+			run:  pkg.NewScan().WithProvider(newProvider()).WithRemoteSupplier(fakeSupplier{}).WithStates(config.SupplierConfig{Key: "unknown", Path: "terraform.tfstate"}).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.StateError{})
+			},
+		},
+		{
+			name: "with canceled context",
+			ctx:  canceled,
+			run:  pkg.NewScan().WithProvider(newProvider()).WithRemoteSupplier(cancelledSupplier{canceled}).WithStates(fixtureState).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.ScanError{})
+				assert.ErrorIs(t, err, context.Canceled)
+			},
+		},
+		{
+			name: "with refresh managed and a supplier unable to refresh",
+			run:  pkg.NewScan().WithProvider(newProvider()).WithRemoteSupplier(fakeSupplier{}).WithStates(fixtureState).WithRefreshManaged(true).Run,
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorAs(t, err, &pkg.ScanError{})
+			},
+		},
+		{
+			name: "with failing output",
+			run:  pkg.NewScan().WithProvider(newProvider()).WithRemoteSupplier(fakeSupplier{}).WithStates(fixtureState).WithOutputs(failingOutput{}).Run,
+			assertErr: func(t *testing.T, err error) {
+				var outputErr pkg.OutputError
+				assert.ErrorAs(t, err, &outputErr)
+				assert.Equal(t, failingOutput{}, outputErr.Output)
+			},
+			analysis: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := c.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			analysis, err := c.run(ctx)
+			c.assertErr(t, err)
+			assert.Equal(t, c.analysis, analysis != nil)
+		})
+	}
+}
+
+// cancelledSupplier fails like the cloud scanner does once its context is done
+type cancelledSupplier struct {
+	ctx context.Context
+}
+
+func (s cancelledSupplier) Resources() ([]*resource.Resource, error) {
+	return nil, s.ctx.Err()
+}
@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultEndpoint is the endpoint telemetry is sent to when no sink is configured
+const DefaultEndpoint = "https://telemetry.driftctl.com/telemetry"
+
+// Sink receives the telemetry payload of a scan, as JSON
+type Sink interface {
+	Send(payload []byte) error
+}
+
+// HTTPSink posts the payload to an HTTP endpoint
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{url: url, client: &http.Client{}}
+}
+
+func (s *HTTPSink) Send(payload []byte) error {
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("unsuccessful request to %s: %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+// FileSink appends the payload of each scan to a local file as a JSON line
+type FileSink struct {
+	path string
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Send(payload []byte) error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "%s\n", payload); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ParseSink returns the sink of a telemetry endpoint, either an http(s) url or a file:// url
+func ParseSink(endpoint string) (Sink, error) {
+	u, err := url.Parse(endpoint)
+	if err == nil {
+		switch u.Scheme {
+		case "http", "https":
+			if u.Host != "" {
+				return NewHTTPSink(endpoint), nil
+			}
+		case "file":
+			if path := strings.TrimPrefix(endpoint, "file://"); path != "" {
+				return NewFileSink(path), nil
+			}
+		}
+	}
+	return nil, errors.Errorf("Invalid telemetry endpoint %s, expected an http(s) url or a file path as file://<path>", endpoint)
+}
@@ -0,0 +1,98 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/snyk/driftctl/pkg/memstore"
+	"github.com/snyk/driftctl/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSink(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		want     Sink
+		wantErr  bool
+	}{
+		{endpoint: "https://telemetry.example.com/driftctl", want: NewHTTPSink("https://telemetry.example.com/driftctl")},
+		{endpoint: "http://localhost:8080", want: NewHTTPSink("http://localhost:8080")},
+		{endpoint: "file:///var/log/driftctl.ndjson", want: NewFileSink("/var/log/driftctl.ndjson")},
+		{endpoint: "file://driftctl.ndjson", want: NewFileSink("driftctl.ndjson")},
+		{endpoint: "file://", wantErr: true},
+		{endpoint: "https://", wantErr: true},
+		{endpoint: "telemetry.example.com", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.endpoint, func(t *testing.T) {
+			got, err := ParseSink(c.endpoint)
+			if c.wantErr {
+				assert.EqualError(t, err, "Invalid telemetry endpoint "+c.endpoint+", expected an http(s) url or a file path as file://<path>")
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, c.want, got)
+		})
+	}
+}
+
+func TestSendTelemetryToCustomEndpoint(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"POST",
+		"https://telemetry.example.com/driftctl",
+		func(req *http.Request) (*http.Response, error) {
+			requestTelemetry := &telemetry{}
+			requestBody, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(requestBody, requestTelemetry); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, 12, requestTelemetry.TotalResources)
+			return httpmock.NewBytesResponse(202, []byte{}), nil
+		},
+	)
+
+	store := memstore.New().Bucket(memstore.TelemetryBucket)
+	store.Set("total_resources", 12)
+	// A custom endpoint does not depend on usage reporting being enabled on the build
+	tl := NewTelemetry(mocks.MockBuild{UsageReporting: false}, NewHTTPSink("https://telemetry.example.com/driftctl"))
+	tl.SendTelemetry(store)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestFileSink(t *testing.T) {
+	file := path.Join(t.TempDir(), "telemetry.ndjson")
+	sink := NewFileSink(file)
+
+	store := memstore.New().Bucket(memstore.TelemetryBucket)
+	store.Set("provider_name", "aws+tf")
+	store.Set("alert_count", 2)
+	tl := NewTelemetry(mocks.MockBuild{}, sink)
+	tl.SendTelemetry(store)
+	tl.SendTelemetry(store)
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, 2)
+	for _, line := range lines {
+		got := &telemetry{}
+		assert.Nil(t, json.Unmarshal([]byte(line), got))
+		assert.Equal(t, "aws+tf", got.ProviderName)
+		assert.Equal(t, 2, got.AlertCount)
+	}
+}
@@ -1,9 +1,7 @@
 package telemetry
 
 import (
-	"bytes"
 	"encoding/json"
-	"net/http"
 	"runtime"
 
 	"github.com/sirupsen/logrus"
@@ -23,21 +21,39 @@ type telemetry struct {
 	ProviderName   string `json:"provider_name"`
 	IaCSourceCount uint   `json:"iac_source_count"`
 	Client         string `json:"client"`
+
+	ResourceTypes map[string]ResourceTypeCount `json:"resource_types,omitempty"`
+	AlertCount    int                          `json:"alert_count"`
+}
+
+// ResourceTypeCount is the number of resources of a type by status
+type ResourceTypeCount struct {
+	Managed   int `json:"managed,omitempty"`
+	Unmanaged int `json:"unmanaged,omitempty"`
+	Missing   int `json:"missing,omitempty"`
+	Changed   int `json:"changed,omitempty"`
 }
 
 type Telemetry struct {
 	build build.BuildInterface
+	sink  Sink
 }
 
-func NewTelemetry(build build.BuildInterface) *Telemetry {
-	return &Telemetry{build: build}
+// NewTelemetry creates a telemetry sending to the given sink.
+// When sink is nil telemetry is sent to DefaultEndpoint, only if usage reporting is enabled on the build.
+func NewTelemetry(build build.BuildInterface, sink Sink) *Telemetry {
+	return &Telemetry{build: build, sink: sink}
 }
 
 func (te Telemetry) SendTelemetry(store memstore.Bucket) {
 
-	if !te.build.IsUsageReportingEnabled() {
-		logrus.Debug("Usage reporting is disabled on this build, telemetry skipped")
-		return
+	sink := te.sink
+	if sink == nil {
+		if !te.build.IsUsageReportingEnabled() {
+			logrus.Debug("Usage reporting is disabled on this build, telemetry skipped")
+			return
+		}
+		sink = NewHTTPSink(DefaultEndpoint)
 	}
 
 	t := &telemetry{
@@ -71,18 +87,21 @@ func (te Telemetry) SendTelemetry(store memstore.Bucket) {
 		t.IaCSourceCount = val
 	}
 
+	if val, ok := store.Get("resource_types").(map[string]ResourceTypeCount); ok {
+		t.ResourceTypes = val
+	}
+
+	if val, ok := store.Get("alert_count").(int); ok {
+		t.AlertCount = val
+	}
+
 	body, err := json.Marshal(t)
 	if err != nil {
 		logrus.Debug(err)
 		return
 	}
 
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", "https://telemetry.driftctl.com/telemetry", bytes.NewReader(body))
-	req.Header.Set("Content-Type", "application/json")
-
-	_, err = client.Do(req)
-	if err != nil {
+	if err := sink.Send(body); err != nil {
 		logrus.Debugf("Unable to send telemetry data: %+v", err)
 		return
 	}
@@ -47,6 +47,10 @@ func TestSendTelemetry(t *testing.T) {
 				ProviderName:   "aws",
 				IaCSourceCount: 2,
 				Client:         "driftctl",
+				ResourceTypes: map[string]ResourceTypeCount{
+					"aws_s3_bucket": {Managed: 1, Unmanaged: 1},
+				},
+				AlertCount: 3,
 			},
 			setStoreValues: func(s memstore.Bucket, a *analyser.Analysis) {
 				s.Set("total_resources", a.Summary().TotalResources)
@@ -54,6 +58,10 @@ func TestSendTelemetry(t *testing.T) {
 				s.Set("duration", uint(a.Duration.Seconds()+0.5))
 				s.Set("provider_name", "aws")
 				s.Set("iac_source_count", uint(2))
+				s.Set("resource_types", map[string]ResourceTypeCount{
+					"aws_s3_bucket": {Managed: 1, Unmanaged: 1},
+				})
+				s.Set("alert_count", 3)
 			},
 		},
 		{
@@ -133,7 +141,7 @@ func TestSendTelemetry(t *testing.T) {
 					},
 				)
 			}
-			tl := NewTelemetry(mocks.MockBuild{UsageReporting: true})
+			tl := NewTelemetry(mocks.MockBuild{UsageReporting: true}, nil)
 			tl.SendTelemetry(store)
 		})
 	}
@@ -149,7 +157,7 @@ func TestTelemetryNotSend(t *testing.T) {
 		"https://telemetry.driftctl.com/telemetry",
 		httpmock.NewErrorResponder(nil),
 	)
-	tl := NewTelemetry(mocks.MockBuild{UsageReporting: false})
+	tl := NewTelemetry(mocks.MockBuild{UsageReporting: false}, nil)
 	tl.SendTelemetry(store)
 
 	assert.Zero(t, httpmock.GetTotalCallCount())
@@ -181,7 +189,7 @@ func TestTelemetrySetProperClient(t *testing.T) {
 
 	viper.Set("IS_SNYK", true)
 	store := memstore.New().Bucket(memstore.TelemetryBucket)
-	tl := NewTelemetry(mocks.MockBuild{UsageReporting: true})
+	tl := NewTelemetry(mocks.MockBuild{UsageReporting: true}, nil)
 	tl.SendTelemetry(store)
 	assert.Equal(t, 1, httpmock.GetTotalCallCount())
 }
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/acm/acmiface"
+
+type FakeACM interface {
+	acmiface.ACMAPI
+}
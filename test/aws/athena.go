@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/athena/athenaiface"
+
+type FakeAthena interface {
+	athenaiface.AthenaAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+
+type FakeCloudwatch interface {
+	cloudwatchiface.CloudWatchAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+
+type FakeCloudwatchEvents interface {
+	cloudwatcheventsiface.CloudWatchEventsAPI
+}
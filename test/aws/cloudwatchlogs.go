@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+
+type FakeCloudwatchLogs interface {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+}
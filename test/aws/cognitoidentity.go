@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+
+type FakeCognitoIdentity interface {
+	cognitoidentityiface.CognitoIdentityAPI
+}
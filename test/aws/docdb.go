@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/docdb/docdbiface"
+
+type FakeDocDB interface {
+	docdbiface.DocDBAPI
+}
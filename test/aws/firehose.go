@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+
+type FakeFirehose interface {
+	firehoseiface.FirehoseAPI
+}
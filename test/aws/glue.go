@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/glue/glueiface"
+
+type FakeGlue interface {
+	glueiface.GlueAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+
+type FakeKafka interface {
+	kafkaiface.KafkaAPI
+}
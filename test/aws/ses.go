@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/ses/sesiface"
+
+type FakeSES interface {
+	sesiface.SESAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/sesv2/sesv2iface"
+
+type FakeSESV2 interface {
+	sesv2iface.SESV2API
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/sfn/sfniface"
+
+type FakeSFN interface {
+	sfniface.SFNAPI
+}
@@ -0,0 +1,7 @@
+package aws
+
+import "github.com/aws/aws-sdk-go/service/shield/shieldiface"
+
+type FakeShield interface {
+	shieldiface.ShieldAPI
+}
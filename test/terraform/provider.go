@@ -14,7 +14,7 @@ import (
 func InitTestAwsProvider(providerLibrary *terraform.ProviderLibrary, version string) (*aws.AWSTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := aws.NewAWSTerraformProvider(version, progress, os.TempDir())
+	provider, err := aws.NewAWSTerraformProvider(version, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 	if err != nil {
 		return nil, err
 	}
@@ -25,7 +25,7 @@ func InitTestAwsProvider(providerLibrary *terraform.ProviderLibrary, version str
 func InitTestGithubProvider(providerLibrary *terraform.ProviderLibrary, version string) (*github.GithubTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := github.NewGithubTerraformProvider(version, progress, os.TempDir())
+	provider, err := github.NewGithubTerraformProvider(version, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func InitTestGithubProvider(providerLibrary *terraform.ProviderLibrary, version
 func InitTestGoogleProvider(providerLibrary *terraform.ProviderLibrary, version string) (*google.GCPTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := google.NewGCPTerraformProvider(version, progress, os.TempDir())
+	provider, err := google.NewGCPTerraformProvider(version, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func InitTestGoogleProvider(providerLibrary *terraform.ProviderLibrary, version
 func InitTestAzureProvider(providerLibrary *terraform.ProviderLibrary, version string) (*azurerm.AzureTerraformProvider, error) {
 	progress := &output.MockProgress{}
 	progress.On("Inc").Maybe().Return()
-	provider, err := azurerm.NewAzureTerraformProvider(version, progress, os.TempDir())
+	provider, err := azurerm.NewAzureTerraformProvider(version, nil, terraform.ProviderSource{}, progress, os.TempDir(), terraform.ProviderMirror{})
 	if err != nil {
 		return nil, err
 	}